@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/micro-business/TenantService/config"
+)
+
+// runConfigCommand resolves every setting from all the sources, the same way the server does, and prints the effective
+// value of every setting along with its origin, masking the secrets. Every missing or not valid setting is reported to
+// stderr and the command exits with a non-zero code, so it can be used as a pre-deploy check.
+// arguments: Mandatory. The command line arguments following the config command.
+func runConfigCommand(arguments []string) {
+	configurationReader, err := config.NewConfigurationReader(flag.NewFlagSet("config", flag.ExitOnError), arguments)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	values, errs := configurationReader.Inspect()
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "SETTING\tVALUE\tORIGIN\tSTATUS")
+
+	for _, value := range values {
+		status := "ok"
+
+		if value.Err != nil {
+			status = "error"
+		}
+
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", value.Key, value.Value, value.Origin, status)
+	}
+
+	writer.Flush()
+
+	if len(errs) == 0 {
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "\n%d configuration error(s) found:\n", len(errs))
+
+	for _, err := range errs {
+		fmt.Fprintf(os.Stderr, "  - %s\n", err.Error())
+	}
+
+	os.Exit(1)
+}
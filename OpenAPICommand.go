@@ -0,0 +1,33 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/micro-business/TenantService/endpoint/restendpoint"
+)
+
+// runOpenAPICommand prints the OpenAPI document describing the REST API, either to stdout or to the file provided using
+// the output flag.
+// arguments: Mandatory. The command line arguments following the openapi command.
+func runOpenAPICommand(arguments []string) {
+	var outputFilePath string
+
+	flagSet := flag.NewFlagSet("openapi", flag.ExitOnError)
+	flagSet.StringVar(&outputFilePath, "output", "", "The path to the file the OpenAPI document is written to. The default is stdout.")
+	flagSet.Parse(arguments)
+
+	document := restendpoint.OpenAPIDocument()
+
+	if len(outputFilePath) == 0 {
+		fmt.Print(string(document))
+
+		return
+	}
+
+	if err := ioutil.WriteFile(outputFilePath, document, 0644); err != nil {
+		log.Fatal(err.Error())
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/micro-business/TenantService/endpoint/graphqlendpoint"
+)
+
+// runSchemaCommand prints the GraphQL schema of the API in the schema definition language, either to stdout or to the
+// file provided using the output flag.
+// arguments: Mandatory. The command line arguments following the schema command.
+func runSchemaCommand(arguments []string) {
+	var outputFilePath string
+
+	flagSet := flag.NewFlagSet("schema", flag.ExitOnError)
+	flagSet.StringVar(&outputFilePath, "output", "", "The path to the file the schema is written to. The default is stdout.")
+	flagSet.Parse(arguments)
+
+	schema := graphqlendpoint.PrintSchema()
+
+	if len(outputFilePath) == 0 {
+		fmt.Print(schema)
+
+		return
+	}
+
+	if err := ioutil.WriteFile(outputFilePath, []byte(schema), 0644); err != nil {
+		log.Fatal(err.Error())
+	}
+}
@@ -4,8 +4,15 @@ package contract
 import (
 	"github.com/micro-business/Micro-Business-Core/system"
 	"github.com/micro-business/TenantService/business/domain"
+	"golang.org/x/net/context"
 )
 
+// MaxBulkApplications is the maximum number of applications a single bulk request can change, whichever API it is sent to.
+const MaxBulkApplications = 100
+
+// ApplicationNotAppliedMessage is the error reported for the valid applications of a failed all or nothing bulk request.
+const ApplicationNotAppliedMessage = "Not applied as another application of the request failed."
+
 // TenantService contract, it can add new tenant and update/retrieve/remove an existing tenant.
 type TenantService interface {
 	// CreateTenant creates a new tenant.
@@ -24,6 +31,16 @@ type TenantService interface {
 	// Returns either the tenant information or error if something goes wrong.
 	ReadTenant(tenantID system.UUID) (domain.Tenant, error)
 
+	// ReadAllTenants retrieves the list of created tenants.
+	// Returns either the list of created tenants, keyed by tenant unique identifier, or error if something goes wrong.
+	ReadAllTenants() (map[system.UUID]domain.Tenant, error)
+
+	// ReadTenants retrieves the provided tenants at once.
+	// tenantIDs: Mandatory: The unique identifiers of the tenants to retrieve.
+	// Returns either the tenants found, keyed by tenant unique identifier, or error if something goes wrong. The tenants
+	// that do not exist are left out.
+	ReadTenants(tenantIDs []system.UUID) (map[system.UUID]domain.Tenant, error)
+
 	// DeleteTenant deletes an existing tenant information.
 	// tenantID: Mandatory: The unique identifier of the existing tenant to remove.
 	// Returns error if something goes wrong.
@@ -53,9 +70,52 @@ type TenantService interface {
 	// Returns either the list of created applications for the provided tenant or error if something goes wrong.
 	ReadAllApplications(tenantID system.UUID) (map[system.UUID]domain.Application, error)
 
+	// ReadApplicationsOfTenants retrieves the list of created applications of the provided tenants at once.
+	// tenantIDs: Mandatory: The unique identifiers of the tenants to retrieve the applications of.
+	// Returns either the list of created applications of every tenant found, keyed by tenant unique identifier, or error if
+	// something goes wrong. The tenants that do not exist are left out.
+	ReadApplicationsOfTenants(tenantIDs []system.UUID) (map[system.UUID]map[system.UUID]domain.Application, error)
+
 	// DeleteApplication deletes an existing tenant application information.
 	// tenantID: Mandatory: The unique identifier of the existing tenant to remove.
 	// applicationID: Mandatory: The unique identifier of the existing application.
 	// Returns error if something goes wrong.
 	DeleteApplication(tenantID system.UUID, applicationID system.UUID) error
+
+	// CreateApplications creates new applications for the provided tenant.
+	// tenantID: Mandatory. The unique identifier of the tenant to create the applications for.
+	// applications: Mandatory. The new applications to create for the provided tenant.
+	// allOrNothing: Mandatory. If true, no application is created when any of the applications is invalid.
+	// Returns either the result of every application, in the order of the provided applications, or error if something goes wrong.
+	// When allOrNothing is set and any application fails, none is created and only the failed applications carry an error.
+	CreateApplications(tenantID system.UUID, applications []domain.Application, allOrNothing bool) ([]domain.ApplicationResult, error)
+
+	// UpdateApplications updates existing tenant applications.
+	// tenantID: Mandatory: The unique identifier of the existing tenant.
+	// applications: Mandatory. The updated applications information, keyed by application unique identifier.
+	// allOrNothing: Mandatory. If true, no application is updated when any of the applications cannot be updated.
+	// Returns either the errors of the applications that could not be updated, keyed by application unique identifier, or error if something goes wrong.
+	UpdateApplications(tenantID system.UUID, applications map[system.UUID]domain.Application, allOrNothing bool) (map[system.UUID]error, error)
+
+	// DeleteApplications deletes existing tenant applications.
+	// tenantID: Mandatory: The unique identifier of the existing tenant.
+	// applicationIDs: Mandatory: The unique identifiers of the existing applications to remove.
+	// allOrNothing: Mandatory. If true, no application is deleted when any of the applications cannot be deleted.
+	// Returns either the errors of the applications that could not be deleted, keyed by application unique identifier, or error if something goes wrong.
+	DeleteApplications(tenantID system.UUID, applicationIDs []system.UUID, allOrNothing bool) (map[system.UUID]error, error)
+
+	// CountTenantsAndApplications counts the tenants and the applications of all tenants.
+	// Returns either the number of tenants and the number of applications or error if something goes wrong.
+	CountTenantsAndApplications() (int, int, error)
+
+	// CheckHealth checks the Cassandra cluster can be reached and its keyspace holds the tables the service requires.
+	// Returns error if the data store cannot serve the requests.
+	CheckHealth() error
+
+	// WithContext returns a copy of the tenant service bound to the provided request context: the copy writes its logs to the
+	// logger carried by the context, e.g. a logger carrying the correlation identifier of the request being served, and
+	// records its spans as children of the span carried by the context.
+	// ctx: Mandatory. The context of the request being served.
+	// Returns the tenant service bound to the provided context.
+	WithContext(ctx context.Context) TenantService
 }
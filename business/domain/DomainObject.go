@@ -1,6 +1,8 @@
 // Package domain defines domain object used in Tenant service
 package domain
 
+import "github.com/micro-business/Micro-Business-Core/system"
+
 // Tenant defines how a tenant should look like
 type Tenant struct {
 	SecretKey string
@@ -10,3 +12,12 @@ type Tenant struct {
 type Application struct {
 	Name string
 }
+
+// ApplicationResult defines the outcome of a single application of a bulk operation
+type ApplicationResult struct {
+	// ApplicationID is the unique identifier of the application. Empty if the application could not be created.
+	ApplicationID system.UUID
+
+	// Err is the error that prevented the operation, nil if the operation succeeded or was not applied.
+	Err error
+}
@@ -0,0 +1,91 @@
+package event
+
+import (
+	"sync"
+
+	"github.com/micro-business/Micro-Business-Core/common/diagnostics"
+)
+
+// Bus is an in-process publisher that delivers every published event to all the current subscriptions. Publishing never
+// blocks: a subscription whose buffer is full is closed and flagged as overflowed, so a slow subscriber cannot hold back the
+// publisher or the other subscribers and knows it missed events.
+type Bus struct {
+	mutex         sync.Mutex
+	subscriptions map[*Subscription]struct{}
+}
+
+// Subscription receives the events published on the bus after it is created.
+type Subscription struct {
+	bus        *Bus
+	events     chan Event
+	overflowed bool
+}
+
+// NewBus creates a new event bus without any subscription.
+func NewBus() *Bus {
+	return &Bus{subscriptions: make(map[*Subscription]struct{})}
+}
+
+// Publish delivers the provided event to all the current subscriptions without blocking.
+// event: Mandatory. The event to deliver.
+func (bus *Bus) Publish(event Event) {
+	bus.mutex.Lock()
+	defer bus.mutex.Unlock()
+
+	for subscription := range bus.subscriptions {
+		select {
+		case subscription.events <- event:
+		default:
+			subscription.overflowed = true
+			bus.remove(subscription)
+		}
+	}
+}
+
+// Subscribe creates a new subscription that receives the events published from now on.
+// bufferSize: Mandatory. The number of events the subscription can hold before it overflows.
+// Returns the new subscription.
+func (bus *Bus) Subscribe(bufferSize int) *Subscription {
+	if bufferSize <= 0 {
+		panic("bufferSize must be greater than zero.")
+	}
+
+	bus.mutex.Lock()
+	defer bus.mutex.Unlock()
+
+	subscription := &Subscription{bus: bus, events: make(chan Event, bufferSize)}
+	bus.subscriptions[subscription] = struct{}{}
+
+	return subscription
+}
+
+// remove closes the provided subscription and stops delivering events to it. The caller must hold the bus mutex.
+func (bus *Bus) remove(subscription *Subscription) {
+	if _, subscribed := bus.subscriptions[subscription]; subscribed {
+		delete(bus.subscriptions, subscription)
+		close(subscription.events)
+	}
+}
+
+// Events returns the channel the events are delivered on. The channel is closed when the subscription is closed or overflowed.
+func (subscription *Subscription) Events() <-chan Event {
+	return subscription.events
+}
+
+// Overflowed checks whether the subscription was closed because its buffer was full when an event was published.
+func (subscription *Subscription) Overflowed() bool {
+	subscription.bus.mutex.Lock()
+	defer subscription.bus.mutex.Unlock()
+
+	return subscription.overflowed
+}
+
+// Close stops delivering events to the subscription. Closing an already closed subscription has no effect.
+func (subscription *Subscription) Close() {
+	diagnostics.IsNotNil(subscription.bus, "subscription.bus", "Subscription must be created using Bus.Subscribe.")
+
+	subscription.bus.mutex.Lock()
+	defer subscription.bus.mutex.Unlock()
+
+	subscription.bus.remove(subscription)
+}
@@ -0,0 +1,73 @@
+package event_test
+
+import (
+	"testing"
+
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/event"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bus behaviour", func() {
+	var (
+		bus         *event.Bus
+		tenantEvent event.Event
+	)
+
+	BeforeEach(func() {
+		bus = event.NewBus()
+
+		tenantID, _ := system.RandomUUID()
+		tenantEvent = event.Event{Type: event.TenantCreated, TenantID: tenantID}
+	})
+
+	It("should deliver the published event to every subscription", func() {
+		first := bus.Subscribe(1)
+		second := bus.Subscribe(1)
+
+		bus.Publish(tenantEvent)
+
+		Expect(first.Events()).To(Receive(Equal(tenantEvent)))
+		Expect(second.Events()).To(Receive(Equal(tenantEvent)))
+	})
+
+	It("should not deliver the events published after the subscription is closed", func() {
+		subscription := bus.Subscribe(1)
+		subscription.Close()
+
+		bus.Publish(tenantEvent)
+
+		Eventually(subscription.Events()).Should(BeClosed())
+		Expect(subscription.Overflowed()).To(BeFalse())
+	})
+
+	It("should close the subscription that cannot keep up without blocking the other subscriptions", func() {
+		slow := bus.Subscribe(1)
+		fast := bus.Subscribe(2)
+
+		bus.Publish(tenantEvent)
+		bus.Publish(tenantEvent)
+
+		Expect(slow.Overflowed()).To(BeTrue())
+		Expect(slow.Events()).To(Receive(Equal(tenantEvent)))
+		Expect(slow.Events()).To(BeClosed())
+
+		Expect(fast.Overflowed()).To(BeFalse())
+		Expect(fast.Events()).To(Receive(Equal(tenantEvent)))
+		Expect(fast.Events()).To(Receive(Equal(tenantEvent)))
+	})
+
+	It("should allow closing a subscription multiple times", func() {
+		subscription := bus.Subscribe(1)
+
+		subscription.Close()
+
+		Expect(func() { subscription.Close() }).NotTo(Panic())
+	})
+})
+
+func TestBus(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Bus behaviour")
+}
@@ -0,0 +1,60 @@
+// Package event defines the change events raised by the tenant service and the in-process bus that delivers them.
+package event
+
+import (
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+)
+
+// Type defines the kind of change an event reports
+type Type string
+
+const (
+	// TenantCreated is raised after a new tenant is created.
+	TenantCreated Type = "TENANT_CREATED"
+
+	// TenantUpdated is raised after an existing tenant is updated.
+	TenantUpdated Type = "TENANT_UPDATED"
+
+	// TenantDeleted is raised after an existing tenant is deleted.
+	TenantDeleted Type = "TENANT_DELETED"
+
+	// ApplicationCreated is raised after a new application is created.
+	ApplicationCreated Type = "APPLICATION_CREATED"
+
+	// ApplicationUpdated is raised after an existing application is updated.
+	ApplicationUpdated Type = "APPLICATION_UPDATED"
+
+	// ApplicationDeleted is raised after an existing application is deleted.
+	ApplicationDeleted Type = "APPLICATION_DELETED"
+)
+
+// Event defines a change made to a tenant or to one of its applications
+type Event struct {
+	// Type is the kind of change.
+	Type Type
+
+	// TenantID is the unique identifier of the changed tenant, or of the tenant that owns the changed application.
+	TenantID system.UUID
+
+	// ApplicationID is the unique identifier of the changed application. Empty for tenant events.
+	ApplicationID system.UUID
+
+	// Tenant is the tenant information after the change. Empty for application events and deleted tenants.
+	Tenant domain.Tenant
+
+	// Application is the application information after the change. Empty for tenant events and deleted applications.
+	Application domain.Application
+}
+
+// IsApplicationEvent checks whether the event reports a change made to an application.
+func (event Event) IsApplicationEvent() bool {
+	return event.Type == ApplicationCreated || event.Type == ApplicationUpdated || event.Type == ApplicationDeleted
+}
+
+// Publisher defines the interface used to publish the change events.
+type Publisher interface {
+	// Publish delivers the provided event to the current subscribers. Publish must not block the caller.
+	// event: Mandatory. The event to deliver.
+	Publish(event Event)
+}
@@ -7,6 +7,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	system "github.com/micro-business/Micro-Business-Core/system"
 	. "github.com/micro-business/TenantService/data/contract"
+	context "golang.org/x/net/context"
 )
 
 // Mock of TenantDataService interface
@@ -62,6 +63,28 @@ func (_mr *_MockTenantDataServiceRecorder) ReadTenant(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReadTenant", arg0)
 }
 
+func (_m *MockTenantDataService) ReadAllTenants() (map[system.UUID]Tenant, error) {
+	ret := _m.ctrl.Call(_m, "ReadAllTenants")
+	ret0, _ := ret[0].(map[system.UUID]Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockTenantDataServiceRecorder) ReadAllTenants() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReadAllTenants")
+}
+
+func (_m *MockTenantDataService) ReadTenants(tenantIDs []system.UUID) (map[system.UUID]Tenant, error) {
+	ret := _m.ctrl.Call(_m, "ReadTenants", tenantIDs)
+	ret0, _ := ret[0].(map[system.UUID]Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockTenantDataServiceRecorder) ReadTenants(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReadTenants", arg0)
+}
+
 func (_m *MockTenantDataService) DeleteTenant(tenantID system.UUID) error {
 	ret := _m.ctrl.Call(_m, "DeleteTenant", tenantID)
 	ret0, _ := ret[0].(error)
@@ -115,6 +138,17 @@ func (_mr *_MockTenantDataServiceRecorder) ReadAllApplications(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReadAllApplications", arg0)
 }
 
+func (_m *MockTenantDataService) ReadApplicationsOfTenants(tenantIDs []system.UUID) (map[system.UUID]map[system.UUID]Application, error) {
+	ret := _m.ctrl.Call(_m, "ReadApplicationsOfTenants", tenantIDs)
+	ret0, _ := ret[0].(map[system.UUID]map[system.UUID]Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockTenantDataServiceRecorder) ReadApplicationsOfTenants(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReadApplicationsOfTenants", arg0)
+}
+
 func (_m *MockTenantDataService) DeleteApplication(tenantID system.UUID, applicationID system.UUID) error {
 	ret := _m.ctrl.Call(_m, "DeleteApplication", tenantID, applicationID)
 	ret0, _ := ret[0].(error)
@@ -124,3 +158,68 @@ func (_m *MockTenantDataService) DeleteApplication(tenantID system.UUID, applica
 func (_mr *_MockTenantDataServiceRecorder) DeleteApplication(arg0, arg1 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteApplication", arg0, arg1)
 }
+
+func (_m *MockTenantDataService) CreateApplications(tenantID system.UUID, applications []Application) ([]system.UUID, error) {
+	ret := _m.ctrl.Call(_m, "CreateApplications", tenantID, applications)
+	ret0, _ := ret[0].([]system.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockTenantDataServiceRecorder) CreateApplications(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateApplications", arg0, arg1)
+}
+
+func (_m *MockTenantDataService) UpdateApplications(tenantID system.UUID, applications map[system.UUID]Application, allOrNothing bool) (map[system.UUID]error, error) {
+	ret := _m.ctrl.Call(_m, "UpdateApplications", tenantID, applications, allOrNothing)
+	ret0, _ := ret[0].(map[system.UUID]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockTenantDataServiceRecorder) UpdateApplications(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateApplications", arg0, arg1, arg2)
+}
+
+func (_m *MockTenantDataService) DeleteApplications(tenantID system.UUID, applicationIDs []system.UUID, allOrNothing bool) (map[system.UUID]error, error) {
+	ret := _m.ctrl.Call(_m, "DeleteApplications", tenantID, applicationIDs, allOrNothing)
+	ret0, _ := ret[0].(map[system.UUID]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockTenantDataServiceRecorder) DeleteApplications(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteApplications", arg0, arg1, arg2)
+}
+
+func (_m *MockTenantDataService) CheckHealth() error {
+	ret := _m.ctrl.Call(_m, "CheckHealth")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockTenantDataServiceRecorder) CheckHealth() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CheckHealth")
+}
+
+func (_m *MockTenantDataService) CountTenantsAndApplications() (int, int, error) {
+	ret := _m.ctrl.Call(_m, "CountTenantsAndApplications")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+func (_mr *_MockTenantDataServiceRecorder) CountTenantsAndApplications() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CountTenantsAndApplications")
+}
+
+func (_m *MockTenantDataService) WithContext(ctx context.Context) TenantDataService {
+	ret := _m.ctrl.Call(_m, "WithContext", ctx)
+	ret0, _ := ret[0].(TenantDataService)
+	return ret0
+}
+
+func (_mr *_MockTenantDataServiceRecorder) WithContext(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WithContext", arg0)
+}
@@ -1,15 +1,49 @@
 package service
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
 	"github.com/micro-business/Micro-Business-Core/common/diagnostics"
 	"github.com/micro-business/Micro-Business-Core/system"
+	businessContract "github.com/micro-business/TenantService/business/contract"
 	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/business/event"
 	"github.com/micro-business/TenantService/data/contract"
+	"github.com/micro-business/TenantService/endpoint/security"
+	"github.com/micro-business/TenantService/idempotency"
+	"github.com/micro-business/TenantService/logging"
+	"github.com/micro-business/TenantService/tracing"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
 )
 
+// completeAttempts is how many times the result of an idempotent request is recorded before giving up.
+const completeAttempts = 3
+
+// completeRetryInterval is how long to wait before recording the result of an idempotent request again.
+const completeRetryInterval = 100 * time.Millisecond
+
 // TenantService provides access to add new tenant and update/retrieve/remove an existing tenant.
 type TenantService struct {
 	TenantDataService contract.TenantDataService
+
+	// EventPublisher is optional. When provided, a change event is published after every successful mutation.
+	EventPublisher event.Publisher
+
+	// Logger is optional. When provided, every successful mutation is logged. The logger carried by the context the
+	// tenant service is bound to, see WithContext, takes precedence.
+	Logger log.Logger
+
+	// IdempotencyStore is optional. When provided, the tenants and applications created with an idempotency key, carried
+	// by the context the tenant service is bound to, are recorded so a retry returns the unique identifier created first.
+	IdempotencyStore idempotency.Store
+
+	ctx context.Context
 }
 
 // CreateTenant creates a new tenant.
@@ -18,9 +52,30 @@ type TenantService struct {
 func (tenantService TenantService) CreateTenant(tenant domain.Tenant) (system.UUID, error) {
 	diagnostics.IsNotNil(tenantService.TenantDataService, "tenantService.TenantDataServic", "TenantDataService must be provided.")
 
+	tenantDataService, span := tenantService.startSpan("CreateTenant")
+	defer span.End()
+
 	validateTenant(tenant)
 
-	return tenantService.TenantDataService.CreateTenant(mapToDataTenant(tenant))
+	tenantID, err := tenantService.createIdempotently(
+		"CreateTenant",
+		"",
+		idempotency.Fingerprint("CreateTenant", tenant.SecretKey),
+		func() (system.UUID, error) {
+			tenantID, err := tenantDataService.CreateTenant(mapToDataTenant(tenant))
+
+			if err == nil {
+				tenantService.publish(event.Event{Type: event.TenantCreated, TenantID: tenantID, Tenant: tenant})
+			}
+
+			return tenantID, err
+		})
+
+	if err != nil {
+		return system.EmptyUUID, tracing.Fail(span, err)
+	}
+
+	return tenantID, nil
 }
 
 // UpdateTenant updates an existing tenant.
@@ -31,9 +86,18 @@ func (tenantService TenantService) UpdateTenant(tenantID system.UUID, tenant dom
 	diagnostics.IsNotNil(tenantService.TenantDataService, "tenantService.TenantDataServic", "TenantDataService must be provided.")
 	diagnostics.IsNotNilOrEmpty(tenantID, "tenantID", "tenantID must be provided.")
 
+	tenantDataService, span := tenantService.startSpan("UpdateTenant")
+	defer span.End()
+
 	validateTenant(tenant)
 
-	return tenantService.TenantDataService.UpdateTenant(tenantID, mapToDataTenant(tenant))
+	if err := tenantDataService.UpdateTenant(tenantID, mapToDataTenant(tenant)); err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	tenantService.publish(event.Event{Type: event.TenantUpdated, TenantID: tenantID, Tenant: tenant})
+
+	return nil
 }
 
 // ReadTenant retrieves an existing tenant.
@@ -43,15 +107,70 @@ func (tenantService TenantService) ReadTenant(tenantID system.UUID) (domain.Tena
 	diagnostics.IsNotNil(tenantService.TenantDataService, "tenantService.TenantDataServic", "TenantDataService must be provided.")
 	diagnostics.IsNotNilOrEmpty(tenantID, "tenantID", "tenantID must be provided.")
 
-	tenant, err := tenantService.TenantDataService.ReadTenant(tenantID)
+	tenantDataService, span := tenantService.startSpan("ReadTenant")
+	defer span.End()
+
+	tenant, err := tenantDataService.ReadTenant(tenantID)
 
 	if err != nil {
-		return domain.Tenant{}, err
+		return domain.Tenant{}, tracing.Fail(span, err)
 	}
 
 	return mapFromDataTenant(tenant), nil
 }
 
+// ReadAllTenants retrieves the list of created tenants.
+// Returns either the list of created tenants, keyed by tenant unique identifier, or error if something goes wrong.
+func (tenantService TenantService) ReadAllTenants() (map[system.UUID]domain.Tenant, error) {
+	diagnostics.IsNotNil(tenantService.TenantDataService, "tenantService.TenantDataServic", "TenantDataService must be provided.")
+
+	tenantDataService, span := tenantService.startSpan("ReadAllTenants")
+	defer span.End()
+
+	returnedTenants, err := tenantDataService.ReadAllTenants()
+
+	if err != nil {
+		return nil, tracing.Fail(span, err)
+	}
+
+	tenants := make(map[system.UUID]domain.Tenant)
+
+	for tenantID, tenant := range returnedTenants {
+		tenants[tenantID] = mapFromDataTenant(tenant)
+	}
+
+	return tenants, nil
+}
+
+// ReadTenants retrieves the provided tenants at once.
+// tenantIDs: Mandatory: The unique identifiers of the tenants to retrieve.
+// Returns either the tenants found, keyed by tenant unique identifier, or error if something goes wrong. The tenants
+// that do not exist are left out.
+func (tenantService TenantService) ReadTenants(tenantIDs []system.UUID) (map[system.UUID]domain.Tenant, error) {
+	diagnostics.IsNotNil(tenantService.TenantDataService, "tenantService.TenantDataServic", "TenantDataService must be provided.")
+
+	for _, tenantID := range tenantIDs {
+		diagnostics.IsNotNilOrEmpty(tenantID, "tenantID", "tenantID must be provided.")
+	}
+
+	tenantDataService, span := tenantService.startSpan("ReadTenants")
+	defer span.End()
+
+	returnedTenants, err := tenantDataService.ReadTenants(tenantIDs)
+
+	if err != nil {
+		return nil, tracing.Fail(span, err)
+	}
+
+	tenants := make(map[system.UUID]domain.Tenant)
+
+	for tenantID, tenant := range returnedTenants {
+		tenants[tenantID] = mapFromDataTenant(tenant)
+	}
+
+	return tenants, nil
+}
+
 // DeleteTenant deletes an existing tenant information.
 // tenantID: Mandatory: The unique identifier of the existing tenant to remove.
 // Returns error if something goes wrong.
@@ -59,7 +178,16 @@ func (tenantService TenantService) DeleteTenant(tenantID system.UUID) error {
 	diagnostics.IsNotNil(tenantService.TenantDataService, "tenantService.TenantDataServic", "TenantDataService must be provided.")
 	diagnostics.IsNotNilOrEmpty(tenantID, "tenantID", "tenantID must be provided.")
 
-	return tenantService.TenantDataService.DeleteTenant(tenantID)
+	tenantDataService, span := tenantService.startSpan("DeleteTenant")
+	defer span.End()
+
+	if err := tenantDataService.DeleteTenant(tenantID); err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	tenantService.publish(event.Event{Type: event.TenantDeleted, TenantID: tenantID})
+
+	return nil
 }
 
 // CreateApplication creates new application for the provided tenant.
@@ -70,9 +198,30 @@ func (tenantService TenantService) CreateApplication(tenantID system.UUID, appli
 	diagnostics.IsNotNil(tenantService.TenantDataService, "tenantService.TenantDataServic", "TenantDataService must be provided.")
 	diagnostics.IsNotNilOrEmpty(tenantID, "tenantID", "tenantID must be provided.")
 
+	tenantDataService, span := tenantService.startSpan("CreateApplication")
+	defer span.End()
+
 	validateApplication(application)
 
-	return tenantService.TenantDataService.CreateApplication(tenantID, mapToDataApplication(application))
+	applicationID, err := tenantService.createIdempotently(
+		"CreateApplication",
+		tenantID.String(),
+		idempotency.Fingerprint("CreateApplication", tenantID.String(), application.Name),
+		func() (system.UUID, error) {
+			applicationID, err := tenantDataService.CreateApplication(tenantID, mapToDataApplication(application))
+
+			if err == nil {
+				tenantService.publish(event.Event{Type: event.ApplicationCreated, TenantID: tenantID, ApplicationID: applicationID, Application: application})
+			}
+
+			return applicationID, err
+		})
+
+	if err != nil {
+		return system.EmptyUUID, tracing.Fail(span, err)
+	}
+
+	return applicationID, nil
 }
 
 // UpdateApplication updates an existing tenant application.
@@ -85,9 +234,18 @@ func (tenantService TenantService) UpdateApplication(tenantID system.UUID, appli
 	diagnostics.IsNotNilOrEmpty(tenantID, "tenantID", "tenantID must be provided.")
 	diagnostics.IsNotNilOrEmpty(applicationID, "applicationID", "applicationID must be provided.")
 
+	tenantDataService, span := tenantService.startSpan("UpdateApplication")
+	defer span.End()
+
 	validateApplication(application)
 
-	return tenantService.TenantDataService.UpdateApplication(tenantID, applicationID, mapToDataApplication(application))
+	if err := tenantDataService.UpdateApplication(tenantID, applicationID, mapToDataApplication(application)); err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	tenantService.publish(event.Event{Type: event.ApplicationUpdated, TenantID: tenantID, ApplicationID: applicationID, Application: application})
+
+	return nil
 }
 
 // ReadApplication retrieves an existing tenant information.
@@ -99,10 +257,13 @@ func (tenantService TenantService) ReadApplication(tenantID system.UUID, applica
 	diagnostics.IsNotNilOrEmpty(tenantID, "tenantID", "tenantID must be provided.")
 	diagnostics.IsNotNilOrEmpty(applicationID, "applicationID", "applicationID must be provided.")
 
-	application, err := tenantService.TenantDataService.ReadApplication(tenantID, applicationID)
+	tenantDataService, span := tenantService.startSpan("ReadApplication")
+	defer span.End()
+
+	application, err := tenantDataService.ReadApplication(tenantID, applicationID)
 
 	if err != nil {
-		return domain.Application{}, err
+		return domain.Application{}, tracing.Fail(span, err)
 	}
 
 	return mapFromDataApplication(application), nil
@@ -115,10 +276,13 @@ func (tenantService TenantService) ReadAllApplications(tenantID system.UUID) (ma
 	diagnostics.IsNotNil(tenantService.TenantDataService, "tenantService.TenantDataServic", "TenantDataService must be provided.")
 	diagnostics.IsNotNilOrEmpty(tenantID, "tenantID", "tenantID must be provided.")
 
-	returnedApplications, err := tenantService.TenantDataService.ReadAllApplications(tenantID)
+	tenantDataService, span := tenantService.startSpan("ReadAllApplications")
+	defer span.End()
+
+	returnedApplications, err := tenantDataService.ReadAllApplications(tenantID)
 
 	if err != nil {
-		return nil, err
+		return nil, tracing.Fail(span, err)
 	}
 
 	applications := make(map[system.UUID]domain.Application)
@@ -130,6 +294,41 @@ func (tenantService TenantService) ReadAllApplications(tenantID system.UUID) (ma
 	return applications, nil
 }
 
+// ReadApplicationsOfTenants retrieves the list of created applications of the provided tenants at once.
+// tenantIDs: Mandatory: The unique identifiers of the tenants to retrieve the applications of.
+// Returns either the list of created applications of every tenant found, keyed by tenant unique identifier, or error if
+// something goes wrong. The tenants that do not exist are left out.
+func (tenantService TenantService) ReadApplicationsOfTenants(tenantIDs []system.UUID) (map[system.UUID]map[system.UUID]domain.Application, error) {
+	diagnostics.IsNotNil(tenantService.TenantDataService, "tenantService.TenantDataServic", "TenantDataService must be provided.")
+
+	for _, tenantID := range tenantIDs {
+		diagnostics.IsNotNilOrEmpty(tenantID, "tenantID", "tenantID must be provided.")
+	}
+
+	tenantDataService, span := tenantService.startSpan("ReadApplicationsOfTenants")
+	defer span.End()
+
+	returnedApplicationsOfTenants, err := tenantDataService.ReadApplicationsOfTenants(tenantIDs)
+
+	if err != nil {
+		return nil, tracing.Fail(span, err)
+	}
+
+	applicationsOfTenants := make(map[system.UUID]map[system.UUID]domain.Application)
+
+	for tenantID, returnedApplications := range returnedApplicationsOfTenants {
+		applications := make(map[system.UUID]domain.Application)
+
+		for applicationID, application := range returnedApplications {
+			applications[applicationID] = mapFromDataApplication(application)
+		}
+
+		applicationsOfTenants[tenantID] = applications
+	}
+
+	return applicationsOfTenants, nil
+}
+
 // DeleteApplication deletes an existing tenant application information.
 // tenantID: Mandatory: The unique identifier of the existing tenant to remove.
 // applicationID: Mandatory: The unique identifier of the existing application.
@@ -139,7 +338,283 @@ func (tenantService TenantService) DeleteApplication(tenantID system.UUID, appli
 	diagnostics.IsNotNilOrEmpty(tenantID, "tenantID", "tenantID must be provided.")
 	diagnostics.IsNotNilOrEmpty(applicationID, "applicationID", "applicationID must be provided.")
 
-	return tenantService.TenantDataService.DeleteApplication(tenantID, applicationID)
+	tenantDataService, span := tenantService.startSpan("DeleteApplication")
+	defer span.End()
+
+	if err := tenantDataService.DeleteApplication(tenantID, applicationID); err != nil {
+		return tracing.Fail(span, err)
+	}
+
+	tenantService.publish(event.Event{Type: event.ApplicationDeleted, TenantID: tenantID, ApplicationID: applicationID})
+
+	return nil
+}
+
+// CreateApplications creates new applications for the provided tenant.
+// tenantID: Mandatory. The unique identifier of the tenant to create the applications for.
+// applications: Mandatory. The new applications to create for the provided tenant.
+// allOrNothing: Mandatory. If true, no application is created when any of the applications is invalid.
+// Returns either the result of every application, in the order of the provided applications, or error if something goes wrong.
+// When allOrNothing is set and any application fails, none is created and only the failed applications carry an error.
+func (tenantService TenantService) CreateApplications(tenantID system.UUID, applications []domain.Application, allOrNothing bool) ([]domain.ApplicationResult, error) {
+	diagnostics.IsNotNil(tenantService.TenantDataService, "tenantService.TenantDataServic", "TenantDataService must be provided.")
+	diagnostics.IsNotNilOrEmpty(tenantID, "tenantID", "tenantID must be provided.")
+
+	tenantDataService, span := tenantService.startSpan("CreateApplications")
+	defer span.End()
+
+	results := make([]domain.ApplicationResult, len(applications))
+	validApplications := make([]contract.Application, 0, len(applications))
+	validApplicationIndexes := make([]int, 0, len(applications))
+
+	for index, application := range applications {
+		if err := checkApplication(application); err != nil {
+			results[index].Err = err
+
+			continue
+		}
+
+		validApplications = append(validApplications, mapToDataApplication(application))
+		validApplicationIndexes = append(validApplicationIndexes, index)
+	}
+
+	if (allOrNothing && len(validApplications) != len(applications)) || len(validApplications) == 0 {
+		return results, nil
+	}
+
+	applicationIDs, err := tenantDataService.CreateApplications(tenantID, validApplications)
+
+	if err != nil {
+		return nil, tracing.Fail(span, err)
+	}
+
+	for validIndex, index := range validApplicationIndexes {
+		results[index].ApplicationID = applicationIDs[validIndex]
+
+		tenantService.publish(event.Event{Type: event.ApplicationCreated, TenantID: tenantID, ApplicationID: applicationIDs[validIndex], Application: applications[index]})
+	}
+
+	return results, nil
+}
+
+// UpdateApplications updates existing tenant applications.
+// tenantID: Mandatory: The unique identifier of the existing tenant.
+// applications: Mandatory. The updated applications information, keyed by application unique identifier.
+// allOrNothing: Mandatory. If true, no application is updated when any of the applications cannot be updated.
+// Returns either the errors of the applications that could not be updated, keyed by application unique identifier, or error if something goes wrong.
+func (tenantService TenantService) UpdateApplications(tenantID system.UUID, applications map[system.UUID]domain.Application, allOrNothing bool) (map[system.UUID]error, error) {
+	diagnostics.IsNotNil(tenantService.TenantDataService, "tenantService.TenantDataServic", "TenantDataService must be provided.")
+	diagnostics.IsNotNilOrEmpty(tenantID, "tenantID", "tenantID must be provided.")
+
+	tenantDataService, span := tenantService.startSpan("UpdateApplications")
+	defer span.End()
+
+	applicationErrors := make(map[system.UUID]error)
+	validApplications := make(map[system.UUID]contract.Application)
+
+	for applicationID, application := range applications {
+		if err := checkApplication(application); err != nil {
+			applicationErrors[applicationID] = err
+
+			continue
+		}
+
+		validApplications[applicationID] = mapToDataApplication(application)
+	}
+
+	if (allOrNothing && len(applicationErrors) != 0) || len(validApplications) == 0 {
+		return applicationErrors, nil
+	}
+
+	dataApplicationErrors, err := tenantDataService.UpdateApplications(tenantID, validApplications, allOrNothing)
+
+	if err != nil {
+		return nil, tracing.Fail(span, err)
+	}
+
+	if allOrNothing && len(dataApplicationErrors) != 0 {
+		return dataApplicationErrors, nil
+	}
+
+	for applicationID := range validApplications {
+		if applicationErr, failed := dataApplicationErrors[applicationID]; failed {
+			applicationErrors[applicationID] = applicationErr
+
+			continue
+		}
+
+		tenantService.publish(event.Event{Type: event.ApplicationUpdated, TenantID: tenantID, ApplicationID: applicationID, Application: applications[applicationID]})
+	}
+
+	return applicationErrors, nil
+}
+
+// DeleteApplications deletes existing tenant applications.
+// tenantID: Mandatory: The unique identifier of the existing tenant.
+// applicationIDs: Mandatory: The unique identifiers of the existing applications to remove.
+// allOrNothing: Mandatory. If true, no application is deleted when any of the applications cannot be deleted.
+// Returns either the errors of the applications that could not be deleted, keyed by application unique identifier, or error if something goes wrong.
+func (tenantService TenantService) DeleteApplications(tenantID system.UUID, applicationIDs []system.UUID, allOrNothing bool) (map[system.UUID]error, error) {
+	diagnostics.IsNotNil(tenantService.TenantDataService, "tenantService.TenantDataServic", "TenantDataService must be provided.")
+	diagnostics.IsNotNilOrEmpty(tenantID, "tenantID", "tenantID must be provided.")
+
+	tenantDataService, span := tenantService.startSpan("DeleteApplications")
+	defer span.End()
+
+	if len(applicationIDs) == 0 {
+		return map[system.UUID]error{}, nil
+	}
+
+	applicationErrors, err := tenantDataService.DeleteApplications(tenantID, applicationIDs, allOrNothing)
+
+	if err != nil {
+		return nil, tracing.Fail(span, err)
+	}
+
+	if allOrNothing && len(applicationErrors) != 0 {
+		return applicationErrors, nil
+	}
+
+	for _, applicationID := range applicationIDs {
+		if _, failed := applicationErrors[applicationID]; !failed {
+			tenantService.publish(event.Event{Type: event.ApplicationDeleted, TenantID: tenantID, ApplicationID: applicationID})
+		}
+	}
+
+	return applicationErrors, nil
+}
+
+// CountTenantsAndApplications counts the tenants and the applications of all tenants.
+// Returns either the number of tenants and the number of applications or error if something goes wrong.
+func (tenantService TenantService) CountTenantsAndApplications() (int, int, error) {
+	diagnostics.IsNotNil(tenantService.TenantDataService, "tenantService.TenantDataServic", "TenantDataService must be provided.")
+
+	return tenantService.TenantDataService.CountTenantsAndApplications()
+}
+
+// CheckHealth checks the Cassandra cluster can be reached and its keyspace holds the tables the service requires. The
+// check stops once the context the tenant service is bound to is done, see WithContext.
+// Returns error if the data store cannot serve the requests.
+func (tenantService TenantService) CheckHealth() error {
+	diagnostics.IsNotNil(tenantService.TenantDataService, "tenantService.TenantDataServic", "TenantDataService must be provided.")
+
+	return tenantService.TenantDataService.WithContext(tenantService.context()).CheckHealth()
+}
+
+// WithContext returns a copy of the tenant service bound to the provided request context. The copy writes its logs to
+// the logger carried by the context, see logging.FromContext, and records its spans as children of the span carried by
+// the context.
+// ctx: Mandatory. The context of the request being served.
+// Returns the tenant service bound to the provided context.
+func (tenantService TenantService) WithContext(ctx context.Context) businessContract.TenantService {
+	tenantService.ctx = ctx
+
+	return tenantService
+}
+
+// context returns the context the tenant service is bound to, the background context if it is not bound to any.
+func (tenantService TenantService) context() context.Context {
+	if tenantService.ctx == nil {
+		return context.Background()
+	}
+
+	return tenantService.ctx
+}
+
+// logger returns the logger carried by the bound context, or the Logger of the tenant service if the context carries none.
+func (tenantService TenantService) logger() log.Logger {
+	if logger, ok := logging.FromContext(tenantService.context()); ok {
+		return logger
+	}
+
+	return logging.OrNop(tenantService.Logger)
+}
+
+// startSpan starts the span of the provided method as a child of the span carried by the bound context.
+// Returns the tenant data service bound to the new span, and the new span.
+func (tenantService TenantService) startSpan(methodName string) (contract.TenantDataService, trace.Span) {
+	ctx, span := tracing.Start(tenantService.context(), "TenantService."+methodName)
+
+	return tenantService.TenantDataService.WithContext(ctx), span
+}
+
+// createIdempotently creates a tenant or an application using the provided function. If the bound context carries an
+// idempotency key and an idempotency store is provided, the request is recorded with the key, and a retry with the same
+// key and fingerprint returns the unique identifier created first instead of calling the function again, so the change
+// event published by the function is published once. The key is
+// scoped to the authenticated caller, the operation and the tenant, so the keys chosen by different callers never refer
+// to the same request.
+// operation: Mandatory. The name of the operation creating the tenant or application.
+// tenantID: Optional. The unique identifier of the tenant the application is created for.
+// fingerprint: Mandatory. The fingerprint of the request, see idempotency.Fingerprint.
+// create: Mandatory. Creates the tenant or application, publishes the change and returns its unique identifier.
+// Returns either the unique identifier or error if something goes wrong, including idempotency.ConflictError if the key
+// is used by a different request, or if the result of the request cannot be recorded.
+func (tenantService TenantService) createIdempotently(operation string, tenantID string, fingerprint string, create func() (system.UUID, error)) (system.UUID, error) {
+	key, ok := idempotency.FromContext(tenantService.context())
+
+	if !ok || tenantService.IdempotencyStore == nil {
+		return create()
+	}
+
+	var caller string
+
+	if principal, authenticated := security.PrincipalFromContext(tenantService.context()); authenticated {
+		caller = principal.Subject
+	}
+
+	key = idempotency.ScopedKey(key, caller, operation, tenantID)
+
+	record, err := tenantService.IdempotencyStore.Reserve(key, fingerprint)
+
+	if err != nil {
+		return system.EmptyUUID, err
+	}
+
+	if record.Completed {
+		return system.ParseUUID(record.Result)
+	}
+
+	createdID, err := create()
+
+	if err != nil {
+		tenantService.IdempotencyStore.Release(key)
+
+		return system.EmptyUUID, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		if err = tenantService.IdempotencyStore.Complete(key, fingerprint, createdID.String()); err == nil {
+			return createdID, nil
+		}
+
+		level.Warn(tenantService.logger()).Log("msg", "Failed to record the result of the idempotent request.", "attempt", attempt, "err", err)
+
+		if attempt == completeAttempts {
+			return system.EmptyUUID, fmt.Errorf(
+				"%s succeeded but its result could not be recorded, the idempotency key cannot be used until its reservation expires. ID: %s",
+				operation,
+				createdID.String())
+		}
+
+		time.Sleep(completeRetryInterval)
+	}
+}
+
+// publish logs the provided change event and publishes it if an event publisher is provided. Only the unique identifiers
+// are logged, never the tenant or application information, as the tenant secret key must not be written to the logs.
+func (tenantService TenantService) publish(changeEvent event.Event) {
+	keyValues := []interface{}{"msg", "Change applied.", "change", string(changeEvent.Type), "tenant_id", changeEvent.TenantID.String()}
+
+	if changeEvent.IsApplicationEvent() {
+		keyValues = append(keyValues, "application_id", changeEvent.ApplicationID.String())
+	}
+
+	level.Info(tenantService.logger()).Log(keyValues...)
+
+	if tenantService.EventPublisher != nil {
+		tenantService.EventPublisher.Publish(changeEvent)
+	}
 }
 
 // validateTenant validates the tenant domain object and make sure the data is consistent and valid.
@@ -166,6 +641,16 @@ func validateApplication(application domain.Application) {
 	diagnostics.IsNotNilOrEmptyOrWhitespace(application.Name, "application.Name", "Name must be provided.")
 }
 
+// checkApplication checks the tenant application domain object the same way validateApplication does, but reports the
+// invalid application as an error so a single invalid application does not fail a bulk operation.
+func checkApplication(application domain.Application) error {
+	if len(strings.TrimSpace(application.Name)) == 0 {
+		return errors.New("Name must be provided.")
+	}
+
+	return nil
+}
+
 // mapToDataApplication Maps the domain tenant application object to the tenant application object used in data layer.
 // application: Mandatory. The tenant application domain object
 // Returns the converted tenant application object used in data layer
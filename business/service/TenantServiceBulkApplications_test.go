@@ -0,0 +1,149 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/business/service"
+	"github.com/micro-business/TenantService/data/contract"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bulk application methods behaviour", func() {
+	var (
+		mockCtrl              *gomock.Controller
+		tenantService         *service.TenantService
+		mockTenantDataService *MockTenantDataService
+		validTenantID         system.UUID
+		firstApplicationID    system.UUID
+		secondApplicationID   system.UUID
+		validApplication      domain.Application
+		invalidApplication    domain.Application
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
+
+		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
+
+		validTenantID, _ = system.RandomUUID()
+		firstApplicationID, _ = system.RandomUUID()
+		secondApplicationID, _ = system.RandomUUID()
+		validApplication = domain.Application{Name: "Application"}
+		invalidApplication = domain.Application{Name: "   "}
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Describe("CreateApplications", func() {
+		It("should panic if tenantID is empty", func() {
+			Ω(func() {
+				tenantService.CreateApplications(system.EmptyUUID, []domain.Application{validApplication}, false)
+			}).Should(Panic())
+		})
+
+		It("should create the valid applications and report the invalid ones in the provided order", func() {
+			mockTenantDataService.EXPECT().CreateApplications(validTenantID, []contract.Application{{Name: validApplication.Name}}).Return([]system.UUID{firstApplicationID}, nil)
+
+			results, err := tenantService.CreateApplications(validTenantID, []domain.Application{invalidApplication, validApplication}, false)
+
+			Expect(err).To(BeNil())
+			Expect(results).To(HaveLen(2))
+			Expect(results[0].Err).NotTo(BeNil())
+			Expect(results[1]).To(Equal(domain.ApplicationResult{ApplicationID: firstApplicationID}))
+		})
+
+		It("should not create any application if any application is invalid and allOrNothing is set", func() {
+			results, err := tenantService.CreateApplications(validTenantID, []domain.Application{invalidApplication, validApplication}, true)
+
+			Expect(err).To(BeNil())
+			Expect(results[0].Err).NotTo(BeNil())
+			Expect(results[1]).To(Equal(domain.ApplicationResult{}))
+		})
+
+		It("should return error if tenant data service returns error", func() {
+			expectedErr := errors.New("Failed")
+			mockTenantDataService.EXPECT().CreateApplications(validTenantID, gomock.Any()).Return(nil, expectedErr)
+
+			results, err := tenantService.CreateApplications(validTenantID, []domain.Application{validApplication}, false)
+
+			Expect(err).To(Equal(expectedErr))
+			Expect(results).To(BeNil())
+		})
+	})
+
+	Describe("UpdateApplications", func() {
+		It("should panic if tenantID is empty", func() {
+			Ω(func() {
+				tenantService.UpdateApplications(system.EmptyUUID, map[system.UUID]domain.Application{firstApplicationID: validApplication}, false)
+			}).Should(Panic())
+		})
+
+		It("should update the valid applications and report the invalid and failed ones", func() {
+			thirdApplicationID, _ := system.RandomUUID()
+			notFoundErr := errors.New("Not found")
+
+			mockTenantDataService.EXPECT().UpdateApplications(
+				validTenantID,
+				map[system.UUID]contract.Application{firstApplicationID: {Name: validApplication.Name}, thirdApplicationID: {Name: validApplication.Name}},
+				false).Return(map[system.UUID]error{thirdApplicationID: notFoundErr}, nil)
+
+			applicationErrors, err := tenantService.UpdateApplications(
+				validTenantID,
+				map[system.UUID]domain.Application{firstApplicationID: validApplication, secondApplicationID: invalidApplication, thirdApplicationID: validApplication},
+				false)
+
+			Expect(err).To(BeNil())
+			Expect(applicationErrors).To(HaveLen(2))
+			Expect(applicationErrors[secondApplicationID]).NotTo(BeNil())
+			Expect(applicationErrors[thirdApplicationID]).To(Equal(notFoundErr))
+		})
+
+		It("should not update any application if any application is invalid and allOrNothing is set", func() {
+			applicationErrors, err := tenantService.UpdateApplications(
+				validTenantID,
+				map[system.UUID]domain.Application{firstApplicationID: validApplication, secondApplicationID: invalidApplication},
+				true)
+
+			Expect(err).To(BeNil())
+			Expect(applicationErrors).To(HaveLen(1))
+			Expect(applicationErrors[secondApplicationID]).NotTo(BeNil())
+		})
+	})
+
+	Describe("DeleteApplications", func() {
+		It("should panic if tenantID is empty", func() {
+			Ω(func() { tenantService.DeleteApplications(system.EmptyUUID, []system.UUID{firstApplicationID}, false) }).Should(Panic())
+		})
+
+		It("should return the errors reported by tenant data service", func() {
+			notFoundErr := errors.New("Not found")
+			mockTenantDataService.EXPECT().DeleteApplications(validTenantID, []system.UUID{firstApplicationID, secondApplicationID}, true).Return(map[system.UUID]error{secondApplicationID: notFoundErr}, nil)
+
+			applicationErrors, err := tenantService.DeleteApplications(validTenantID, []system.UUID{firstApplicationID, secondApplicationID}, true)
+
+			Expect(err).To(BeNil())
+			Expect(applicationErrors).To(Equal(map[system.UUID]error{secondApplicationID: notFoundErr}))
+		})
+
+		It("should not call tenant data service if no application provided", func() {
+			applicationErrors, err := tenantService.DeleteApplications(validTenantID, []system.UUID{}, false)
+
+			Expect(err).To(BeNil())
+			Expect(applicationErrors).To(BeEmpty())
+		})
+	})
+})
+
+func TestBulkApplications(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Bulk application methods behaviour")
+}
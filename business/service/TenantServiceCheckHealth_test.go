@@ -0,0 +1,65 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/TenantService/business/service"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("CheckHealth method behaviour", func() {
+	var (
+		mockCtrl              *gomock.Controller
+		tenantService         *service.TenantService
+		mockTenantDataService *MockTenantDataService
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
+
+		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should panic when tenant data service not provided", func() {
+		tenantService.TenantDataService = nil
+
+		Ω(func() { tenantService.CheckHealth() }).Should(Panic())
+	})
+
+	It("should return the error returned by tenant data service", func() {
+		expectedError := errors.New("Failed")
+
+		mockTenantDataService.EXPECT().CheckHealth().Return(expectedError)
+
+		Expect(tenantService.CheckHealth()).To(Equal(expectedError))
+	})
+
+	It("should check the data store within the context the tenant service is bound to", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		boundTenantDataService := NewMockTenantDataService(mockCtrl)
+		tenantService.TenantDataService = boundTenantDataService
+
+		boundTenantDataService.EXPECT().WithContext(ctx).Return(mockTenantDataService)
+		mockTenantDataService.EXPECT().CheckHealth().Return(nil)
+
+		Expect(tenantService.WithContext(ctx).CheckHealth()).To(BeNil())
+	})
+})
+
+func TestCheckHealth(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CheckHealth method behaviour")
+}
@@ -0,0 +1,61 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/TenantService/business/service"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CountTenantsAndApplications method behaviour", func() {
+	var (
+		mockCtrl              *gomock.Controller
+		tenantService         *service.TenantService
+		mockTenantDataService *MockTenantDataService
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+
+		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should panic when tenant data service not provided", func() {
+		tenantService.TenantDataService = nil
+
+		Ω(func() { tenantService.CountTenantsAndApplications() }).Should(Panic())
+	})
+
+	It("should return the counts returned by tenant data service", func() {
+		mockTenantDataService.EXPECT().CountTenantsAndApplications().Return(3, 7, nil)
+
+		tenantCount, applicationCount, err := tenantService.CountTenantsAndApplications()
+
+		Expect(err).To(BeNil())
+		Expect(tenantCount).To(Equal(3))
+		Expect(applicationCount).To(Equal(7))
+	})
+
+	It("should return the error returned by tenant data service", func() {
+		expectedError := errors.New("Failed")
+
+		mockTenantDataService.EXPECT().CountTenantsAndApplications().Return(0, 0, expectedError)
+
+		_, _, err := tenantService.CountTenantsAndApplications()
+
+		Expect(err).To(Equal(expectedError))
+	})
+})
+
+func TestCountTenantsAndApplications(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CountTenantsAndApplications method behaviour")
+}
@@ -27,6 +27,7 @@ var _ = Describe("CreateApplication method input parameters and dependency test"
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
 
 		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
 
@@ -71,6 +72,7 @@ var _ = Describe("CreateApplication method behaviour", func() {
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
 
 		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
 
@@ -26,6 +26,7 @@ var _ = Describe("CreateTenant method input parameters and dependency test", fun
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
 
 		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
 
@@ -68,6 +69,7 @@ var _ = Describe("CreateTenant method behaviour", func() {
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
 
 		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
 
@@ -23,6 +23,7 @@ var _ = Describe("DeleteApplication method input parameters and dependency test"
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
 
 		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
 
@@ -65,6 +66,7 @@ var _ = Describe("DeleteApplication method behaviour", func() {
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
 
 		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
 
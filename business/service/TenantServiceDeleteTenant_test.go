@@ -22,6 +22,7 @@ var _ = Describe("DeleteTenant method input parameters and dependency test", fun
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
 
 		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
 
@@ -58,6 +59,7 @@ var _ = Describe("DeleteTenant method behaviour", func() {
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
 
 		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
 
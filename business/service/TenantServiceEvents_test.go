@@ -0,0 +1,122 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/business/event"
+	"github.com/micro-business/TenantService/business/service"
+	"github.com/micro-business/TenantService/data/contract"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Change events behaviour", func() {
+	var (
+		mockCtrl              *gomock.Controller
+		tenantService         *service.TenantService
+		mockTenantDataService *MockTenantDataService
+		subscription          *event.Subscription
+		validTenantID         system.UUID
+		validApplicationID    system.UUID
+		validTenant           domain.Tenant
+		validApplication      domain.Application
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
+
+		bus := event.NewBus()
+		subscription = bus.Subscribe(10)
+
+		tenantService = &service.TenantService{TenantDataService: mockTenantDataService, EventPublisher: bus}
+
+		validTenantID, _ = system.RandomUUID()
+		validApplicationID, _ = system.RandomUUID()
+		validTenant = domain.Tenant{SecretKey: "Secret Key"}
+		validApplication = domain.Application{Name: "Application"}
+	})
+
+	AfterEach(func() {
+		subscription.Close()
+		mockCtrl.Finish()
+	})
+
+	It("should publish tenant created event after the tenant is created", func() {
+		mockTenantDataService.EXPECT().CreateTenant(contract.Tenant{SecretKey: validTenant.SecretKey}).Return(validTenantID, nil)
+
+		tenantService.CreateTenant(validTenant)
+
+		Expect(subscription.Events()).To(Receive(Equal(event.Event{Type: event.TenantCreated, TenantID: validTenantID, Tenant: validTenant})))
+	})
+
+	It("should publish tenant updated event after the tenant is updated", func() {
+		mockTenantDataService.EXPECT().UpdateTenant(validTenantID, contract.Tenant{SecretKey: validTenant.SecretKey}).Return(nil)
+
+		tenantService.UpdateTenant(validTenantID, validTenant)
+
+		Expect(subscription.Events()).To(Receive(Equal(event.Event{Type: event.TenantUpdated, TenantID: validTenantID, Tenant: validTenant})))
+	})
+
+	It("should publish tenant deleted event after the tenant is deleted", func() {
+		mockTenantDataService.EXPECT().DeleteTenant(validTenantID).Return(nil)
+
+		tenantService.DeleteTenant(validTenantID)
+
+		Expect(subscription.Events()).To(Receive(Equal(event.Event{Type: event.TenantDeleted, TenantID: validTenantID})))
+	})
+
+	It("should publish application created event after the application is created", func() {
+		mockTenantDataService.EXPECT().CreateApplication(validTenantID, contract.Application{Name: validApplication.Name}).Return(validApplicationID, nil)
+
+		tenantService.CreateApplication(validTenantID, validApplication)
+
+		Expect(subscription.Events()).To(Receive(Equal(event.Event{
+			Type:          event.ApplicationCreated,
+			TenantID:      validTenantID,
+			ApplicationID: validApplicationID,
+			Application:   validApplication,
+		})))
+	})
+
+	It("should publish application updated event after the application is updated", func() {
+		mockTenantDataService.EXPECT().UpdateApplication(validTenantID, validApplicationID, contract.Application{Name: validApplication.Name}).Return(nil)
+
+		tenantService.UpdateApplication(validTenantID, validApplicationID, validApplication)
+
+		Expect(subscription.Events()).To(Receive(Equal(event.Event{
+			Type:          event.ApplicationUpdated,
+			TenantID:      validTenantID,
+			ApplicationID: validApplicationID,
+			Application:   validApplication,
+		})))
+	})
+
+	It("should publish application deleted event after the application is deleted", func() {
+		mockTenantDataService.EXPECT().DeleteApplication(validTenantID, validApplicationID).Return(nil)
+
+		tenantService.DeleteApplication(validTenantID, validApplicationID)
+
+		Expect(subscription.Events()).To(Receive(Equal(event.Event{Type: event.ApplicationDeleted, TenantID: validTenantID, ApplicationID: validApplicationID})))
+	})
+
+	It("should not publish any event when tenant data service fails", func() {
+		mockTenantDataService.EXPECT().DeleteTenant(validTenantID).Return(errors.New("Failed"))
+		mockTenantDataService.EXPECT().CreateApplication(validTenantID, contract.Application{Name: validApplication.Name}).Return(system.EmptyUUID, errors.New("Failed"))
+
+		tenantService.DeleteTenant(validTenantID)
+		tenantService.CreateApplication(validTenantID, validApplication)
+
+		Expect(subscription.Events()).NotTo(Receive())
+	})
+})
+
+func TestChangeEvents(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Change events behaviour")
+}
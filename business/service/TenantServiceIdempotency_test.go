@@ -0,0 +1,174 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/business/event"
+	"github.com/micro-business/TenantService/business/service"
+	"github.com/micro-business/TenantService/data/contract"
+	"github.com/micro-business/TenantService/endpoint/security"
+	"github.com/micro-business/TenantService/idempotency"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+// failingCompleteStore is an idempotency store that never records the result of a request.
+type failingCompleteStore struct {
+	idempotency.Store
+}
+
+func (store failingCompleteStore) Complete(key string, fingerprint string, result string) error {
+	return errors.New("Failed")
+}
+
+var _ = Describe("Idempotency behaviour", func() {
+	var (
+		mockCtrl              *gomock.Controller
+		tenantService         *service.TenantService
+		mockTenantDataService *MockTenantDataService
+		subscription          *event.Subscription
+		validTenantID         system.UUID
+		validApplicationID    system.UUID
+		validTenant           domain.Tenant
+		validApplication      domain.Application
+	)
+
+	withKey := func(key string) service.TenantService {
+		return tenantService.WithContext(idempotency.NewContext(context.Background(), key)).(service.TenantService)
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
+
+		bus := event.NewBus()
+		subscription = bus.Subscribe(10)
+
+		tenantService = &service.TenantService{
+			TenantDataService: mockTenantDataService,
+			EventPublisher:    bus,
+			IdempotencyStore:  idempotency.NewMemoryStore(time.Minute, nil),
+		}
+
+		validTenantID, _ = system.RandomUUID()
+		validApplicationID, _ = system.RandomUUID()
+		validTenant = domain.Tenant{SecretKey: "Secret Key"}
+		validApplication = domain.Application{Name: "Application"}
+	})
+
+	AfterEach(func() {
+		subscription.Close()
+		mockCtrl.Finish()
+	})
+
+	It("should return the tenant created first if the request is retried with the same key", func() {
+		mockTenantDataService.EXPECT().CreateTenant(contract.Tenant{SecretKey: validTenant.SecretKey}).Return(validTenantID, nil)
+
+		firstTenantID, err := withKey("key").CreateTenant(validTenant)
+		Expect(err).To(BeNil())
+
+		retriedTenantID, err := withKey("key").CreateTenant(validTenant)
+		Expect(err).To(BeNil())
+
+		Expect(firstTenantID).To(Equal(validTenantID))
+		Expect(retriedTenantID).To(Equal(validTenantID))
+	})
+
+	It("should publish the tenant created event once if the request is retried with the same key", func() {
+		mockTenantDataService.EXPECT().CreateTenant(contract.Tenant{SecretKey: validTenant.SecretKey}).Return(validTenantID, nil)
+
+		withKey("key").CreateTenant(validTenant)
+		withKey("key").CreateTenant(validTenant)
+
+		Expect(subscription.Events()).To(Receive())
+		Expect(subscription.Events()).NotTo(Receive())
+	})
+
+	It("should return conflict error if the key is reused with a different tenant", func() {
+		mockTenantDataService.EXPECT().CreateTenant(contract.Tenant{SecretKey: validTenant.SecretKey}).Return(validTenantID, nil)
+
+		withKey("key").CreateTenant(validTenant)
+		_, err := withKey("key").CreateTenant(domain.Tenant{SecretKey: "Other Secret Key"})
+
+		Expect(err).To(BeAssignableToTypeOf(idempotency.ConflictError{}))
+	})
+
+	It("should create the tenant again if the request failed the first time", func() {
+		expectedError := errors.New("Failed")
+		mockTenantDataService.EXPECT().CreateTenant(contract.Tenant{SecretKey: validTenant.SecretKey}).Return(system.EmptyUUID, expectedError)
+		mockTenantDataService.EXPECT().CreateTenant(contract.Tenant{SecretKey: validTenant.SecretKey}).Return(validTenantID, nil)
+
+		_, err := withKey("key").CreateTenant(validTenant)
+		Expect(err).To(Equal(expectedError))
+
+		tenantID, err := withKey("key").CreateTenant(validTenant)
+		Expect(err).To(BeNil())
+		Expect(tenantID).To(Equal(validTenantID))
+	})
+
+	It("should create a new tenant for every request sent without a key", func() {
+		mockTenantDataService.EXPECT().CreateTenant(contract.Tenant{SecretKey: validTenant.SecretKey}).Return(validTenantID, nil).Times(2)
+
+		tenantService.CreateTenant(validTenant)
+		tenantService.CreateTenant(validTenant)
+	})
+
+	It("should return the application created first if the request is retried with the same key", func() {
+		mockTenantDataService.EXPECT().CreateApplication(validTenantID, contract.Application{Name: validApplication.Name}).Return(validApplicationID, nil)
+
+		withKey("key").CreateApplication(validTenantID, validApplication)
+		applicationID, err := withKey("key").CreateApplication(validTenantID, validApplication)
+
+		Expect(err).To(BeNil())
+		Expect(applicationID).To(Equal(validApplicationID))
+	})
+
+	It("should create the application of a different tenant even if the key is reused", func() {
+		otherTenantID, _ := system.RandomUUID()
+		otherApplicationID, _ := system.RandomUUID()
+		mockTenantDataService.EXPECT().CreateApplication(validTenantID, contract.Application{Name: validApplication.Name}).Return(validApplicationID, nil)
+		mockTenantDataService.EXPECT().CreateApplication(otherTenantID, contract.Application{Name: validApplication.Name}).Return(otherApplicationID, nil)
+
+		withKey("key").CreateApplication(validTenantID, validApplication)
+		applicationID, err := withKey("key").CreateApplication(otherTenantID, validApplication)
+
+		Expect(err).To(BeNil())
+		Expect(applicationID).To(Equal(otherApplicationID))
+	})
+
+	It("should not return the tenant created by a different caller using the same key", func() {
+		otherTenantID, _ := system.RandomUUID()
+		mockTenantDataService.EXPECT().CreateTenant(contract.Tenant{SecretKey: validTenant.SecretKey}).Return(validTenantID, nil)
+		mockTenantDataService.EXPECT().CreateTenant(contract.Tenant{SecretKey: validTenant.SecretKey}).Return(otherTenantID, nil)
+
+		ctx := idempotency.NewContext(context.Background(), "key")
+		tenantService.WithContext(security.WithPrincipal(ctx, security.Principal{Subject: "CN=billing"})).CreateTenant(validTenant)
+		tenantID, err := tenantService.WithContext(security.WithPrincipal(ctx, security.Principal{Subject: "CN=portal"})).CreateTenant(validTenant)
+
+		Expect(err).To(BeNil())
+		Expect(tenantID).To(Equal(otherTenantID))
+	})
+
+	It("should return error naming the created tenant if its result cannot be recorded", func() {
+		mockTenantDataService.EXPECT().CreateTenant(contract.Tenant{SecretKey: validTenant.SecretKey}).Return(validTenantID, nil)
+		tenantService.IdempotencyStore = failingCompleteStore{Store: idempotency.NewMemoryStore(time.Minute, nil)}
+
+		_, err := withKey("key").CreateTenant(validTenant)
+
+		Expect(err).NotTo(BeNil())
+		Expect(err.Error()).To(ContainSubstring(validTenantID.String()))
+		Expect(subscription.Events()).To(Receive())
+	})
+})
+
+func TestIdempotency(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Idempotency behaviour")
+}
@@ -0,0 +1,81 @@
+package service_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/business/service"
+	"github.com/micro-business/TenantService/data/contract"
+	"github.com/micro-business/TenantService/logging"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("Logging behaviour", func() {
+	var (
+		mockCtrl              *gomock.Controller
+		tenantService         *service.TenantService
+		mockTenantDataService *MockTenantDataService
+		output                *bytes.Buffer
+		logger                log.Logger
+		validTenantID         system.UUID
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
+		output = &bytes.Buffer{}
+		logger = log.NewLogfmtLogger(output)
+
+		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
+
+		validTenantID, _ = system.RandomUUID()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should write the logs to the logger carried by the bound context", func() {
+		ctx := logging.NewContext(context.Background(), logger, "correlation-id")
+
+		mockTenantDataService.EXPECT().CreateTenant(contract.Tenant{SecretKey: "Secret Key"}).Return(validTenantID, nil)
+
+		tenantService.WithContext(ctx).CreateTenant(domain.Tenant{SecretKey: "Secret Key"})
+
+		Expect(output.String()).To(ContainSubstring("change=TENANT_CREATED"))
+		Expect(output.String()).To(ContainSubstring("request_id=correlation-id"))
+	})
+
+	It("should log the applied change without the tenant secret key", func() {
+		scopedTenantService := service.TenantService{TenantDataService: mockTenantDataService, Logger: logger}
+
+		mockTenantDataService.EXPECT().CreateTenant(contract.Tenant{SecretKey: "Very Secret Key"}).Return(validTenantID, nil)
+
+		scopedTenantService.CreateTenant(domain.Tenant{SecretKey: "Very Secret Key"})
+
+		Expect(output.String()).To(ContainSubstring("change=TENANT_CREATED"))
+		Expect(output.String()).To(ContainSubstring("tenant_id=" + validTenantID.String()))
+		Expect(output.String()).NotTo(ContainSubstring("Very Secret Key"))
+	})
+
+	It("should not log anything if no logger is provided", func() {
+		mockTenantDataService.EXPECT().CreateTenant(contract.Tenant{SecretKey: "Secret Key"}).Return(validTenantID, nil)
+
+		_, err := tenantService.CreateTenant(domain.Tenant{SecretKey: "Secret Key"})
+
+		Expect(err).To(BeNil())
+		Expect(output.String()).To(BeEmpty())
+	})
+})
+
+func TestLogging(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Logging behaviour")
+}
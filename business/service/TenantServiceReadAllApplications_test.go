@@ -25,6 +25,7 @@ var _ = Describe("ReadAllApplications method input parameters and dependency tes
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
 
 		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
 
@@ -61,6 +62,7 @@ var _ = Describe("ReadAllApplications method behaviour", func() {
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
 
 		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
 
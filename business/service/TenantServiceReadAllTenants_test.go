@@ -0,0 +1,67 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/business/service"
+	"github.com/micro-business/TenantService/data/contract"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadAllTenants method behaviour", func() {
+	var (
+		mockCtrl              *gomock.Controller
+		tenantService         *service.TenantService
+		mockTenantDataService *MockTenantDataService
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
+
+		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should panic when tenant data service not provided", func() {
+		tenantService.TenantDataService = nil
+
+		Ω(func() { tenantService.ReadAllTenants() }).Should(Panic())
+	})
+
+	It("should return the tenants returned by tenant data service", func() {
+		tenantID, _ := system.RandomUUID()
+
+		mockTenantDataService.EXPECT().ReadAllTenants().Return(map[system.UUID]contract.Tenant{tenantID: {SecretKey: "Secret Key"}}, nil)
+
+		tenants, err := tenantService.ReadAllTenants()
+
+		Expect(err).To(BeNil())
+		Expect(tenants).To(Equal(map[system.UUID]domain.Tenant{tenantID: {SecretKey: "Secret Key"}}))
+	})
+
+	It("should return the error returned by tenant data service", func() {
+		expectedError := errors.New("Failed")
+
+		mockTenantDataService.EXPECT().ReadAllTenants().Return(nil, expectedError)
+
+		tenants, err := tenantService.ReadAllTenants()
+
+		Expect(err).To(Equal(expectedError))
+		Expect(tenants).To(BeNil())
+	})
+})
+
+func TestReadAllTenants(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ReadAllTenants method behaviour")
+}
@@ -0,0 +1,127 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/business/service"
+	"github.com/micro-business/TenantService/data/contract"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadApplicationsOfTenants method input parameters and dependency test", func() {
+	var (
+		mockCtrl              *gomock.Controller
+		tenantService         *service.TenantService
+		mockTenantDataService *MockTenantDataService
+		validTenantID         system.UUID
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
+
+		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
+
+		validTenantID, _ = system.RandomUUID()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("when tenant data service not provided", func() {
+		It("should panic", func() {
+			tenantService.TenantDataService = nil
+
+			Ω(func() { tenantService.ReadApplicationsOfTenants([]system.UUID{validTenantID}) }).Should(Panic())
+		})
+	})
+
+	Describe("Input Parameters", func() {
+		It("should panic when empty tenant unique identifier provided", func() {
+			Ω(func() { tenantService.ReadApplicationsOfTenants([]system.UUID{validTenantID, system.EmptyUUID}) }).Should(Panic())
+		})
+	})
+})
+
+var _ = Describe("ReadApplicationsOfTenants method behaviour", func() {
+	var (
+		mockCtrl              *gomock.Controller
+		tenantService         *service.TenantService
+		mockTenantDataService *MockTenantDataService
+		validTenantIDs        []system.UUID
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
+
+		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
+
+		firstTenantID, _ := system.RandomUUID()
+		secondTenantID, _ := system.RandomUUID()
+		validTenantIDs = []system.UUID{firstTenantID, secondTenantID}
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should call tenant data service ReadApplicationsOfTenants function once with all the tenants", func() {
+		mockTenantDataService.EXPECT().ReadApplicationsOfTenants(validTenantIDs).Times(1)
+
+		tenantService.ReadApplicationsOfTenants(validTenantIDs)
+	})
+
+	Context("when tenant data service succeeds to read the applications of the requested tenants", func() {
+		It("should return the applications of every tenant found and no error", func() {
+			applicationID, _ := system.RandomUUID()
+			randomValue, _ := system.RandomUUID()
+
+			mockTenantDataService.
+				EXPECT().
+				ReadApplicationsOfTenants(validTenantIDs).
+				Return(map[system.UUID]map[system.UUID]contract.Application{
+					validTenantIDs[0]: {applicationID: {Name: randomValue.String()}},
+					validTenantIDs[1]: {},
+				}, nil)
+
+			applicationsOfTenants, err := tenantService.ReadApplicationsOfTenants(validTenantIDs)
+
+			Expect(applicationsOfTenants).To(Equal(map[system.UUID]map[system.UUID]domain.Application{
+				validTenantIDs[0]: {applicationID: {Name: randomValue.String()}},
+				validTenantIDs[1]: {},
+			}))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Context("when tenant data service fails to read the applications of the requested tenants", func() {
+		It("should return the error returned by tenant data service", func() {
+			expectedErrorID, _ := system.RandomUUID()
+			expectedError := errors.New(expectedErrorID.String())
+			mockTenantDataService.
+				EXPECT().
+				ReadApplicationsOfTenants(validTenantIDs).
+				Return(nil, expectedError)
+
+			applicationsOfTenants, err := tenantService.ReadApplicationsOfTenants(validTenantIDs)
+
+			Expect(applicationsOfTenants).To(BeNil())
+			Expect(err).To(Equal(expectedError))
+		})
+	})
+})
+
+func TestReadApplicationsOfTenants(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ReadApplicationsOfTenants method input parameters and dependency test")
+	RunSpecs(t, "ReadApplicationsOfTenants method behaviour")
+}
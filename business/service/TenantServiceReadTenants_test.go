@@ -0,0 +1,120 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/business/service"
+	"github.com/micro-business/TenantService/data/contract"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadTenants method input parameters and dependency test", func() {
+	var (
+		mockCtrl              *gomock.Controller
+		tenantService         *service.TenantService
+		mockTenantDataService *MockTenantDataService
+		validTenantID         system.UUID
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
+
+		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
+
+		validTenantID, _ = system.RandomUUID()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("when tenant data service not provided", func() {
+		It("should panic", func() {
+			tenantService.TenantDataService = nil
+
+			Ω(func() { tenantService.ReadTenants([]system.UUID{validTenantID}) }).Should(Panic())
+		})
+	})
+
+	Describe("Input Parameters", func() {
+		It("should panic when empty tenant unique identifier provided", func() {
+			Ω(func() { tenantService.ReadTenants([]system.UUID{validTenantID, system.EmptyUUID}) }).Should(Panic())
+		})
+	})
+})
+
+var _ = Describe("ReadTenants method behaviour", func() {
+	var (
+		mockCtrl              *gomock.Controller
+		tenantService         *service.TenantService
+		mockTenantDataService *MockTenantDataService
+		validTenantIDs        []system.UUID
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService).AnyTimes()
+
+		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
+
+		firstTenantID, _ := system.RandomUUID()
+		secondTenantID, _ := system.RandomUUID()
+		validTenantIDs = []system.UUID{firstTenantID, secondTenantID}
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should call tenant data service ReadTenants function once with all the tenants", func() {
+		mockTenantDataService.EXPECT().ReadTenants(validTenantIDs).Times(1)
+
+		tenantService.ReadTenants(validTenantIDs)
+	})
+
+	Context("when tenant data service succeeds to read the requested tenants", func() {
+		It("should return the tenants found and no error", func() {
+			randomValue, _ := system.RandomUUID()
+
+			mockTenantDataService.
+				EXPECT().
+				ReadTenants(validTenantIDs).
+				Return(map[system.UUID]contract.Tenant{validTenantIDs[0]: {SecretKey: randomValue.String()}}, nil)
+
+			tenants, err := tenantService.ReadTenants(validTenantIDs)
+
+			Expect(tenants).To(Equal(map[system.UUID]domain.Tenant{validTenantIDs[0]: {SecretKey: randomValue.String()}}))
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Context("when tenant data service fails to read the requested tenants", func() {
+		It("should return the error returned by tenant data service", func() {
+			expectedErrorID, _ := system.RandomUUID()
+			expectedError := errors.New(expectedErrorID.String())
+			mockTenantDataService.
+				EXPECT().
+				ReadTenants(validTenantIDs).
+				Return(nil, expectedError)
+
+			tenants, err := tenantService.ReadTenants(validTenantIDs)
+
+			Expect(tenants).To(BeNil())
+			Expect(err).To(Equal(expectedError))
+		})
+	})
+})
+
+func TestReadTenants(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ReadTenants method input parameters and dependency test")
+	RunSpecs(t, "ReadTenants method behaviour")
+}
@@ -0,0 +1,81 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/service"
+	"github.com/micro-business/TenantService/tracing"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("Tracing behaviour", func() {
+	var (
+		mockCtrl              *gomock.Controller
+		tenantService         *service.TenantService
+		mockTenantDataService *MockTenantDataService
+		recorder              *tracetest.SpanRecorder
+		ctx                   context.Context
+		parentSpan            trace.Span
+		validTenantID         system.UUID
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantDataService = NewMockTenantDataService(mockCtrl)
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+		tenantService = &service.TenantService{TenantDataService: mockTenantDataService}
+
+		ctx, parentSpan = tracing.Start(context.Background(), "parent")
+		validTenantID, _ = system.RandomUUID()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should record the method span as a child of the span of the bound context", func() {
+		var dataServiceContext context.Context
+
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Do(func(ctx context.Context) { dataServiceContext = ctx }).Return(mockTenantDataService)
+		mockTenantDataService.EXPECT().DeleteTenant(validTenantID).Return(nil)
+
+		Expect(tenantService.WithContext(ctx).DeleteTenant(validTenantID)).To(BeNil())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name()).To(Equal("TenantService.DeleteTenant"))
+		Expect(spans[0].Parent().SpanID()).To(Equal(parentSpan.SpanContext().SpanID()))
+		Expect(trace.SpanContextFromContext(dataServiceContext).SpanID()).To(Equal(spans[0].SpanContext().SpanID()))
+	})
+
+	It("should mark the method span as failed when tenant data service fails", func() {
+		expectedError := errors.New("Failed")
+
+		mockTenantDataService.EXPECT().WithContext(gomock.Any()).Return(mockTenantDataService)
+		mockTenantDataService.EXPECT().DeleteTenant(validTenantID).Return(expectedError)
+
+		Expect(tenantService.WithContext(ctx).DeleteTenant(validTenantID)).To(Equal(expectedError))
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Status().Code).To(Equal(codes.Error))
+		Expect(spans[0].Status().Description).To(Equal("Failed"))
+	})
+})
+
+func TestTracing(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Tracing behaviour")
+}
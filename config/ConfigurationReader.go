@@ -1,10 +1,158 @@
 package config
 
+import (
+	"net"
+
+	"golang.org/x/net/context"
+)
+
+// RateLimit defines the token bucket used to throttle the requests of a caller
+type RateLimit struct {
+	// RequestsPerSecond is the rate the bucket is refilled at. Zero disables the rate limiting.
+	RequestsPerSecond float64
+
+	// Burst is the size of the bucket, the number of requests a caller can make at once.
+	Burst int
+}
+
+// PrincipalTenant defines the tenant and application an authenticated principal acts on behalf of
+type PrincipalTenant struct {
+	// TenantID is the unique identifier of the tenant.
+	TenantID string
+
+	// ApplicationID is the unique identifier of the tenant application. Empty if the principal acts on behalf of the
+	// tenant itself.
+	ApplicationID string
+}
+
+// CassandraConnection defines how the service connects to the Cassandra cluster, on top of its hosts, keyspace and
+// protocol version
+type CassandraConnection struct {
+	// Username is the username the service authenticates with. Empty means authentication is not used.
+	Username string
+
+	// Password is the password the service authenticates with.
+	Password string
+
+	// TLSEnabled encrypts the connections to the Cassandra nodes using TLS.
+	TLSEnabled bool
+
+	// TLSCAFilePath is the path to the PEM encoded CA bundle the node certificates are verified with. Empty means the
+	// system CA bundle is used.
+	TLSCAFilePath string
+
+	// TLSCertificateFilePath is the path to the PEM encoded client certificate presented to the nodes. Empty means no
+	// client certificate is presented.
+	TLSCertificateFilePath string
+
+	// TLSKeyFilePath is the path to the PEM encoded private key of the client certificate.
+	TLSKeyFilePath string
+
+	// TLSHostVerificationEnabled verifies the host name of the nodes against their certificate.
+	TLSHostVerificationEnabled bool
+
+	// ReadConsistency is the consistency level of the statements reading data, e.g. local_quorum.
+	ReadConsistency string
+
+	// WriteConsistency is the consistency level of the statements writing data, e.g. local_quorum.
+	WriteConsistency string
+
+	// ConnectTimeout is how long, in milliseconds, a connection to a node can take to be established.
+	ConnectTimeout int
+
+	// QueryTimeout is how long, in milliseconds, a node can take to answer a statement.
+	QueryTimeout int
+
+	// RetryPolicy is how the failed statements are retried, either none, simple or exponential.
+	RetryPolicy string
+
+	// RetryAttempts is the number of times a failed statement is retried when a retry policy is used.
+	RetryAttempts int
+
+	// TokenAwareRouting sends the statements to the nodes owning the data they target.
+	TokenAwareRouting bool
+
+	// LocalDatacenter is the datacenter whose nodes are preferred. Empty means the nodes of every datacenter are used alike.
+	LocalDatacenter string
+
+	// ConnectionsPerHost is the number of connections opened to every node.
+	ConnectionsPerHost int
+}
+
+// TLS defines how the service encrypts the connections of its clients
+type TLS struct {
+	// CertificateFilePath is the path to the PEM encoded certificate the service presents to its clients. Empty means
+	// the service serves plain HTTP.
+	CertificateFilePath string
+
+	// KeyFilePath is the path to the PEM encoded private key of the service certificate.
+	KeyFilePath string
+
+	// ClientCAFilePath is the path to the PEM encoded CA bundle the client certificates are verified with. Empty means
+	// the client certificates are not verified.
+	ClientCAFilePath string
+
+	// ClientCertificateRequired requires every client to present a certificate signed by the client CA bundle.
+	ClientCertificateRequired bool
+
+	// MinimumVersion is the minimum TLS version the service accepts, e.g. 1.2
+	MinimumVersion string
+}
+
+// ServerTimeouts defines how long, in seconds, the HTTP server waits on the clients and on the in-flight requests
+type ServerTimeouts struct {
+	// ReadTimeout is how long a request, including its body, can take to be read. Zero means no timeout.
+	ReadTimeout int
+
+	// WriteTimeout is how long a response can take to be written, from the end of the request headers. Zero means no timeout.
+	WriteTimeout int
+
+	// IdleTimeout is how long an idle keep-alive connection is kept open. Zero means the read timeout is used.
+	IdleTimeout int
+
+	// ShutdownTimeout is how long the in-flight requests are given to complete when the service is shut down.
+	ShutdownTimeout int
+}
+
+// Tracing defines how the spans of the served requests are sampled and exported
+type Tracing struct {
+	// Exporter is the exporter of the spans, either none, stdout, file or otlp.
+	Exporter string
+
+	// FilePath is the path of the file the spans are appended to when the file exporter is used.
+	FilePath string
+
+	// OTLPEndpoint is the host and port of the OpenTelemetry collector the spans are sent to when the otlp exporter is used.
+	OTLPEndpoint string
+
+	// OTLPInsecure sends the spans to the OpenTelemetry collector over plain HTTP instead of HTTPS.
+	OTLPInsecure bool
+
+	// SampleRatio is the ratio of the traces started by the service that are sampled, between 0 and 1.
+	SampleRatio float64
+}
+
+// PersistedQueries defines which queries the clients can execute by sending their hash instead of the query
+type PersistedQueries struct {
+	// Mode is how persisted queries are supported, either automatic, strict or disabled.
+	Mode string
+
+	// Manifest holds the queries loaded from the manifest file, keyed by their lowercase hex encoded sha256 hash. Nil
+	// means no manifest is provided.
+	Manifest map[string]string
+
+	// CacheSize is the maximum number of automatic persisted queries kept in memory.
+	CacheSize int
+}
+
 // ConfigurationReader defines the interface that provides access to all configurations parameters required by the service.
 type ConfigurationReader interface {
 	// GetListeningPort returns the port the application should start listening on.
 	GetListeningPort() (int, error)
 
+	// GetGRPCListeningPort returns the port the service should listen on to serve the gRPC requests. Zero means gRPC is not served.
+	GetGRPCListeningPort() (int, error)
+
 	// GetCassandraHosts returns the list of Cassandra host addresses.
 	GetCassandraHosts() ([]string, error)
 
@@ -13,4 +161,90 @@ type ConfigurationReader interface {
 
 	// GetCassandraProtocolVersion returns the cassandra procotol version.
 	GetCassandraProtocolVersion() (int, error)
+
+	// GetCassandraConnection returns how the service authenticates, encrypts, routes and retries its connections to Cassandra.
+	GetCassandraConnection() (CassandraConnection, error)
+
+	// GetTLS returns how the service encrypts the connections of its clients.
+	GetTLS() (TLS, error)
+
+	// GetDefaultRateLimit returns the rate limit applied to every caller that does not have its own rate limit.
+	GetDefaultRateLimit() (RateLimit, error)
+
+	// GetTenantRateLimits returns the rate limits overridden per tenant, keyed by tenant unique identifier.
+	GetTenantRateLimits() (map[string]RateLimit, error)
+
+	// GetRateLimitTrustedProxies returns the networks of the reverse proxies whose X-Forwarded-For header identifies the
+	// anonymous callers. Empty means the service is reached directly.
+	GetRateLimitTrustedProxies() ([]*net.IPNet, error)
+
+	// GetPrincipalPermissions returns the permissions explicitly granted to the authenticated principals, keyed by principal name.
+	GetPrincipalPermissions() (map[string][]string, error)
+
+	// GetPrincipalTenants returns the tenant and application the authenticated principals act on behalf of, keyed by
+	// principal name. The principals not listed do not act on behalf of any tenant.
+	GetPrincipalTenants() (map[string]PrincipalTenant, error)
+
+	// GetSensitiveFieldPolicy returns how sensitive fields are resolved for callers without the required permission, either mask or error.
+	GetSensitiveFieldPolicy() (string, error)
+
+	// GetCORSAllowedOrigins returns the origins allowed to call the API from a browser. * allows any origin.
+	GetCORSAllowedOrigins() ([]string, error)
+
+	// GetMaxRequestBodySize returns the maximum size of a request body in bytes.
+	GetMaxRequestBodySize() (int, error)
+
+	// GetMaxQueryDepth returns the maximum nesting depth of the fields of a GraphQL query. Zero means no limit.
+	GetMaxQueryDepth() (int, error)
+
+	// GetMaxQueryComplexity returns the maximum estimated cost of a GraphQL query. Zero means no limit.
+	GetMaxQueryComplexity() (int, error)
+
+	// GetSubscriptionKeepAliveInterval returns how often, in seconds, a ping is sent over the WebSocket connections of GraphQL subscriptions.
+	GetSubscriptionKeepAliveInterval() (int, error)
+
+	// GetSubscriptionConnectionInitTimeout returns how long, in seconds, a WebSocket client has to initialise the connection before it is closed.
+	GetSubscriptionConnectionInitTimeout() (int, error)
+
+	// GetSubscriptionBufferSize returns the number of events a GraphQL subscription can hold before the subscriber is considered too slow.
+	GetSubscriptionBufferSize() (int, error)
+
+	// GetSubscriptionAnonymousAccessAllowed returns whether WebSocket clients without an authenticated principal can subscribe.
+	GetSubscriptionAnonymousAccessAllowed() (bool, error)
+
+	// GetIntrospectionEnabled returns whether the GraphQL schema can be queried using the __schema and __type introspection fields.
+	GetIntrospectionEnabled() (bool, error)
+
+	// GetGraphiQLEnabled returns whether the GraphiQL explorer is served to the browsers requesting the API. Disabled by default.
+	GetGraphiQLEnabled() (bool, error)
+
+	// GetGraphiQLAssetsDirectory returns the directory holding the vendored GraphiQL assets served to the explorer.
+	GetGraphiQLAssetsDirectory() (string, error)
+
+	// GetPersistedQueries returns which queries the clients can execute by sending their hash instead of the query.
+	GetPersistedQueries() (PersistedQueries, error)
+
+	// GetMetricsEnabled returns whether the metrics are recorded and exposed to Prometheus on the /metrics path. Enabled by default.
+	GetMetricsEnabled() (bool, error)
+
+	// GetLogFormat returns the format of the log lines, either json or logfmt.
+	GetLogFormat() (string, error)
+
+	// GetLogLevel returns the lowest level of the log lines written, either debug, info, warn or error.
+	GetLogLevel() (string, error)
+
+	// GetServerTimeouts returns how long the HTTP server waits on the clients and on the in-flight requests on shutdown.
+	GetServerTimeouts() (ServerTimeouts, error)
+
+	// GetTracing returns how the spans of the served requests are sampled and exported. Spans are not exported by default.
+	GetTracing() (Tracing, error)
+
+	// GetIdempotencyKeyTTL returns how long, in seconds, the result of a create request sent with an idempotency key is
+	// kept, so the request can be safely retried.
+	GetIdempotencyKeyTTL() (int, error)
+
+	// CheckHealth checks the configuration store can be reached.
+	// ctx: Mandatory. The context of the check, the check stops once the context is done.
+	// Returns error if the configuration cannot be read.
+	CheckHealth(ctx context.Context) error
 }
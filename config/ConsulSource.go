@@ -0,0 +1,140 @@
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/micro-business/Micro-Business-Core/common/config"
+	"golang.org/x/net/context"
+)
+
+// consulKeyPrefix is the prefix of the Consul keys the settings are stored under.
+const consulKeyPrefix = "services/tenant-service/"
+
+// consulWatchWaitTime is how long a blocking query waits for a change before Consul answers it unchanged.
+const consulWatchWaitTime = 5 * time.Minute
+
+// consulWatchRetryInterval is how long the watch waits before querying Consul again after a query failed.
+const consulWatchRetryInterval = 10 * time.Second
+
+// ConsulSource implements Source reading the settings from the Consul key/value store. The Consul key of a setting is
+// its key prefixed by services/tenant-service/, e.g. services/tenant-service/endpoint/listening-port. Empty values are ignored.
+type ConsulSource struct {
+	ConsulAddress string
+	ConsulScheme  string
+}
+
+// Name returns consul.
+func (consul ConsulSource) Name() string {
+	return "consul"
+}
+
+// Lookup returns the raw value of the provided setting.
+// key: Mandatory. The key of the setting.
+// Returns the value and true if the Consul key of the setting exists, false if it does not, or error if Consul cannot be read.
+func (consul ConsulSource) Lookup(key string) (string, bool, error) {
+	consulHelper := config.ConsulHelper{ConsulAddress: consul.ConsulAddress, ConsulScheme: consul.ConsulScheme}
+	keyPair, err := consulHelper.GetKeyPair(consulKeyPrefix + key)
+
+	if err != nil {
+		return "", false, err
+	}
+
+	if keyPair == nil {
+		return "", false, nil
+	}
+
+	value := strings.TrimSpace(string(keyPair.Value))
+
+	return value, len(value) != 0, nil
+}
+
+// CheckHealth checks the Consul agent can be reached by reading the listening port key.
+// ctx: Mandatory. The context of the check, the query is cancelled once the context is done.
+// Returns error if the Consul key/value store cannot be read.
+func (consul ConsulSource) CheckHealth(ctx context.Context) error {
+	client, err := api.NewClient(&api.Config{Address: consul.ConsulAddress, Scheme: consul.ConsulScheme})
+
+	if err != nil {
+		return err
+	}
+
+	_, _, err = client.KV().Get(consulKeyPrefix+listeningPortKey, (&api.QueryOptions{}).WithContext(ctx))
+
+	return err
+}
+
+// Watch starts watching the Consul keys of the settings in the background using blocking queries, until the provided
+// channel is closed.
+// stop: Mandatory. Closed to stop watching.
+// notify: Mandatory. Called with nil every time any of the keys changed, or with the error of a failed query. The keys
+// are queried again after consulWatchRetryInterval then.
+func (consul ConsulSource) Watch(stop <-chan struct{}, notify func(err error)) {
+	go consul.watch(stop, notify)
+}
+
+func (consul ConsulSource) watch(stop <-chan struct{}, notify func(err error)) {
+	client, err := api.NewClient(&api.Config{Address: consul.ConsulAddress, Scheme: consul.ConsulScheme})
+
+	if err != nil {
+		notify(err)
+
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	var waitIndex, lastIndex uint64
+	listed := false
+
+	for {
+		queryOptions := &api.QueryOptions{WaitIndex: waitIndex, WaitTime: consulWatchWaitTime}
+		_, queryMeta, err := client.KV().List(consulKeyPrefix, queryOptions.WithContext(ctx))
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			notify(err)
+
+			select {
+			case <-stop:
+				return
+			case <-time.After(consulWatchRetryInterval):
+			}
+
+			continue
+		}
+
+		index := queryMeta.LastIndex
+		waitIndex = index
+
+		if listed && index != lastIndex {
+			notify(nil)
+		}
+
+		// The index goes backwards when the Consul servers are restored from a snapshot, the keys changed then so the
+		// watch notifies and starts over from the first index.
+		if listed && index < lastIndex {
+			waitIndex = 0
+		}
+
+		// A query waiting on index 0 returns at once, the index is clamped to 1 to keep blocking when Consul resets it.
+		if waitIndex < 1 {
+			waitIndex = 1
+		}
+
+		listed, lastIndex = true, index
+	}
+}
@@ -0,0 +1,133 @@
+package config_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/micro-business/TenantService/config"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ConsulSource Watch method behaviour", func() {
+	var (
+		index         uint64
+		queries       int32
+		failed        int32
+		consulServer  *httptest.Server
+		notifications chan error
+		stop          chan struct{}
+	)
+
+	BeforeEach(func() {
+		index = 1
+		queries = 0
+		failed = 0
+		notifications = make(chan error, 10)
+		stop = make(chan struct{})
+
+		// Answers the blocking queries once the index is greater than the index waited on, or after 100ms as Consul does
+		// once the wait time elapsed. The tests change the index to simulate a change of the keys.
+		consulServer = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {
+			atomic.AddInt32(&queries, 1)
+
+			if atomic.LoadInt32(&failed) == 1 {
+				writer.WriteHeader(http.StatusInternalServerError)
+
+				return
+			}
+
+			waitIndex, _ := strconv.ParseUint(httpRequest.URL.Query().Get("index"), 10, 64)
+
+			waitTime := time.After(100 * time.Millisecond)
+
+			for waiting := true; waiting && atomic.LoadUint64(&index) <= waitIndex; {
+				select {
+				case <-httpRequest.Context().Done():
+					return
+				case <-stop:
+					return
+				case <-waitTime:
+					waiting = false
+				case <-time.After(10 * time.Millisecond):
+				}
+			}
+
+			writer.Header().Set("X-Consul-Index", strconv.FormatUint(atomic.LoadUint64(&index), 10))
+			writer.Write([]byte("[]"))
+		}))
+	})
+
+	AfterEach(func() {
+		close(stop)
+		consulServer.Close()
+	})
+
+	watch := func() {
+		source := config.ConsulSource{ConsulAddress: strings.TrimPrefix(consulServer.URL, "http://"), ConsulScheme: "http"}
+
+		source.Watch(stop, func(err error) {
+			select {
+			case notifications <- err:
+			default:
+			}
+		})
+	}
+
+	It("should not notify until the keys change", func() {
+		watch()
+
+		Consistently(notifications, "200ms").ShouldNot(Receive())
+	})
+
+	It("should notify once the keys change", func() {
+		watch()
+
+		Eventually(func() int32 { return atomic.LoadInt32(&queries) }, "2s").Should(BeNumerically(">=", 2))
+		atomic.StoreUint64(&index, 2)
+
+		Eventually(notifications, "2s").Should(Receive(BeNil()))
+	})
+
+	It("should notify once the index goes backwards", func() {
+		atomic.StoreUint64(&index, 5)
+		watch()
+
+		Eventually(func() int32 { return atomic.LoadInt32(&queries) }, "2s").Should(BeNumerically(">=", 2))
+		atomic.StoreUint64(&index, 3)
+
+		Eventually(notifications, "2s").Should(Receive(BeNil()))
+	})
+
+	It("should keep blocking once the index is reset to 0", func() {
+		watch()
+
+		Eventually(func() int32 { return atomic.LoadInt32(&queries) }, "2s").Should(BeNumerically(">=", 2))
+		atomic.StoreUint64(&index, 0)
+
+		Eventually(notifications, "2s").Should(Receive(BeNil()))
+
+		resetQueries := atomic.LoadInt32(&queries)
+		time.Sleep(300 * time.Millisecond)
+
+		Expect(atomic.LoadInt32(&queries) - resetQueries).To(BeNumerically("<=", 5))
+		Expect(notifications).NotTo(Receive())
+	})
+
+	It("should notify the error if Consul cannot be queried", func() {
+		atomic.StoreInt32(&failed, 1)
+		watch()
+
+		Eventually(notifications, "2s").Should(Receive(HaveOccurred()))
+	})
+})
+
+func TestConsulSource(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ConsulSource")
+}
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"os"
+	"strings"
+)
+
+// EnvironmentPrefix is the prefix of the environment variables the settings are read from.
+const EnvironmentPrefix = "TENANT_SERVICE_"
+
+// environmentAliases are the environment variables read for a setting when its prefixed variable is not set, keyed by
+// setting key. PORT is set by most container platforms.
+var environmentAliases = map[string]string{
+	listeningPortKey: "PORT",
+}
+
+// EnvironmentSource implements Source reading the settings from the environment variables. The variable of a setting is
+// its key in upper case prefixed by TENANT_SERVICE_, with slashes and dashes replaced by underscores, e.g.
+// TENANT_SERVICE_ENDPOINT_LISTENING_PORT. Variables set to an empty value are ignored.
+type EnvironmentSource struct {
+	// LookupEnv is optional. When provided, it is used instead of os.LookupEnv to read the variables.
+	LookupEnv func(name string) (string, bool)
+}
+
+// Name returns env.
+func (source EnvironmentSource) Name() string {
+	return "env"
+}
+
+// Lookup returns the raw value of the provided setting.
+// key: Mandatory. The key of the setting.
+// Returns the value and true if the variable of the setting is set, false if it is not.
+func (source EnvironmentSource) Lookup(key string) (string, bool, error) {
+	lookupEnv := source.LookupEnv
+
+	if lookupEnv == nil {
+		lookupEnv = os.LookupEnv
+	}
+
+	names := []string{EnvironmentVariableName(key)}
+
+	if alias, ok := environmentAliases[key]; ok {
+		names = append(names, alias)
+	}
+
+	for _, name := range names {
+		if value, ok := lookupEnv(name); ok && len(strings.TrimSpace(value)) != 0 {
+			return strings.TrimSpace(value), true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// EnvironmentVariableName returns the name of the environment variable the provided setting is read from.
+func EnvironmentVariableName(key string) string {
+	return EnvironmentPrefix + strings.ToUpper(strings.NewReplacer("/", "_", "-", "_").Replace(key))
+}
@@ -0,0 +1,99 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileSource implements Source reading the settings from a YAML or JSON file. The document nests the settings by the
+// segments of their key, e.g. the endpoint/listening-port setting is read from the listening-port member of the
+// endpoint member. Lists are joined by commas, e.g. the Cassandra hosts can be provided as a list.
+type FileSource struct {
+	filePath string
+	document map[string]interface{}
+}
+
+// NewFileSource reads the settings from the provided file. The format of the file is detected by its extension, .json
+// files are read as JSON, .yaml and .yml files as YAML.
+// filePath: Mandatory. The path to the file.
+// Returns either the new file source or error if the file cannot be read or parsed.
+func NewFileSource(filePath string) (FileSource, error) {
+	content, err := ioutil.ReadFile(filePath)
+
+	if err != nil {
+		return FileSource{}, err
+	}
+
+	document := make(map[string]interface{})
+
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".json":
+		decoder := json.NewDecoder(strings.NewReader(string(content)))
+		decoder.UseNumber()
+		err = decoder.Decode(&document)
+
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, &document)
+
+	default:
+		return FileSource{}, fmt.Errorf("Configuration file %s must be either a YAML or a JSON file.", filePath)
+	}
+
+	if err != nil {
+		return FileSource{}, fmt.Errorf("Configuration file %s could not be parsed. Error: %s", filePath, err.Error())
+	}
+
+	return FileSource{filePath: filePath, document: document}, nil
+}
+
+// Name returns file.
+func (source FileSource) Name() string {
+	return "file"
+}
+
+// Lookup returns the raw value of the provided setting.
+// key: Mandatory. The key of the setting.
+// Returns the value and true if the file provides the setting, false if it does not, or error if the file holds an
+// object instead of a value under the key of the setting.
+func (source FileSource) Lookup(key string) (string, bool, error) {
+	var value interface{} = source.document
+
+	for _, segment := range strings.Split(key, "/") {
+		members, ok := value.(map[string]interface{})
+
+		if !ok {
+			return "", false, nil
+		}
+
+		if value, ok = members[segment]; !ok {
+			return "", false, nil
+		}
+	}
+
+	switch value := value.(type) {
+	case nil:
+		return "", false, nil
+
+	case map[string]interface{}:
+		return "", false, fmt.Errorf("Configuration file %s must hold a value, not an object, under %s.", source.filePath, key)
+
+	case []interface{}:
+		items := make([]string, 0, len(value))
+
+		for _, item := range value {
+			items = append(items, fmt.Sprint(item))
+		}
+
+		return strings.Join(items, ","), len(items) != 0, nil
+
+	default:
+		valueInString := strings.TrimSpace(fmt.Sprint(value))
+
+		return valueInString, len(valueInString) != 0, nil
+	}
+}
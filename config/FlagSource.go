@@ -0,0 +1,82 @@
+package config
+
+import (
+	"flag"
+	"strings"
+)
+
+// legacyFlagNames are the flags kept from the earlier versions of the service, keyed by flag name.
+var legacyFlagNames = map[string]string{
+	"consul-address":            consulAddressKey,
+	"consul-scheme":             consulSchemeKey,
+	"listening-port":            listeningPortKey,
+	"cassandra-hosts":           cassandraHostsKey,
+	"cassandra-keyspace":        cassandraKeyspaceKey,
+	"cassandra-protocl-version": cassandraProtocolVersionKey,
+	"tls-certificate-file":      tlsCertificateFilePathKey,
+	"tls-key-file":              tlsKeyFilePathKey,
+	"tls-client-ca-file":        tlsClientCAFilePathKey,
+}
+
+// FlagSource implements Source reading the settings from the command line flags. A flag is defined for every setting,
+// named after its key with slashes replaced by dots, e.g. -endpoint.listening-port. Only the flags set on the command
+// line are provided, so the other sources are not overridden by the flags left unset.
+type FlagSource struct {
+	flagSet *flag.FlagSet
+	values  map[string]*string
+}
+
+// NewFlagSource defines the flags of every setting in the provided flag set. The flag set must be parsed before the
+// settings are looked up.
+// flagSet: Mandatory. The flag set to define the flags in.
+// Returns the new flag source.
+func NewFlagSource(flagSet *flag.FlagSet) FlagSource {
+	source := FlagSource{flagSet: flagSet, values: make(map[string]*string)}
+
+	for _, setting := range settings {
+		name := FlagName(setting.key)
+		source.values[name] = flagSet.String(name, "", setting.description)
+	}
+
+	for name, key := range legacyFlagNames {
+		source.values[name] = flagSet.String(name, "", "Deprecated, use -"+FlagName(key)+" instead.")
+	}
+
+	return source
+}
+
+// Name returns flag.
+func (source FlagSource) Name() string {
+	return "flag"
+}
+
+// Lookup returns the raw value of the provided setting.
+// key: Mandatory. The key of the setting.
+// Returns the value and true if the flag of the setting, or its deprecated flag, is set, false if neither is.
+func (source FlagSource) Lookup(key string) (string, bool, error) {
+	setFlags := make(map[string]bool)
+	source.flagSet.Visit(func(setFlag *flag.Flag) {
+		setFlags[setFlag.Name] = true
+	})
+
+	names := []string{FlagName(key)}
+
+	for name, legacyKey := range legacyFlagNames {
+		if legacyKey == key {
+			names = append(names, name)
+		}
+	}
+
+	for _, name := range names {
+		if value := strings.TrimSpace(*source.values[name]); setFlags[name] && len(value) != 0 {
+			return value, true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// FlagName returns the name of the command line flag the provided setting is read from.
+func FlagName(key string) string {
+	return strings.Replace(key, "/", ".", -1)
+}
@@ -0,0 +1,622 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"net"
+
+	"golang.org/x/net/context"
+)
+
+// defaultOrigin is the origin reported for the settings no source provides.
+const defaultOrigin = "default"
+
+// LayeredConfigurationReader implements ConfigurationReader reading every setting from a list of sources. The sources
+// are consulted in order, the first source providing a setting wins and the default value of the setting is used if no
+// source provides it. Every value is parsed and validated before it is returned.
+type LayeredConfigurationReader struct {
+	Sources []Source
+}
+
+// resolvedValue is the value of a setting along with the source it was read from
+type resolvedValue struct {
+	value  interface{}
+	raw    string
+	origin string
+}
+
+// NewConfigurationReader creates the reader of the settings of the service. The settings are read from the command line
+// flags, the environment variables, the configuration file and Consul, in this order of precedence, then fall back to
+// their default value. The configuration file is only read if the config-file setting is provided by a flag or an
+// environment variable, and Consul is only read if the consul/address setting is provided by any of the other sources.
+// flagSet: Mandatory. The flag set the flags of the settings are defined in.
+// arguments: Mandatory. The command line arguments, without the program name.
+// Returns either the new configuration reader or error if the arguments or the configuration file cannot be parsed.
+func NewConfigurationReader(flagSet *flag.FlagSet, arguments []string) (LayeredConfigurationReader, error) {
+	flagSource := NewFlagSource(flagSet)
+
+	if err := flagSet.Parse(arguments); err != nil {
+		return LayeredConfigurationReader{}, err
+	}
+
+	reader := LayeredConfigurationReader{Sources: []Source{flagSource, EnvironmentSource{}}}
+
+	configFilePath, err := reader.getString(configFileKey)
+
+	if err != nil {
+		return LayeredConfigurationReader{}, err
+	}
+
+	if len(configFilePath) != 0 {
+		fileSource, err := NewFileSource(configFilePath)
+
+		if err != nil {
+			return LayeredConfigurationReader{}, err
+		}
+
+		reader.Sources = append(reader.Sources, fileSource)
+	}
+
+	consulAddress, err := reader.getString(consulAddressKey)
+
+	if err != nil {
+		return LayeredConfigurationReader{}, err
+	}
+
+	consulScheme, err := reader.getString(consulSchemeKey)
+
+	if err != nil {
+		return LayeredConfigurationReader{}, err
+	}
+
+	if len(consulAddress) != 0 {
+		reader.Sources = append(reader.Sources, ConsulSource{ConsulAddress: consulAddress, ConsulScheme: consulScheme})
+	}
+
+	return reader, nil
+}
+
+// GetListeningPort returns the port the service should listen on to serve the HTTP request
+func (reader LayeredConfigurationReader) GetListeningPort() (int, error) {
+	return reader.getInt(listeningPortKey)
+}
+
+// GetGRPCListeningPort returns the port the service should listen on to serve the gRPC requests. Zero means gRPC is not served.
+func (reader LayeredConfigurationReader) GetGRPCListeningPort() (int, error) {
+	return reader.getInt(grpcListeningPortKey)
+}
+
+// GetCassandraHosts returns the list of Cassandra host addresses.
+func (reader LayeredConfigurationReader) GetCassandraHosts() ([]string, error) {
+	resolved, err := reader.resolve(cassandraHostsKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resolved.value.([]string), nil
+}
+
+// GetCassandraKeyspace returns the name of Cassandra key space that the service data is stored under.
+func (reader LayeredConfigurationReader) GetCassandraKeyspace() (string, error) {
+	return reader.getString(cassandraKeyspaceKey)
+}
+
+// GetCassandraProtocolVersion returns the Cassandra protocol version to be used when connecting to Cassandra database.
+func (reader LayeredConfigurationReader) GetCassandraProtocolVersion() (int, error) {
+	return reader.getInt(cassandraProtocolVersionKey)
+}
+
+// GetCassandraConnection returns how the service authenticates, encrypts, routes and retries its connections to Cassandra.
+func (reader LayeredConfigurationReader) GetCassandraConnection() (CassandraConnection, error) {
+	var connection CassandraConnection
+	var err error
+
+	if connection.Username, err = reader.getString(cassandraUsernameKey); err != nil {
+		return CassandraConnection{}, err
+	}
+
+	if connection.Password, err = reader.getString(cassandraPasswordKey); err != nil {
+		return CassandraConnection{}, err
+	}
+
+	if connection.TLSEnabled, err = reader.getBool(cassandraTLSEnabledKey); err != nil {
+		return CassandraConnection{}, err
+	}
+
+	if connection.TLSCAFilePath, err = reader.getString(cassandraTLSCAFilePathKey); err != nil {
+		return CassandraConnection{}, err
+	}
+
+	if connection.TLSCertificateFilePath, err = reader.getString(cassandraTLSCertificateFilePathKey); err != nil {
+		return CassandraConnection{}, err
+	}
+
+	if connection.TLSKeyFilePath, err = reader.getString(cassandraTLSKeyFilePathKey); err != nil {
+		return CassandraConnection{}, err
+	}
+
+	if connection.TLSHostVerificationEnabled, err = reader.getBool(cassandraTLSHostVerificationEnabledKey); err != nil {
+		return CassandraConnection{}, err
+	}
+
+	if connection.ReadConsistency, err = reader.getString(cassandraReadConsistencyKey); err != nil {
+		return CassandraConnection{}, err
+	}
+
+	if connection.WriteConsistency, err = reader.getString(cassandraWriteConsistencyKey); err != nil {
+		return CassandraConnection{}, err
+	}
+
+	if connection.ConnectTimeout, err = reader.getInt(cassandraConnectTimeoutKey); err != nil {
+		return CassandraConnection{}, err
+	}
+
+	if connection.QueryTimeout, err = reader.getInt(cassandraQueryTimeoutKey); err != nil {
+		return CassandraConnection{}, err
+	}
+
+	if connection.RetryPolicy, err = reader.getString(cassandraRetryPolicyKey); err != nil {
+		return CassandraConnection{}, err
+	}
+
+	if connection.RetryAttempts, err = reader.getInt(cassandraRetryAttemptsKey); err != nil {
+		return CassandraConnection{}, err
+	}
+
+	if connection.TokenAwareRouting, err = reader.getBool(cassandraTokenAwareRoutingKey); err != nil {
+		return CassandraConnection{}, err
+	}
+
+	if connection.LocalDatacenter, err = reader.getString(cassandraLocalDatacenterKey); err != nil {
+		return CassandraConnection{}, err
+	}
+
+	if connection.ConnectionsPerHost, err = reader.getInt(cassandraConnectionsPerHostKey); err != nil {
+		return CassandraConnection{}, err
+	}
+
+	if (len(connection.Username) == 0) != (len(connection.Password) == 0) {
+		return CassandraConnection{}, fmt.Errorf("Settings %s and %s must be provided together.", cassandraUsernameKey, cassandraPasswordKey)
+	}
+
+	if (len(connection.TLSCertificateFilePath) == 0) != (len(connection.TLSKeyFilePath) == 0) {
+		return CassandraConnection{}, fmt.Errorf("Settings %s and %s must be provided together.", cassandraTLSCertificateFilePathKey, cassandraTLSKeyFilePathKey)
+	}
+
+	return connection, nil
+}
+
+// GetTLS returns how the service encrypts the connections of its clients. The service serves plain HTTP by default.
+func (reader LayeredConfigurationReader) GetTLS() (TLS, error) {
+	certificateFilePath, err := reader.getString(tlsCertificateFilePathKey)
+
+	if err != nil {
+		return TLS{}, err
+	}
+
+	keyFilePath, err := reader.getString(tlsKeyFilePathKey)
+
+	if err != nil {
+		return TLS{}, err
+	}
+
+	if (len(certificateFilePath) == 0) != (len(keyFilePath) == 0) {
+		return TLS{}, fmt.Errorf("Settings %s and %s must be provided together.", tlsCertificateFilePathKey, tlsKeyFilePathKey)
+	}
+
+	clientCAFilePath, err := reader.getString(tlsClientCAFilePathKey)
+
+	if err != nil {
+		return TLS{}, err
+	}
+
+	clientCertificateRequired, err := reader.getBool(tlsClientCertificateRequiredKey)
+
+	if err != nil {
+		return TLS{}, err
+	}
+
+	if clientCertificateRequired && len(clientCAFilePath) == 0 {
+		return TLS{}, fmt.Errorf("Setting %s must be provided when the client certificate is required.", tlsClientCAFilePathKey)
+	}
+
+	minimumVersion, err := reader.getString(tlsMinimumVersionKey)
+
+	if err != nil {
+		return TLS{}, err
+	}
+
+	return TLS{
+		CertificateFilePath:       certificateFilePath,
+		KeyFilePath:               keyFilePath,
+		ClientCAFilePath:          clientCAFilePath,
+		ClientCertificateRequired: clientCertificateRequired,
+		MinimumVersion:            minimumVersion,
+	}, nil
+}
+
+// GetDefaultRateLimit returns the rate limit applied to every caller that does not have its own rate limit.
+func (reader LayeredConfigurationReader) GetDefaultRateLimit() (RateLimit, error) {
+	requestsPerSecond, err := reader.getFloat(rateLimitRequestsPerSecondKey)
+
+	if err != nil {
+		return RateLimit{}, err
+	}
+
+	burst, err := reader.getInt(rateLimitBurstKey)
+
+	if err != nil {
+		return RateLimit{}, err
+	}
+
+	return newRateLimit(requestsPerSecond, burst), nil
+}
+
+// GetTenantRateLimits returns the rate limits overridden per tenant, keyed by tenant unique identifier.
+// The setting holds comma separated entries in form of tenantID=requestsPerSecond:burst
+func (reader LayeredConfigurationReader) GetTenantRateLimits() (map[string]RateLimit, error) {
+	resolved, err := reader.resolve(rateLimitTenantsKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resolved.value.(map[string]RateLimit), nil
+}
+
+// GetRateLimitTrustedProxies returns the networks of the reverse proxies whose X-Forwarded-For header identifies the
+// anonymous callers. Empty means the service is reached directly.
+// The setting holds a comma separated list of IP addresses or CIDR ranges
+func (reader LayeredConfigurationReader) GetRateLimitTrustedProxies() ([]*net.IPNet, error) {
+	resolved, err := reader.resolve(rateLimitTrustedProxiesKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resolved.value.([]*net.IPNet), nil
+}
+
+// GetPrincipalPermissions returns the permissions explicitly granted to the authenticated principals, keyed by principal name.
+// The setting holds comma separated entries in form of principalName=permission1|permission2
+func (reader LayeredConfigurationReader) GetPrincipalPermissions() (map[string][]string, error) {
+	resolved, err := reader.resolve(principalPermissionsKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resolved.value.(map[string][]string), nil
+}
+
+// GetPrincipalTenants returns the tenant and application the authenticated principals act on behalf of, keyed by
+// principal name. The setting holds comma separated entries in form of principalName=tenantID/applicationID, the
+// application being optional.
+func (reader LayeredConfigurationReader) GetPrincipalTenants() (map[string]PrincipalTenant, error) {
+	resolved, err := reader.resolve(principalTenantsKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resolved.value.(map[string]PrincipalTenant), nil
+}
+
+// GetSensitiveFieldPolicy returns how sensitive fields are resolved for callers without the required permission, either mask or error.
+func (reader LayeredConfigurationReader) GetSensitiveFieldPolicy() (string, error) {
+	return reader.getString(sensitiveFieldPolicyKey)
+}
+
+// GetCORSAllowedOrigins returns the origins allowed to call the API from a browser. * allows any origin.
+func (reader LayeredConfigurationReader) GetCORSAllowedOrigins() ([]string, error) {
+	resolved, err := reader.resolve(corsAllowedOriginsKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resolved.value.([]string), nil
+}
+
+// GetMaxRequestBodySize returns the maximum size of a request body in bytes.
+func (reader LayeredConfigurationReader) GetMaxRequestBodySize() (int, error) {
+	return reader.getInt(maxRequestBodySizeKey)
+}
+
+// GetMaxQueryDepth returns the maximum nesting depth of the fields of a GraphQL query. Zero means no limit.
+func (reader LayeredConfigurationReader) GetMaxQueryDepth() (int, error) {
+	return reader.getInt(maxQueryDepthKey)
+}
+
+// GetMaxQueryComplexity returns the maximum estimated cost of a GraphQL query. Zero means no limit.
+func (reader LayeredConfigurationReader) GetMaxQueryComplexity() (int, error) {
+	return reader.getInt(maxQueryComplexityKey)
+}
+
+// GetSubscriptionKeepAliveInterval returns how often, in seconds, a ping is sent over the WebSocket connections of GraphQL subscriptions.
+func (reader LayeredConfigurationReader) GetSubscriptionKeepAliveInterval() (int, error) {
+	return reader.getInt(subscriptionKeepAliveIntervalKey)
+}
+
+// GetSubscriptionConnectionInitTimeout returns how long, in seconds, a WebSocket client has to initialise the connection before it is closed.
+func (reader LayeredConfigurationReader) GetSubscriptionConnectionInitTimeout() (int, error) {
+	return reader.getInt(subscriptionConnectionInitTimeoutKey)
+}
+
+// GetSubscriptionBufferSize returns the number of events a GraphQL subscription can hold before the subscriber is considered too slow.
+func (reader LayeredConfigurationReader) GetSubscriptionBufferSize() (int, error) {
+	return reader.getInt(subscriptionBufferSizeKey)
+}
+
+// GetSubscriptionAnonymousAccessAllowed returns whether WebSocket clients without an authenticated principal can subscribe.
+func (reader LayeredConfigurationReader) GetSubscriptionAnonymousAccessAllowed() (bool, error) {
+	return reader.getBool(subscriptionAnonymousAccessAllowedKey)
+}
+
+// GetIntrospectionEnabled returns whether the GraphQL schema can be queried using the __schema and __type introspection fields.
+func (reader LayeredConfigurationReader) GetIntrospectionEnabled() (bool, error) {
+	return reader.getBool(introspectionEnabledKey)
+}
+
+// GetGraphiQLEnabled returns whether the GraphiQL explorer is served to the browsers requesting the API. Disabled by default.
+func (reader LayeredConfigurationReader) GetGraphiQLEnabled() (bool, error) {
+	return reader.getBool(graphiQLEnabledKey)
+}
+
+// GetGraphiQLAssetsDirectory returns the directory holding the vendored GraphiQL assets served to the explorer.
+func (reader LayeredConfigurationReader) GetGraphiQLAssetsDirectory() (string, error) {
+	return reader.getString(graphiQLAssetsDirectoryKey)
+}
+
+// GetPersistedQueries returns which queries the clients can execute by sending their hash instead of the query. The
+// manifest, if provided, is loaded and the hash of every query checked.
+func (reader LayeredConfigurationReader) GetPersistedQueries() (PersistedQueries, error) {
+	mode, err := reader.getString(persistedQueryModeKey)
+
+	if err != nil {
+		return PersistedQueries{}, err
+	}
+
+	manifestFilePath, err := reader.getString(persistedQueryManifestFilePathKey)
+
+	if err != nil {
+		return PersistedQueries{}, err
+	}
+
+	if mode == "strict" && len(manifestFilePath) == 0 {
+		return PersistedQueries{}, fmt.Errorf("Setting %s must be provided in strict mode.", persistedQueryManifestFilePathKey)
+	}
+
+	cacheSize, err := reader.getInt(persistedQueryCacheSizeKey)
+
+	if err != nil {
+		return PersistedQueries{}, err
+	}
+
+	var manifest map[string]string
+
+	if len(manifestFilePath) != 0 {
+		if manifest, err = loadPersistedQueryManifest(manifestFilePath); err != nil {
+			return PersistedQueries{}, err
+		}
+	}
+
+	return PersistedQueries{Mode: mode, Manifest: manifest, CacheSize: cacheSize}, nil
+}
+
+// GetMetricsEnabled returns whether the metrics are recorded and exposed to Prometheus on the /metrics path. Enabled by default.
+func (reader LayeredConfigurationReader) GetMetricsEnabled() (bool, error) {
+	return reader.getBool(metricsEnabledKey)
+}
+
+// GetLogFormat returns the format of the log lines, either json or logfmt.
+func (reader LayeredConfigurationReader) GetLogFormat() (string, error) {
+	return reader.getString(logFormatKey)
+}
+
+// GetLogLevel returns the lowest level of the log lines written, either debug, info, warn or error.
+func (reader LayeredConfigurationReader) GetLogLevel() (string, error) {
+	return reader.getString(logLevelKey)
+}
+
+// GetServerTimeouts returns how long the HTTP server waits on the clients and on the in-flight requests on shutdown.
+func (reader LayeredConfigurationReader) GetServerTimeouts() (ServerTimeouts, error) {
+	readTimeout, err := reader.getInt(serverReadTimeoutKey)
+
+	if err != nil {
+		return ServerTimeouts{}, err
+	}
+
+	writeTimeout, err := reader.getInt(serverWriteTimeoutKey)
+
+	if err != nil {
+		return ServerTimeouts{}, err
+	}
+
+	idleTimeout, err := reader.getInt(serverIdleTimeoutKey)
+
+	if err != nil {
+		return ServerTimeouts{}, err
+	}
+
+	shutdownTimeout, err := reader.getInt(serverShutdownTimeoutKey)
+
+	if err != nil {
+		return ServerTimeouts{}, err
+	}
+
+	return ServerTimeouts{
+		ReadTimeout:     readTimeout,
+		WriteTimeout:    writeTimeout,
+		IdleTimeout:     idleTimeout,
+		ShutdownTimeout: shutdownTimeout,
+	}, nil
+}
+
+// GetTracing returns how the spans of the served requests are sampled and exported. Spans are not exported by default.
+func (reader LayeredConfigurationReader) GetTracing() (Tracing, error) {
+	exporter, err := reader.getString(tracingExporterKey)
+
+	if err != nil {
+		return Tracing{}, err
+	}
+
+	filePath, err := reader.getString(tracingFilePathKey)
+
+	if err != nil {
+		return Tracing{}, err
+	}
+
+	if exporter == "file" && len(filePath) == 0 {
+		return Tracing{}, fmt.Errorf("Setting %s must be provided when the file exporter is used.", tracingFilePathKey)
+	}
+
+	otlpEndpoint, err := reader.getString(tracingOTLPEndpointKey)
+
+	if err != nil {
+		return Tracing{}, err
+	}
+
+	if exporter == "otlp" && len(otlpEndpoint) == 0 {
+		return Tracing{}, fmt.Errorf("Setting %s must be provided when the otlp exporter is used.", tracingOTLPEndpointKey)
+	}
+
+	otlpInsecure, err := reader.getBool(tracingOTLPInsecureKey)
+
+	if err != nil {
+		return Tracing{}, err
+	}
+
+	sampleRatio, err := reader.getFloat(tracingSampleRatioKey)
+
+	if err != nil {
+		return Tracing{}, err
+	}
+
+	return Tracing{
+		Exporter:     exporter,
+		FilePath:     filePath,
+		OTLPEndpoint: otlpEndpoint,
+		OTLPInsecure: otlpInsecure,
+		SampleRatio:  sampleRatio,
+	}, nil
+}
+
+// GetIdempotencyKeyTTL returns how long, in seconds, the result of a create request sent with an idempotency key is kept.
+// Defaults to 24 hours.
+func (reader LayeredConfigurationReader) GetIdempotencyKeyTTL() (int, error) {
+	return reader.getInt(idempotencyKeyTTLKey)
+}
+
+// CheckHealth checks the sources depending on a remote service, such as Consul, can be read.
+// ctx: Mandatory. The context of the check, the check stops once the context is done.
+// Returns error if any of the sources cannot be read.
+func (reader LayeredConfigurationReader) CheckHealth(ctx context.Context) error {
+	for _, source := range reader.Sources {
+		if checker, ok := source.(healthChecker); ok {
+			if err := checker.CheckHealth(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Watch starts watching, in the background, the sources that can report the changes of their settings, such as Consul,
+// until the provided channel is closed. Nothing is watched if no source can report its changes.
+// stop: Mandatory. Closed to stop watching.
+// notify: Mandatory. Called with nil every time the settings may have changed, or with the error preventing a source
+// from being watched.
+func (reader LayeredConfigurationReader) Watch(stop <-chan struct{}, notify func(err error)) {
+	for _, source := range reader.Sources {
+		if watcher, ok := source.(Watcher); ok {
+			watcher.Watch(stop, notify)
+		}
+	}
+}
+
+// resolve reads the provided setting from the first source providing it, or falls back to its default value, then
+// parses and validates the value.
+// key: Mandatory. The key of the setting.
+// Returns either the resolved value or error if a source cannot be read, or if the value is missing or not valid.
+func (reader LayeredConfigurationReader) resolve(key string) (resolvedValue, error) {
+	setting := findSetting(key)
+	raw, origin, err := reader.lookup(setting)
+
+	if err != nil {
+		return resolvedValue{}, err
+	}
+
+	return setting.resolve(raw, origin)
+}
+
+// lookup reads the raw value of the provided setting from the first source providing it, or falls back to its default
+// value.
+// setting: Mandatory. The setting to read.
+// Returns either the raw value along with its origin or error if a source cannot be read or the setting is required and
+// no source provides it.
+func (reader LayeredConfigurationReader) lookup(setting setting) (string, string, error) {
+	for _, source := range reader.Sources {
+		raw, ok, err := source.Lookup(setting.key)
+
+		if err != nil {
+			return "", "", err
+		}
+
+		if ok {
+			return raw, source.Name(), nil
+		}
+	}
+
+	if setting.required {
+		return "", "", fmt.Errorf(
+			"Setting %s must be provided, e.g. using the -%s flag or the %s environment variable.",
+			setting.key,
+			FlagName(setting.key),
+			EnvironmentVariableName(setting.key))
+	}
+
+	return setting.defaultValue, defaultOrigin, nil
+}
+
+func (reader LayeredConfigurationReader) getString(key string) (string, error) {
+	resolved, err := reader.resolve(key)
+
+	if err != nil {
+		return "", err
+	}
+
+	return resolved.value.(string), nil
+}
+
+func (reader LayeredConfigurationReader) getInt(key string) (int, error) {
+	resolved, err := reader.resolve(key)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return resolved.value.(int), nil
+}
+
+func (reader LayeredConfigurationReader) getBool(key string) (bool, error) {
+	resolved, err := reader.resolve(key)
+
+	if err != nil {
+		return false, err
+	}
+
+	return resolved.value.(bool), nil
+}
+
+func (reader LayeredConfigurationReader) getFloat(key string) (float64, error) {
+	resolved, err := reader.resolve(key)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return resolved.value.(float64), nil
+}
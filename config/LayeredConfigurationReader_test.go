@@ -0,0 +1,554 @@
+package config_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/micro-business/TenantService/config"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// stubSource provides the settings held in the map, named as the source it stands for.
+type stubSource struct {
+	name   string
+	values map[string]string
+}
+
+func (source stubSource) Name() string {
+	return source.name
+}
+
+func (source stubSource) Lookup(key string) (string, bool, error) {
+	value, ok := source.values[key]
+
+	return value, ok, nil
+}
+
+var _ = Describe("LayeredConfigurationReader", func() {
+	var (
+		requiredSettings map[string]string
+		directory        string
+	)
+
+	BeforeEach(func() {
+		requiredSettings = map[string]string{
+			"endpoint/listening-port":         "8080",
+			"data/cassandra/hosts":            "host1, host2",
+			"data/cassandra/keyspace":         "tenant",
+			"data/cassandra/protocol-version": "4",
+		}
+
+		directory, _ = ioutil.TempDir("", "config")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(directory)
+	})
+
+	writeFile := func(name string, content string) string {
+		filePath := filepath.Join(directory, name)
+		ioutil.WriteFile(filePath, []byte(content), 0600)
+
+		return filePath
+	}
+
+	Context("when resolving a setting", func() {
+		It("should read the setting from the first source providing it", func() {
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{
+				stubSource{name: "flag", values: map[string]string{"endpoint/listening-port": "9090"}},
+				stubSource{name: "consul", values: requiredSettings},
+			}}
+
+			port, err := reader.GetListeningPort()
+
+			Expect(err).To(BeNil())
+			Expect(port).To(Equal(9090))
+		})
+
+		It("should fall back to the next source if the first source does not provide the setting", func() {
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{
+				stubSource{name: "flag", values: map[string]string{}},
+				stubSource{name: "consul", values: requiredSettings},
+			}}
+
+			hosts, err := reader.GetCassandraHosts()
+
+			Expect(err).To(BeNil())
+			Expect(hosts).To(Equal([]string{"host1", "host2"}))
+		})
+
+		It("should return the default value if no source provides the setting", func() {
+			reader := config.LayeredConfigurationReader{}
+
+			logLevel, err := reader.GetLogLevel()
+
+			Expect(err).To(BeNil())
+			Expect(logLevel).To(Equal("info"))
+		})
+
+		It("should return error if a required setting is not provided", func() {
+			reader := config.LayeredConfigurationReader{}
+
+			_, err := reader.GetCassandraKeyspace()
+
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("data/cassandra/keyspace must be provided"))
+			Expect(err.Error()).To(ContainSubstring("TENANT_SERVICE_DATA_CASSANDRA_KEYSPACE"))
+		})
+
+		It("should return error naming the origin if the value is not valid", func() {
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{
+				stubSource{name: "env", values: map[string]string{"endpoint/listening-port": "70000"}},
+			}}
+
+			_, err := reader.GetListeningPort()
+
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("Origin: env"))
+			Expect(err.Error()).To(ContainSubstring("Value: 70000"))
+		})
+
+		It("should return error if the value is not one of the allowed values", func() {
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{
+				stubSource{name: "file", values: map[string]string{"logging/format": "xml"}},
+			}}
+
+			_, err := reader.GetLogFormat()
+
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("should return error if the file exporter is used without a file path", func() {
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{
+				stubSource{name: "file", values: map[string]string{"tracing/exporter": "file"}},
+			}}
+
+			_, err := reader.GetTracing()
+
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("when reading the Cassandra connection", func() {
+		It("should return the default values if no source provides the settings", func() {
+			reader := config.LayeredConfigurationReader{}
+
+			connection, err := reader.GetCassandraConnection()
+
+			Expect(err).To(BeNil())
+			Expect(connection.ReadConsistency).To(Equal("quorum"))
+			Expect(connection.WriteConsistency).To(Equal("quorum"))
+			Expect(connection.RetryPolicy).To(Equal("none"))
+			Expect(connection.TokenAwareRouting).To(BeTrue())
+			Expect(connection.TLSHostVerificationEnabled).To(BeTrue())
+			Expect(connection.ConnectionsPerHost).To(Equal(2))
+		})
+
+		It("should return error if the username is provided without the password", func() {
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{
+				stubSource{name: "env", values: map[string]string{"data/cassandra/username": "cassandra"}},
+			}}
+
+			_, err := reader.GetCassandraConnection()
+
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("should return error if the read consistency only applies to writes", func() {
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{
+				stubSource{name: "env", values: map[string]string{"data/cassandra/read-consistency": "any"}},
+			}}
+
+			_, err := reader.GetCassandraConnection()
+
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("when reading the TLS settings", func() {
+		It("should serve plain HTTP if no source provides the settings", func() {
+			reader := config.LayeredConfigurationReader{}
+
+			tlsSettings, err := reader.GetTLS()
+
+			Expect(err).To(BeNil())
+			Expect(tlsSettings.CertificateFilePath).To(BeEmpty())
+			Expect(tlsSettings.MinimumVersion).To(Equal("1.2"))
+		})
+
+		It("should return error if the certificate is provided without the key", func() {
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{
+				stubSource{name: "env", values: map[string]string{"endpoint/tls/certificate-file-path": "certificate.pem"}},
+			}}
+
+			_, err := reader.GetTLS()
+
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("must be provided together"))
+		})
+
+		It("should return error if a client certificate is required without the client CA bundle", func() {
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{
+				stubSource{name: "env", values: map[string]string{
+					"endpoint/tls/certificate-file-path":       "certificate.pem",
+					"endpoint/tls/key-file-path":               "key.pem",
+					"endpoint/tls/client-certificate-required": "true",
+				}},
+			}}
+
+			_, err := reader.GetTLS()
+
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("endpoint/tls/client-ca-file-path"))
+		})
+	})
+
+	Context("when reading the persisted queries", func() {
+		const query = "{__typename}"
+		const otherQuery = "query Other {__typename}"
+
+		hashOf := func(query string) string {
+			hash := sha256.Sum256([]byte(query))
+
+			return hex.EncodeToString(hash[:])
+		}
+
+		readerOf := func(values map[string]string) config.LayeredConfigurationReader {
+			return config.LayeredConfigurationReader{Sources: []config.Source{stubSource{name: "env", values: values}}}
+		}
+
+		It("should support automatic persisted queries without manifest if no source provides the settings", func() {
+			persistedQueries, err := config.LayeredConfigurationReader{}.GetPersistedQueries()
+
+			Expect(err).To(BeNil())
+			Expect(persistedQueries.Mode).To(Equal("automatic"))
+			Expect(persistedQueries.Manifest).To(BeNil())
+			Expect(persistedQueries.CacheSize).To(Equal(1000))
+		})
+
+		It("should return error if strict mode is used without a manifest", func() {
+			_, err := readerOf(map[string]string{"endpoint/graphql/persisted-queries/mode": "strict"}).GetPersistedQueries()
+
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("endpoint/graphql/persisted-queries/manifest-file-path"))
+		})
+
+		It("should load Apollo persisted query manifests", func() {
+			manifestFilePath := writeFile("manifest.json",
+				`{"format": "apollo-persisted-query-manifest", "version": 1, "operations": [{"id": "`+hashOf(query)+`", "name": "Typename", "type": "query", "body": "`+query+`"}]}`)
+
+			persistedQueries, err := readerOf(map[string]string{
+				"endpoint/graphql/persisted-queries/mode":               "strict",
+				"endpoint/graphql/persisted-queries/manifest-file-path": manifestFilePath,
+			}).GetPersistedQueries()
+
+			Expect(err).To(BeNil())
+			Expect(persistedQueries.Manifest).To(Equal(map[string]string{hashOf(query): query}))
+		})
+
+		It("should load manifests mapping the hashes to the queries", func() {
+			manifestFilePath := writeFile("manifest.json", `{"`+hashOf(otherQuery)+`": "`+otherQuery+`"}`)
+
+			persistedQueries, err := readerOf(map[string]string{"endpoint/graphql/persisted-queries/manifest-file-path": manifestFilePath}).GetPersistedQueries()
+
+			Expect(err).To(BeNil())
+			Expect(persistedQueries.Manifest).To(Equal(map[string]string{hashOf(otherQuery): otherQuery}))
+		})
+
+		It("should return error if any hash does not match its query", func() {
+			manifestFilePath := writeFile("manifest.json", `{"`+hashOf(otherQuery)+`": "`+query+`"}`)
+
+			_, err := readerOf(map[string]string{"endpoint/graphql/persisted-queries/manifest-file-path": manifestFilePath}).GetPersistedQueries()
+
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("does not match"))
+		})
+
+		It("should return error if the manifest cannot be read", func() {
+			_, err := readerOf(map[string]string{"endpoint/graphql/persisted-queries/manifest-file-path": filepath.Join(directory, "missing.json")}).GetPersistedQueries()
+
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("when reading the trusted proxies of the rate limiter", func() {
+		It("should trust no proxy if no source provides the setting", func() {
+			reader := config.LayeredConfigurationReader{}
+
+			trustedProxies, err := reader.GetRateLimitTrustedProxies()
+
+			Expect(err).To(BeNil())
+			Expect(trustedProxies).To(BeEmpty())
+		})
+
+		It("should read the IP addresses and the CIDR ranges", func() {
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{
+				stubSource{name: "env", values: map[string]string{"endpoint/rate-limit/trusted-proxies": "10.0.0.0/8, 192.168.0.1, ::1"}},
+			}}
+
+			trustedProxies, err := reader.GetRateLimitTrustedProxies()
+
+			Expect(err).To(BeNil())
+			Expect(trustedProxies).To(HaveLen(3))
+			Expect(trustedProxies[0].String()).To(Equal("10.0.0.0/8"))
+			Expect(trustedProxies[1].String()).To(Equal("192.168.0.1/32"))
+			Expect(trustedProxies[2].String()).To(Equal("::1/128"))
+		})
+
+		It("should return error if an entry is neither an IP address nor a CIDR range", func() {
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{
+				stubSource{name: "env", values: map[string]string{"endpoint/rate-limit/trusted-proxies": "10.0.0.1, proxy"}},
+			}}
+
+			_, err := reader.GetRateLimitTrustedProxies()
+
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("when reading the principal tenants", func() {
+		It("should bind every principal to its tenant and optional application", func() {
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{
+				stubSource{name: "env", values: map[string]string{"endpoint/security/principal-tenants": "billing=tenant1, portal = tenant2/application2"}},
+			}}
+
+			principalTenants, err := reader.GetPrincipalTenants()
+
+			Expect(err).To(BeNil())
+			Expect(principalTenants).To(Equal(map[string]config.PrincipalTenant{
+				"billing": {TenantID: "tenant1"},
+				"portal":  {TenantID: "tenant2", ApplicationID: "application2"},
+			}))
+		})
+
+		It("should return error if an entry has no tenant", func() {
+			for _, value := range []string{"billing", "billing=", "billing=/application1", "=tenant1", "billing=tenant1/"} {
+				reader := config.LayeredConfigurationReader{Sources: []config.Source{
+					stubSource{name: "env", values: map[string]string{"endpoint/security/principal-tenants": value}},
+				}}
+
+				_, err := reader.GetPrincipalTenants()
+
+				Expect(err).NotTo(BeNil(), value)
+			}
+		})
+	})
+
+	Context("when reading the environment variables", func() {
+		It("should read the prefixed variable of the setting", func() {
+			source := config.EnvironmentSource{LookupEnv: func(name string) (string, bool) {
+				value, ok := map[string]string{"TENANT_SERVICE_ENDPOINT_RATE_LIMIT_BURST": "20"}[name]
+
+				return value, ok
+			}}
+
+			value, ok, err := source.Lookup("endpoint/rate-limit/burst")
+
+			Expect(err).To(BeNil())
+			Expect(ok).To(BeTrue())
+			Expect(value).To(Equal("20"))
+		})
+
+		It("should read the listening port from the PORT variable", func() {
+			source := config.EnvironmentSource{LookupEnv: func(name string) (string, bool) {
+				value, ok := map[string]string{"PORT": "5000"}[name]
+
+				return value, ok
+			}}
+
+			value, ok, _ := source.Lookup("endpoint/listening-port")
+
+			Expect(ok).To(BeTrue())
+			Expect(value).To(Equal("5000"))
+		})
+	})
+
+	Context("when reading a configuration file", func() {
+		It("should read the nested members of a YAML file", func() {
+			source, err := config.NewFileSource(writeFile("config.yaml", "data:\n  cassandra:\n    hosts:\n      - host1\n      - host2\n"))
+			Expect(err).To(BeNil())
+
+			value, ok, err := source.Lookup("data/cassandra/hosts")
+
+			Expect(err).To(BeNil())
+			Expect(ok).To(BeTrue())
+			Expect(value).To(Equal("host1,host2"))
+		})
+
+		It("should read the nested members of a JSON file", func() {
+			source, err := config.NewFileSource(writeFile("config.json", `{"endpoint": {"listening-port": 8080}}`))
+			Expect(err).To(BeNil())
+
+			value, ok, err := source.Lookup("endpoint/listening-port")
+
+			Expect(err).To(BeNil())
+			Expect(ok).To(BeTrue())
+			Expect(value).To(Equal("8080"))
+		})
+
+		It("should return error if the file is neither a YAML nor a JSON file", func() {
+			_, err := config.NewFileSource(writeFile("config.ini", "port=8080"))
+
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("should return error if the file holds an object under the key of a setting", func() {
+			source, _ := config.NewFileSource(writeFile("config.yaml", "endpoint:\n  listening-port:\n    value: 8080\n"))
+
+			_, _, err := source.Lookup("endpoint/listening-port")
+
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("when creating the reader from the command line", func() {
+		It("should let the flags override the configuration file", func() {
+			filePath := writeFile("config.yaml", "endpoint:\n  listening-port: 8080\nlogging:\n  level: debug\n")
+			flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+
+			reader, err := config.NewConfigurationReader(flagSet, []string{"-config-file", filePath, "-endpoint.listening-port", "9090"})
+			Expect(err).To(BeNil())
+
+			port, _ := reader.GetListeningPort()
+			logLevel, _ := reader.GetLogLevel()
+
+			Expect(port).To(Equal(9090))
+			Expect(logLevel).To(Equal("debug"))
+		})
+
+		It("should read the deprecated flags", func() {
+			flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+
+			reader, err := config.NewConfigurationReader(flagSet, []string{"-cassandra-keyspace", "legacy"})
+			Expect(err).To(BeNil())
+
+			keyspace, err := reader.GetCassandraKeyspace()
+
+			Expect(err).To(BeNil())
+			Expect(keyspace).To(Equal("legacy"))
+		})
+
+		It("should return error if the configuration file cannot be read", func() {
+			flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+
+			_, err := config.NewConfigurationReader(flagSet, []string{"-config-file", filepath.Join(directory, "missing.yaml")})
+
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("when inspecting the settings", func() {
+		findValue := func(values []config.SettingValue, key string) config.SettingValue {
+			for _, value := range values {
+				if value.Key == key {
+					return value
+				}
+			}
+
+			Fail("Setting " + key + " is not reported.")
+
+			return config.SettingValue{}
+		}
+
+		It("should report the effective value of every setting along with its origin", func() {
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{
+				stubSource{name: "flag", values: map[string]string{"endpoint/listening-port": "9090"}},
+				stubSource{name: "env", values: requiredSettings},
+			}}
+
+			values, errs := reader.Inspect()
+
+			Expect(errs).To(BeEmpty())
+			port := findValue(values, "endpoint/listening-port")
+
+			Expect(port.Value).To(Equal("9090"))
+			Expect(port.Origin).To(Equal("flag"))
+			Expect(findValue(values, "data/cassandra/keyspace").Origin).To(Equal("env"))
+			Expect(findValue(values, "logging/level").Origin).To(Equal("default"))
+		})
+
+		It("should mask the value of the secret settings", func() {
+			requiredSettings["data/cassandra/username"] = "tenant-service"
+			requiredSettings["data/cassandra/password"] = "s3cr3t"
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{stubSource{name: "file", values: requiredSettings}}}
+
+			values, errs := reader.Inspect()
+
+			Expect(errs).To(BeEmpty())
+
+			password := findValue(values, "data/cassandra/password")
+
+			Expect(password.Secret).To(BeTrue())
+			Expect(password.Value).NotTo(ContainSubstring("s3cr3t"))
+			Expect(password.Origin).To(Equal("file"))
+		})
+
+		It("should report every missing and not valid setting at once", func() {
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{stubSource{name: "env", values: map[string]string{
+				"endpoint/listening-port": "not-a-port",
+				"logging/level":           "verbose",
+				"data/cassandra/password": "s3cr3t",
+			}}}}
+
+			values, errs := reader.Inspect()
+
+			Expect(errs).To(HaveLen(6))
+			Expect(findValue(values, "endpoint/listening-port").Err).NotTo(BeNil())
+			Expect(findValue(values, "logging/level").Err).NotTo(BeNil())
+			Expect(findValue(values, "data/cassandra/hosts").Err).NotTo(BeNil())
+			Expect(findValue(values, "data/cassandra/hosts").Origin).To(BeEmpty())
+			Expect(findValue(values, "data/cassandra/keyspace").Err).NotTo(BeNil())
+			Expect(findValue(values, "data/cassandra/protocol-version").Err).NotTo(BeNil())
+			Expect(errs[5].Error()).To(ContainSubstring("must be provided together"))
+
+			for _, err := range errs {
+				Expect(err.Error()).NotTo(ContainSubstring("s3cr3t"))
+			}
+		})
+
+		It("should report the settings that are not valid together without reporting a not valid setting twice", func() {
+			requiredSettings["tracing/exporter"] = "file"
+			requiredSettings["data/cassandra/connect-timeout"] = "-1"
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{stubSource{name: "env", values: requiredSettings}}}
+
+			_, errs := reader.Inspect()
+
+			Expect(errs).To(HaveLen(2))
+			Expect(errs[0].Error()).To(ContainSubstring("data/cassandra/connect-timeout"))
+			Expect(errs[1].Error()).To(ContainSubstring("tracing/file-path"))
+		})
+
+		It("should report the TLS and persisted query settings that are not valid together", func() {
+			requiredSettings["endpoint/tls/certificate-file-path"] = "certificate.pem"
+			requiredSettings["endpoint/graphql/persisted-queries/mode"] = "strict"
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{stubSource{name: "env", values: requiredSettings}}}
+
+			_, errs := reader.Inspect()
+
+			Expect(errs).To(HaveLen(2))
+			Expect(errs[0].Error()).To(ContainSubstring("endpoint/tls/key-file-path"))
+			Expect(errs[1].Error()).To(ContainSubstring("endpoint/graphql/persisted-queries/manifest-file-path"))
+		})
+
+		It("should report the manifest of the persisted queries that does not match its hashes", func() {
+			requiredSettings["endpoint/graphql/persisted-queries/manifest-file-path"] = writeFile("manifest.json", `{"0000": "{__typename}"}`)
+			reader := config.LayeredConfigurationReader{Sources: []config.Source{stubSource{name: "env", values: requiredSettings}}}
+
+			_, errs := reader.Inspect()
+
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Error()).To(ContainSubstring("does not match"))
+		})
+	})
+})
+
+func TestLayeredConfigurationReader(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "LayeredConfigurationReader")
+}
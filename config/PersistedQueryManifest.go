@@ -0,0 +1,72 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// apolloManifestFormat is the format of the persisted query manifests generated by Apollo tooling.
+const apolloManifestFormat = "apollo-persisted-query-manifest"
+
+// loadPersistedQueryManifest loads the persisted queries from the provided manifest file. The file is either an Apollo
+// persisted query manifest or a JSON object mapping the sha256 hash of every query to the query.
+// filePath: Mandatory. The path to the manifest file.
+// Returns either the queries keyed by their lowercase hex encoded sha256 hash or error if the file cannot be read, is
+// malformed or any hash does not match its query.
+func loadPersistedQueryManifest(filePath string) (map[string]string, error) {
+	content, err := ioutil.ReadFile(filePath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	apolloManifest := struct {
+		Format     string `json:"format"`
+		Version    int    `json:"version"`
+		Operations []struct {
+			ID   string `json:"id"`
+			Body string `json:"body"`
+		} `json:"operations"`
+	}{}
+
+	queries := make(map[string]string)
+
+	if err = json.Unmarshal(content, &apolloManifest); err == nil && apolloManifest.Format == apolloManifestFormat {
+		if apolloManifest.Version != 1 {
+			return nil, fmt.Errorf("Persisted query manifest version is not supported. Version: %d", apolloManifest.Version)
+		}
+
+		for _, operation := range apolloManifest.Operations {
+			queries[operation.ID] = operation.Body
+		}
+	} else if err = json.Unmarshal(content, &queries); err != nil {
+		return nil, fmt.Errorf("Persisted query manifest must be either an Apollo manifest or a JSON object of queries keyed by hash. Error: %s", err.Error())
+	}
+
+	manifest := make(map[string]string)
+
+	for hash, query := range queries {
+		hash = strings.ToLower(hash)
+
+		if HashPersistedQuery(query) != hash {
+			return nil, fmt.Errorf("Persisted query hash does not match the query. Hash: %s", hash)
+		}
+
+		manifest[hash] = query
+	}
+
+	return manifest, nil
+}
+
+// HashPersistedQuery returns the lowercase hex encoded sha256 hash of the provided query, the hash the persisted
+// queries are keyed by.
+// query: Mandatory. The query to hash.
+func HashPersistedQuery(query string) string {
+	hash := sha256.Sum256([]byte(query))
+
+	return hex.EncodeToString(hash[:])
+}
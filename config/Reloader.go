@@ -0,0 +1,212 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/micro-business/Micro-Business-Core/common/diagnostics"
+)
+
+// LiveSettings defines the settings that are safe to change while the service is running. They are applied every time
+// the configuration is reloaded, the other settings only take effect once the service is restarted.
+type LiveSettings struct {
+	// LogLevel is the lowest level of the log lines written, either debug, info, warn or error.
+	LogLevel string
+
+	// DefaultRateLimit is the rate limit applied to every caller that does not have its own rate limit.
+	DefaultRateLimit RateLimit
+
+	// TenantRateLimits are the rate limits overridden per tenant, keyed by tenant unique identifier.
+	TenantRateLimits map[string]RateLimit
+
+	// CORSAllowedOrigins are the origins allowed to call the API from a browser. * allows any origin.
+	CORSAllowedOrigins []string
+
+	// IdempotencyKeyTTL is how long, in seconds, the result of a create request sent with an idempotency key is kept.
+	IdempotencyKeyTTL int
+}
+
+// Watcher contract, it reports the changes of the settings.
+type Watcher interface {
+	// Watch starts watching the settings in the background, until the provided channel is closed.
+	// stop: Mandatory. Closed to stop watching.
+	// notify: Mandatory. Called with nil every time the settings may have changed, or with the error preventing the
+	// settings from being watched. Watching is retried after an error.
+	Watch(stop <-chan struct{}, notify func(err error))
+}
+
+// Reloader reads the live settings again every time the configuration changes and applies them. A reload is rejected,
+// and the settings in effect are kept, if any of the live settings is not valid.
+type Reloader struct {
+	configurationReader ConfigurationReader
+	logger              log.Logger
+	mutex               sync.Mutex
+	settings            LiveSettings
+	appliers            []func(settings LiveSettings)
+}
+
+// NewReloader creates a reloader starting with the live settings currently provided by the configuration reader.
+// configurationReader: Mandatory. The reader the live settings are read from.
+// logger: Mandatory. The logger the applied changes and the rejected reloads are logged to.
+// Returns either the new reloader or error if any of the live settings is not valid.
+func NewReloader(configurationReader ConfigurationReader, logger log.Logger) (*Reloader, error) {
+	diagnostics.IsNotNil(configurationReader, "configurationReader", "configurationReader must be provided.")
+	diagnostics.IsNotNil(logger, "logger", "logger must be provided.")
+
+	settings, err := ReadLiveSettings(configurationReader)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reloader{configurationReader: configurationReader, logger: logger, settings: settings}, nil
+}
+
+// ReadLiveSettings reads the settings that are safe to change while the service is running.
+// configurationReader: Mandatory. The reader the live settings are read from.
+// Returns either the live settings or error if any of them cannot be read or is not valid.
+func ReadLiveSettings(configurationReader ConfigurationReader) (LiveSettings, error) {
+	logLevel, err := configurationReader.GetLogLevel()
+
+	if err != nil {
+		return LiveSettings{}, err
+	}
+
+	defaultRateLimit, err := configurationReader.GetDefaultRateLimit()
+
+	if err != nil {
+		return LiveSettings{}, err
+	}
+
+	tenantRateLimits, err := configurationReader.GetTenantRateLimits()
+
+	if err != nil {
+		return LiveSettings{}, err
+	}
+
+	corsAllowedOrigins, err := configurationReader.GetCORSAllowedOrigins()
+
+	if err != nil {
+		return LiveSettings{}, err
+	}
+
+	idempotencyKeyTTL, err := configurationReader.GetIdempotencyKeyTTL()
+
+	if err != nil {
+		return LiveSettings{}, err
+	}
+
+	return LiveSettings{
+		LogLevel:           logLevel,
+		DefaultRateLimit:   defaultRateLimit,
+		TenantRateLimits:   tenantRateLimits,
+		CORSAllowedOrigins: corsAllowedOrigins,
+		IdempotencyKeyTTL:  idempotencyKeyTTL,
+	}, nil
+}
+
+// Settings returns the live settings in effect.
+func (reloader *Reloader) Settings() LiveSettings {
+	reloader.mutex.Lock()
+	defer reloader.mutex.Unlock()
+
+	return reloader.settings
+}
+
+// OnReload registers a function applying the live settings. The function is called right away with the settings in
+// effect, then every time a reload changes any of the live settings.
+// apply: Mandatory. The function applying the live settings.
+func (reloader *Reloader) OnReload(apply func(settings LiveSettings)) {
+	diagnostics.IsNotNil(apply, "apply", "apply must be provided.")
+
+	reloader.mutex.Lock()
+	defer reloader.mutex.Unlock()
+
+	reloader.appliers = append(reloader.appliers, apply)
+	apply(reloader.settings)
+}
+
+// Reload reads the live settings again and applies them if any of them changed. Every changed setting is logged.
+// Returns error if any of the live settings is not valid, the reload is rejected and the settings in effect are kept.
+func (reloader *Reloader) Reload() error {
+	reloader.mutex.Lock()
+	defer reloader.mutex.Unlock()
+
+	settings, err := ReadLiveSettings(reloader.configurationReader)
+
+	if err != nil {
+		level.Error(reloader.logger).Log("msg", "Configuration reload rejected, the settings in effect are kept.", "err", err)
+
+		return err
+	}
+
+	changes := settings.changesFrom(reloader.settings)
+
+	if len(changes) == 0 {
+		level.Debug(reloader.logger).Log("msg", "Configuration reloaded, no live setting changed.")
+
+		return nil
+	}
+
+	for _, change := range changes {
+		level.Info(reloader.logger).Log("msg", "Setting changed.", "setting", change.key, "previous", change.previous, "current", change.current)
+	}
+
+	reloader.settings = settings
+
+	for _, apply := range reloader.appliers {
+		apply(settings)
+	}
+
+	level.Info(reloader.logger).Log("msg", "Configuration reloaded.", "changed", len(changes))
+
+	return nil
+}
+
+// Watch reloads the live settings every time the provided watcher reports a change, until the provided channel is closed.
+// The errors reported by the watcher are logged.
+// watcher: Mandatory. The watcher reporting the changes of the configuration.
+// stop: Mandatory. Closed to stop watching.
+func (reloader *Reloader) Watch(watcher Watcher, stop <-chan struct{}) {
+	diagnostics.IsNotNil(watcher, "watcher", "watcher must be provided.")
+
+	watcher.Watch(stop, func(err error) {
+		if err != nil {
+			level.Warn(reloader.logger).Log("msg", "Failed to watch the configuration, retrying.", "err", err)
+
+			return
+		}
+
+		reloader.Reload()
+	})
+}
+
+// settingChange defines the previous and the current value of a changed setting
+type settingChange struct {
+	key      string
+	previous string
+	current  string
+}
+
+// changesFrom returns the settings that differ from the provided previous settings.
+func (settings LiveSettings) changesFrom(previous LiveSettings) []settingChange {
+	changes := []settingChange{}
+
+	compare := func(key string, previousValue interface{}, currentValue interface{}) {
+		if !reflect.DeepEqual(previousValue, currentValue) {
+			changes = append(changes, settingChange{key: key, previous: fmt.Sprint(previousValue), current: fmt.Sprint(currentValue)})
+		}
+	}
+
+	compare(logLevelKey, previous.LogLevel, settings.LogLevel)
+	compare(rateLimitRequestsPerSecondKey, previous.DefaultRateLimit.RequestsPerSecond, settings.DefaultRateLimit.RequestsPerSecond)
+	compare(rateLimitBurstKey, previous.DefaultRateLimit.Burst, settings.DefaultRateLimit.Burst)
+	compare(rateLimitTenantsKey, previous.TenantRateLimits, settings.TenantRateLimits)
+	compare(corsAllowedOriginsKey, previous.CORSAllowedOrigins, settings.CORSAllowedOrigins)
+	compare(idempotencyKeyTTLKey, previous.IdempotencyKeyTTL, settings.IdempotencyKeyTTL)
+
+	return changes
+}
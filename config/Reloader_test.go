@@ -0,0 +1,110 @@
+package config_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/micro-business/TenantService/config"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// stubWatcher reports a change every time notify is called by the test.
+type stubWatcher struct {
+	notify func(err error)
+}
+
+func (watcher *stubWatcher) Watch(stop <-chan struct{}, notify func(err error)) {
+	watcher.notify = notify
+}
+
+var _ = Describe("Reloader", func() {
+	var (
+		values   map[string]string
+		output   *bytes.Buffer
+		reloader *config.Reloader
+		applied  []config.LiveSettings
+	)
+
+	BeforeEach(func() {
+		values = map[string]string{"logging/level": "info"}
+		output = &bytes.Buffer{}
+		applied = []config.LiveSettings{}
+
+		var err error
+		reader := config.LayeredConfigurationReader{Sources: []config.Source{stubSource{name: "consul", values: values}}}
+		reloader, err = config.NewReloader(reader, log.NewLogfmtLogger(output))
+		Expect(err).To(BeNil())
+
+		reloader.OnReload(func(settings config.LiveSettings) {
+			applied = append(applied, settings)
+		})
+	})
+
+	It("should apply the settings in effect as soon as the function is registered", func() {
+		Expect(applied).To(HaveLen(1))
+		Expect(applied[0].LogLevel).To(Equal("info"))
+		Expect(applied[0].CORSAllowedOrigins).To(Equal([]string{"*"}))
+	})
+
+	It("should apply and log the changed settings", func() {
+		values["logging/level"] = "debug"
+		values["endpoint/cors/allowed-origins"] = "https://app.example"
+
+		Expect(reloader.Reload()).To(Succeed())
+
+		Expect(applied).To(HaveLen(2))
+		Expect(applied[1].LogLevel).To(Equal("debug"))
+		Expect(applied[1].CORSAllowedOrigins).To(Equal([]string{"https://app.example"}))
+		Expect(reloader.Settings().LogLevel).To(Equal("debug"))
+		Expect(output.String()).To(ContainSubstring("setting=logging/level previous=info current=debug"))
+		Expect(output.String()).To(ContainSubstring("setting=endpoint/cors/allowed-origins"))
+		Expect(output.String()).To(ContainSubstring("changed=2"))
+	})
+
+	It("should not apply the settings if none changed", func() {
+		Expect(reloader.Reload()).To(Succeed())
+
+		Expect(applied).To(HaveLen(1))
+	})
+
+	It("should reject the reload and keep the settings in effect if any setting is not valid", func() {
+		values["logging/level"] = "debug"
+		values["endpoint/rate-limit/requests-per-second"] = "-1"
+
+		Expect(reloader.Reload()).NotTo(Succeed())
+
+		Expect(applied).To(HaveLen(1))
+		Expect(reloader.Settings().LogLevel).To(Equal("info"))
+		Expect(output.String()).To(ContainSubstring("Configuration reload rejected"))
+	})
+
+	It("should reload the settings every time the watcher reports a change", func() {
+		watcher := &stubWatcher{}
+		reloader.Watch(watcher, make(chan struct{}))
+
+		values["business/idempotency-key-ttl"] = "60"
+		watcher.notify(nil)
+
+		Expect(applied).To(HaveLen(2))
+		Expect(applied[1].IdempotencyKeyTTL).To(Equal(60))
+	})
+
+	It("should not reload the settings if the watcher reports an error", func() {
+		watcher := &stubWatcher{}
+		reloader.Watch(watcher, make(chan struct{}))
+
+		values["logging/level"] = "debug"
+		watcher.notify(errors.New("Consul is not reachable."))
+
+		Expect(applied).To(HaveLen(1))
+		Expect(output.String()).To(ContainSubstring("Failed to watch the configuration"))
+	})
+})
+
+func TestReloader(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Reloader")
+}
@@ -0,0 +1,446 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const configFileKey = "config-file"
+const consulAddressKey = "consul/address"
+const consulSchemeKey = "consul/scheme"
+const listeningPortKey = "endpoint/listening-port"
+const grpcListeningPortKey = "endpoint/grpc/listening-port"
+const cassandraHostsKey = "data/cassandra/hosts"
+const cassandraKeyspaceKey = "data/cassandra/keyspace"
+const cassandraProtocolVersionKey = "data/cassandra/protocol-version"
+const cassandraUsernameKey = "data/cassandra/username"
+const cassandraPasswordKey = "data/cassandra/password"
+const cassandraTLSEnabledKey = "data/cassandra/tls/enabled"
+const cassandraTLSCAFilePathKey = "data/cassandra/tls/ca-file-path"
+const cassandraTLSCertificateFilePathKey = "data/cassandra/tls/certificate-file-path"
+const cassandraTLSKeyFilePathKey = "data/cassandra/tls/key-file-path"
+const cassandraTLSHostVerificationEnabledKey = "data/cassandra/tls/host-verification-enabled"
+const cassandraReadConsistencyKey = "data/cassandra/read-consistency"
+const cassandraWriteConsistencyKey = "data/cassandra/write-consistency"
+const cassandraConnectTimeoutKey = "data/cassandra/connect-timeout"
+const cassandraQueryTimeoutKey = "data/cassandra/query-timeout"
+const cassandraRetryPolicyKey = "data/cassandra/retry-policy"
+const cassandraRetryAttemptsKey = "data/cassandra/retry-attempts"
+const cassandraTokenAwareRoutingKey = "data/cassandra/token-aware-routing"
+const cassandraLocalDatacenterKey = "data/cassandra/local-datacenter"
+const cassandraConnectionsPerHostKey = "data/cassandra/connections-per-host"
+const tlsCertificateFilePathKey = "endpoint/tls/certificate-file-path"
+const tlsKeyFilePathKey = "endpoint/tls/key-file-path"
+const tlsClientCAFilePathKey = "endpoint/tls/client-ca-file-path"
+const tlsClientCertificateRequiredKey = "endpoint/tls/client-certificate-required"
+const tlsMinimumVersionKey = "endpoint/tls/minimum-version"
+const rateLimitRequestsPerSecondKey = "endpoint/rate-limit/requests-per-second"
+const rateLimitBurstKey = "endpoint/rate-limit/burst"
+const rateLimitTenantsKey = "endpoint/rate-limit/tenants"
+const rateLimitTrustedProxiesKey = "endpoint/rate-limit/trusted-proxies"
+const principalPermissionsKey = "endpoint/security/principal-permissions"
+const principalTenantsKey = "endpoint/security/principal-tenants"
+const sensitiveFieldPolicyKey = "endpoint/security/sensitive-field-policy"
+const corsAllowedOriginsKey = "endpoint/cors/allowed-origins"
+const maxRequestBodySizeKey = "endpoint/max-request-body-size"
+const maxQueryDepthKey = "endpoint/graphql/max-query-depth"
+const maxQueryComplexityKey = "endpoint/graphql/max-query-complexity"
+const subscriptionKeepAliveIntervalKey = "endpoint/graphql/subscriptions/keep-alive-interval"
+const subscriptionConnectionInitTimeoutKey = "endpoint/graphql/subscriptions/connection-init-timeout"
+const subscriptionBufferSizeKey = "endpoint/graphql/subscriptions/buffer-size"
+const subscriptionAnonymousAccessAllowedKey = "endpoint/graphql/subscriptions/anonymous-access-allowed"
+const graphiQLEnabledKey = "endpoint/graphql/graphiql-enabled"
+const graphiQLAssetsDirectoryKey = "endpoint/graphql/graphiql-assets-directory"
+const introspectionEnabledKey = "endpoint/graphql/introspection-enabled"
+const persistedQueryModeKey = "endpoint/graphql/persisted-queries/mode"
+const persistedQueryManifestFilePathKey = "endpoint/graphql/persisted-queries/manifest-file-path"
+const persistedQueryCacheSizeKey = "endpoint/graphql/persisted-queries/cache-size"
+const metricsEnabledKey = "endpoint/metrics/enabled"
+const logFormatKey = "logging/format"
+const logLevelKey = "logging/level"
+const serverReadTimeoutKey = "endpoint/timeouts/read"
+const serverWriteTimeoutKey = "endpoint/timeouts/write"
+const serverIdleTimeoutKey = "endpoint/timeouts/idle"
+const serverShutdownTimeoutKey = "endpoint/timeouts/shutdown"
+const tracingExporterKey = "tracing/exporter"
+const tracingFilePathKey = "tracing/file-path"
+const tracingOTLPEndpointKey = "tracing/otlp-endpoint"
+const tracingOTLPInsecureKey = "tracing/otlp-insecure"
+const tracingSampleRatioKey = "tracing/sample-ratio"
+const idempotencyKeyTTLKey = "business/idempotency-key-ttl"
+
+// readConsistencies are the consistency levels the statements reading data can be executed at.
+var readConsistencies = []string{"one", "two", "three", "quorum", "all", "local_quorum", "local_one"}
+
+// writeConsistencies are the consistency levels the statements writing data can be executed at.
+var writeConsistencies = []string{"any", "one", "two", "three", "quorum", "all", "local_quorum", "each_quorum", "local_one"}
+
+// setting defines a setting of the service: the key it is read under from every source, its default value and how its
+// raw value is parsed and validated. Adding a setting only takes a new entry in settings and a getter.
+type setting struct {
+	key          string
+	description  string
+	defaultValue string
+
+	// required is true if the setting has no default value and must be provided by a source.
+	required bool
+
+	// secret is true if the value must never be written to the logs or the error messages.
+	secret bool
+
+	// parse converts the raw value to the typed value returned by the getter, or returns why the value is not valid.
+	parse func(value string) (interface{}, error)
+}
+
+// settings are all the settings of the service, in the order they are documented in.
+var settings = []setting{
+	{key: configFileKey, description: "Path to the YAML or JSON file the settings are read from.", parse: parseString},
+	{key: consulAddressKey, description: "Address of the Consul agent the settings are read from, in form of host:port. Consul is not used if empty.", parse: parseString},
+	{key: consulSchemeKey, description: "Scheme used to connect to the Consul agent, either http or https.", defaultValue: "http", parse: parseOneOf("http", "https")},
+	{key: listeningPortKey, description: "Port the HTTP requests are served on.", required: true, parse: parsePort},
+	{key: grpcListeningPortKey, description: "Port the gRPC requests are served on. Zero means gRPC is not served.", defaultValue: "0", parse: parseOptionalPort},
+	{key: cassandraHostsKey, description: "Comma separated list of the Cassandra host addresses.", required: true, parse: parseList},
+	{key: cassandraKeyspaceKey, description: "Cassandra keyspace the data is stored under.", required: true, parse: parseString},
+	{key: cassandraProtocolVersionKey, description: "Cassandra protocol version.", required: true, parse: parsePositiveInt},
+	{key: cassandraUsernameKey, description: "Username the service authenticates to Cassandra with. Authentication is not used if empty.", parse: parseString},
+	{key: cassandraPasswordKey, description: "Password the service authenticates to Cassandra with.", secret: true, parse: parseString},
+	{key: cassandraTLSEnabledKey, description: "Whether the connections to Cassandra are encrypted using TLS.", defaultValue: "false", parse: parseBool},
+	{key: cassandraTLSCAFilePathKey, description: "Path to the PEM encoded CA bundle the Cassandra node certificates are verified with. The system CA bundle is used if empty.", parse: parseString},
+	{key: cassandraTLSCertificateFilePathKey, description: "Path to the PEM encoded client certificate presented to Cassandra.", parse: parseString},
+	{key: cassandraTLSKeyFilePathKey, description: "Path to the PEM encoded private key of the client certificate presented to Cassandra.", parse: parseString},
+	{key: cassandraTLSHostVerificationEnabledKey, description: "Whether the host name of the Cassandra nodes is verified against their certificate.", defaultValue: "true", parse: parseBool},
+	{key: cassandraReadConsistencyKey, description: "Consistency level of the statements reading data, e.g. quorum or local_quorum.", defaultValue: "quorum", parse: parseOneOf(readConsistencies...)},
+	{key: cassandraWriteConsistencyKey, description: "Consistency level of the statements writing data, e.g. quorum or local_quorum.", defaultValue: "quorum", parse: parseOneOf(writeConsistencies...)},
+	{key: cassandraConnectTimeoutKey, description: "How long, in milliseconds, a connection to a Cassandra node can take to be established.", defaultValue: "11000", parse: parsePositiveInt},
+	{key: cassandraQueryTimeoutKey, description: "How long, in milliseconds, Cassandra can take to answer a statement.", defaultValue: "11000", parse: parsePositiveInt},
+	{key: cassandraRetryPolicyKey, description: "How the failed statements are retried, either none, simple or exponential.", defaultValue: "none", parse: parseOneOf("none", "simple", "exponential")},
+	{key: cassandraRetryAttemptsKey, description: "Number of times a failed statement is retried when a retry policy is used.", defaultValue: "3", parse: parsePositiveInt},
+	{key: cassandraTokenAwareRoutingKey, description: "Whether the statements are sent to the Cassandra nodes owning the data they target.", defaultValue: "true", parse: parseBool},
+	{key: cassandraLocalDatacenterKey, description: "Datacenter whose Cassandra nodes are preferred. The nodes of every datacenter are used alike if empty.", parse: parseString},
+	{key: cassandraConnectionsPerHostKey, description: "Number of connections opened to every Cassandra node.", defaultValue: "2", parse: parsePositiveInt},
+	{key: tlsCertificateFilePathKey, description: "Path to the PEM encoded certificate of the service. Plain HTTP is served if empty.", parse: parseString},
+	{key: tlsKeyFilePathKey, description: "Path to the PEM encoded private key of the service certificate.", parse: parseString},
+	{key: tlsClientCAFilePathKey, description: "Path to the PEM encoded CA bundle the client certificates are verified with.", parse: parseString},
+	{key: tlsClientCertificateRequiredKey, description: "Whether every client must present a certificate signed by the client CA bundle.", defaultValue: "false", parse: parseBool},
+	{key: tlsMinimumVersionKey, description: "Minimum TLS version accepted, either 1.2 or 1.3.", defaultValue: "1.2", parse: parseOneOf("1.2", "1.3")},
+	{key: rateLimitRequestsPerSecondKey, description: "Requests per second allowed per caller. Zero disables the rate limiting.", defaultValue: "0", parse: parseNonNegativeNumber},
+	{key: rateLimitBurstKey, description: "Requests a caller can make at once. Zero means the requests per second rounded up.", defaultValue: "0", parse: parseNonNegativeInt},
+	{key: rateLimitTenantsKey, description: "Rate limits overridden per tenant, comma separated entries in form of tenantID=requestsPerSecond:burst.", parse: parseTenantRateLimits},
+	{key: rateLimitTrustedProxiesKey, description: "Comma separated list of the IP addresses or CIDR ranges of the reverse proxies in front of the service. The anonymous callers reaching the service through them are identified by the X-Forwarded-For header. Empty means the service is reached directly.", parse: parseTrustedProxies},
+	{key: principalPermissionsKey, description: "Permissions granted to the principals, comma separated entries in form of principalName=permission1|permission2.", parse: parsePrincipalPermissions},
+	{key: principalTenantsKey, description: "Tenant and application the principals act on behalf of, comma separated entries in form of principalName=tenantID or principalName=tenantID/applicationID.", parse: parsePrincipalTenants},
+	{key: sensitiveFieldPolicyKey, description: "How sensitive fields are resolved for callers without the permission, either mask or error.", defaultValue: "mask", parse: parseOneOf("mask", "error")},
+	{key: corsAllowedOriginsKey, description: "Comma separated list of the origins allowed to call the API from a browser. * allows any origin.", defaultValue: "*", parse: parseList},
+	{key: maxRequestBodySizeKey, description: "Maximum size of a request body in bytes.", defaultValue: "1048576", parse: parsePositiveInt},
+	{key: maxQueryDepthKey, description: "Maximum nesting depth of a GraphQL query. Zero means no limit.", defaultValue: "10", parse: parseNonNegativeInt},
+	{key: maxQueryComplexityKey, description: "Maximum estimated cost of a GraphQL query. Zero means no limit.", defaultValue: "1000", parse: parseNonNegativeInt},
+	{key: subscriptionKeepAliveIntervalKey, description: "How often, in seconds, a ping is sent to the GraphQL subscribers.", defaultValue: "15", parse: parsePositiveInt},
+	{key: subscriptionConnectionInitTimeoutKey, description: "How long, in seconds, a WebSocket client has to initialise the connection.", defaultValue: "10", parse: parsePositiveInt},
+	{key: subscriptionBufferSizeKey, description: "Number of events a GraphQL subscription can hold before the subscriber is considered too slow.", defaultValue: "64", parse: parsePositiveInt},
+	{key: subscriptionAnonymousAccessAllowedKey, description: "Whether WebSocket clients without an authenticated principal can subscribe.", defaultValue: "false", parse: parseBool},
+	{key: graphiQLEnabledKey, description: "Whether the GraphiQL explorer is served to the browsers.", defaultValue: "false", parse: parseBool},
+	{key: graphiQLAssetsDirectoryKey, description: "Directory holding the vendored GraphiQL assets served to the explorer, required when the explorer is enabled.", parse: parseString},
+	{key: introspectionEnabledKey, description: "Whether the GraphQL schema can be introspected.", defaultValue: "true", parse: parseBool},
+	{key: persistedQueryModeKey, description: "How persisted queries are supported, either automatic, strict or disabled.", defaultValue: "automatic", parse: parseOneOf("automatic", "strict", "disabled")},
+	{key: persistedQueryManifestFilePathKey, description: "Path to the manifest of the persisted queries.", parse: parseString},
+	{key: persistedQueryCacheSizeKey, description: "Maximum number of automatic persisted queries kept in memory.", defaultValue: "1000", parse: parsePositiveInt},
+	{key: metricsEnabledKey, description: "Whether the metrics are exposed to Prometheus on the /metrics path.", defaultValue: "true", parse: parseBool},
+	{key: logFormatKey, description: "Format of the log lines, either json or logfmt.", defaultValue: "logfmt", parse: parseOneOf("json", "logfmt")},
+	{key: logLevelKey, description: "Lowest level of the log lines written, either debug, info, warn or error.", defaultValue: "info", parse: parseOneOf("debug", "info", "warn", "error")},
+	{key: serverReadTimeoutKey, description: "How long, in seconds, a request can take to be read. Zero means no timeout.", defaultValue: "30", parse: parseNonNegativeInt},
+	{key: serverWriteTimeoutKey, description: "How long, in seconds, a response can take to be written. Zero means no timeout.", defaultValue: "60", parse: parseNonNegativeInt},
+	{key: serverIdleTimeoutKey, description: "How long, in seconds, an idle keep-alive connection is kept open.", defaultValue: "120", parse: parseNonNegativeInt},
+	{key: serverShutdownTimeoutKey, description: "How long, in seconds, the in-flight requests are given to complete on shutdown.", defaultValue: "30", parse: parsePositiveInt},
+	{key: tracingExporterKey, description: "Exporter of the spans, either none, stdout, file or otlp.", defaultValue: "none", parse: parseOneOf("none", "stdout", "file", "otlp")},
+	{key: tracingFilePathKey, description: "Path of the file the spans are appended to when the file exporter is used.", parse: parseString},
+	{key: tracingOTLPEndpointKey, description: "Host and port of the OpenTelemetry collector when the otlp exporter is used.", parse: parseString},
+	{key: tracingOTLPInsecureKey, description: "Whether the spans are sent to the OpenTelemetry collector over plain HTTP.", defaultValue: "false", parse: parseBool},
+	{key: tracingSampleRatioKey, description: "Ratio of the traces started by the service that are sampled, between 0 and 1.", defaultValue: "1", parse: parseRatio},
+	{key: idempotencyKeyTTLKey, description: "How long, in seconds, the result of a create request sent with an idempotency key is kept.", defaultValue: "86400", parse: parsePositiveInt},
+}
+
+// findSetting returns the setting of the provided key. The key must be one of the keys of settings.
+func findSetting(key string) setting {
+	for _, setting := range settings {
+		if setting.key == key {
+			return setting
+		}
+	}
+
+	panic(fmt.Sprintf("Setting %s is not defined.", key))
+}
+
+func parseString(value string) (interface{}, error) {
+	return value, nil
+}
+
+func parseBool(value string) (interface{}, error) {
+	parsed, err := strconv.ParseBool(value)
+
+	if err != nil {
+		return nil, errors.New("must be a boolean value")
+	}
+
+	return parsed, nil
+}
+
+func parseInt(value string) (int, error) {
+	parsed, err := strconv.Atoi(value)
+
+	if err != nil {
+		return 0, errors.New("must be an integer value")
+	}
+
+	return parsed, nil
+}
+
+func parseNonNegativeInt(value string) (interface{}, error) {
+	parsed, err := parseInt(value)
+
+	if err != nil || parsed < 0 {
+		return nil, errors.New("must be a non-negative integer")
+	}
+
+	return parsed, nil
+}
+
+func parsePositiveInt(value string) (interface{}, error) {
+	parsed, err := parseInt(value)
+
+	if err != nil || parsed <= 0 {
+		return nil, errors.New("must be a positive integer")
+	}
+
+	return parsed, nil
+}
+
+func parsePort(value string) (interface{}, error) {
+	parsed, err := parseInt(value)
+
+	if err != nil || parsed <= 0 || parsed > math.MaxUint16 {
+		return nil, errors.New("must be a port number between 1 and 65535")
+	}
+
+	return parsed, nil
+}
+
+func parseOptionalPort(value string) (interface{}, error) {
+	parsed, err := parseInt(value)
+
+	if err != nil || parsed < 0 || parsed > math.MaxUint16 {
+		return nil, errors.New("must be a port number between 0 and 65535")
+	}
+
+	return parsed, nil
+}
+
+func parseNonNegativeNumber(value string) (interface{}, error) {
+	parsed, err := strconv.ParseFloat(value, 64)
+
+	if err != nil || parsed < 0 {
+		return nil, errors.New("must be a non-negative number")
+	}
+
+	return parsed, nil
+}
+
+func parseRatio(value string) (interface{}, error) {
+	parsed, err := strconv.ParseFloat(value, 64)
+
+	if err != nil || parsed < 0 || parsed > 1 {
+		return nil, errors.New("must be a number between 0 and 1")
+	}
+
+	return parsed, nil
+}
+
+func parseList(value string) (interface{}, error) {
+	items := []string{}
+
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); len(item) != 0 {
+			items = append(items, item)
+		}
+	}
+
+	if len(items) == 0 {
+		return nil, errors.New("must contain at least one item")
+	}
+
+	return items, nil
+}
+
+func parseOneOf(allowedValues ...string) func(value string) (interface{}, error) {
+	return func(value string) (interface{}, error) {
+		for _, allowedValue := range allowedValues {
+			if value == allowedValue {
+				return value, nil
+			}
+		}
+
+		return nil, fmt.Errorf("must be either %s or %s", strings.Join(allowedValues[:len(allowedValues)-1], ", "), allowedValues[len(allowedValues)-1])
+	}
+}
+
+func parseTenantRateLimits(value string) (interface{}, error) {
+	rateLimits := make(map[string]RateLimit)
+
+	if len(value) == 0 {
+		return rateLimits, nil
+	}
+
+	for _, entry := range strings.Split(value, ",") {
+		tenantAndRateLimit := strings.SplitN(strings.TrimSpace(entry), "=", 2)
+
+		if len(tenantAndRateLimit) != 2 {
+			return nil, fmt.Errorf("contains invalid entry %s", entry)
+		}
+
+		rateAndBurst := strings.SplitN(tenantAndRateLimit[1], ":", 2)
+
+		if len(rateAndBurst) != 2 {
+			return nil, fmt.Errorf("contains invalid entry %s", entry)
+		}
+
+		requestsPerSecond, err := parseNonNegativeNumber(strings.TrimSpace(rateAndBurst[0]))
+
+		if err != nil {
+			return nil, fmt.Errorf("contains invalid entry %s, requests per second %s", entry, err.Error())
+		}
+
+		burst, err := parseNonNegativeInt(strings.TrimSpace(rateAndBurst[1]))
+
+		if err != nil {
+			return nil, fmt.Errorf("contains invalid entry %s, burst %s", entry, err.Error())
+		}
+
+		rateLimits[strings.TrimSpace(tenantAndRateLimit[0])] = newRateLimit(requestsPerSecond.(float64), burst.(int))
+	}
+
+	return rateLimits, nil
+}
+
+func parseTrustedProxies(value string) (interface{}, error) {
+	trustedProxies := []*net.IPNet{}
+
+	if len(value) == 0 {
+		return trustedProxies, nil
+	}
+
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+
+		if _, network, err := net.ParseCIDR(entry); err == nil {
+			trustedProxies = append(trustedProxies, network)
+
+			continue
+		}
+
+		ipAddress := net.ParseIP(entry)
+
+		if ipAddress == nil {
+			return nil, fmt.Errorf("contains invalid entry %s, must be an IP address or a CIDR range", entry)
+		}
+
+		bits := 8 * net.IPv6len
+
+		if ipv4Address := ipAddress.To4(); ipv4Address != nil {
+			ipAddress, bits = ipv4Address, 8*net.IPv4len
+		}
+
+		trustedProxies = append(trustedProxies, &net.IPNet{IP: ipAddress, Mask: net.CIDRMask(bits, bits)})
+	}
+
+	return trustedProxies, nil
+}
+
+func parsePrincipalPermissions(value string) (interface{}, error) {
+	permissions := make(map[string][]string)
+
+	if len(value) == 0 {
+		return permissions, nil
+	}
+
+	for _, entry := range strings.Split(value, ",") {
+		principalAndPermissions := strings.SplitN(strings.TrimSpace(entry), "=", 2)
+
+		if len(principalAndPermissions) != 2 || len(strings.TrimSpace(principalAndPermissions[0])) == 0 {
+			return nil, fmt.Errorf("contains invalid entry %s", entry)
+		}
+
+		principalName := strings.TrimSpace(principalAndPermissions[0])
+
+		for _, permission := range strings.Split(principalAndPermissions[1], "|") {
+			if permission = strings.TrimSpace(permission); len(permission) != 0 {
+				permissions[principalName] = append(permissions[principalName], permission)
+			}
+		}
+	}
+
+	return permissions, nil
+}
+
+func parsePrincipalTenants(value string) (interface{}, error) {
+	principalTenants := make(map[string]PrincipalTenant)
+
+	if len(value) == 0 {
+		return principalTenants, nil
+	}
+
+	for _, entry := range strings.Split(value, ",") {
+		principalAndTenant := strings.SplitN(strings.TrimSpace(entry), "=", 2)
+
+		if len(principalAndTenant) != 2 || len(strings.TrimSpace(principalAndTenant[0])) == 0 {
+			return nil, fmt.Errorf("contains invalid entry %s", entry)
+		}
+
+		tenantAndApplication := strings.SplitN(principalAndTenant[1], "/", 2)
+		principalTenant := PrincipalTenant{TenantID: strings.TrimSpace(tenantAndApplication[0])}
+
+		if len(tenantAndApplication) == 2 {
+			principalTenant.ApplicationID = strings.TrimSpace(tenantAndApplication[1])
+
+			if len(principalTenant.ApplicationID) == 0 {
+				return nil, fmt.Errorf("contains invalid entry %s", entry)
+			}
+		}
+
+		if len(principalTenant.TenantID) == 0 {
+			return nil, fmt.Errorf("contains invalid entry %s", entry)
+		}
+
+		principalTenants[strings.TrimSpace(principalAndTenant[0])] = principalTenant
+	}
+
+	return principalTenants, nil
+}
+
+// newRateLimit creates the rate limit of the provided values. The burst defaults to the requests per second rounded up.
+func newRateLimit(requestsPerSecond float64, burst int) RateLimit {
+	if requestsPerSecond > 0 && burst == 0 {
+		burst = int(math.Ceil(requestsPerSecond))
+	}
+
+	return RateLimit{RequestsPerSecond: requestsPerSecond, Burst: burst}
+}
+
+// resolve parses and validates the provided raw value of the setting read from the provided origin.
+// Returns either the resolved value or error if the value is not valid. The value of a secret setting is not written
+// in the error.
+func (setting setting) resolve(raw string, origin string) (resolvedValue, error) {
+	value, err := setting.parse(raw)
+
+	if err != nil {
+		if setting.secret {
+			return resolvedValue{}, fmt.Errorf("Setting %s %s. Origin: %s", setting.key, err.Error(), origin)
+		}
+
+		return resolvedValue{}, fmt.Errorf("Setting %s %s. Origin: %s, Value: %s", setting.key, err.Error(), origin, raw)
+	}
+
+	return resolvedValue{value: value, raw: raw, origin: origin}, nil
+}
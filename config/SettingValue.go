@@ -0,0 +1,85 @@
+package config
+
+// maskedValue replaces the value of the secret settings whenever settings are reported.
+const maskedValue = "********"
+
+// SettingValue defines the effective value of a setting along with the source it is read from
+type SettingValue struct {
+	// Key is the key of the setting, e.g. endpoint/listening-port.
+	Key string
+
+	// Description describes what the setting controls.
+	Description string
+
+	// Value is the raw value in effect. The value of the secret settings is masked.
+	Value string
+
+	// Origin is the name of the source the value is read from, or default if no source provides it. Empty if the
+	// setting is missing or its sources cannot be read.
+	Origin string
+
+	// Secret is true if the value is masked.
+	Secret bool
+
+	// Err is the error preventing the setting from being used, nil if the value is valid.
+	Err error
+}
+
+// Inspect resolves every setting from all the sources without stopping at the first failure, so every missing or not
+// valid setting is reported at once.
+// Returns the effective value of every setting, in the documented order, along with every error found. The errors
+// include the settings that are valid on their own but not together with the others, such as the Cassandra username
+// provided without a password.
+func (reader LayeredConfigurationReader) Inspect() ([]SettingValue, []error) {
+	values := make([]SettingValue, 0, len(settings))
+	errs := []error{}
+
+	for _, setting := range settings {
+		value := SettingValue{Key: setting.key, Description: setting.description, Secret: setting.secret}
+		raw, origin, err := reader.lookup(setting)
+
+		if err == nil {
+			value.Value, value.Origin = raw, origin
+
+			if setting.secret && len(raw) != 0 {
+				value.Value = maskedValue
+			}
+
+			_, err = setting.resolve(raw, origin)
+		}
+
+		if err != nil {
+			value.Err = err
+			errs = appendDistinctError(errs, err)
+		}
+
+		values = append(values, value)
+	}
+
+	crossChecks := []func() error{
+		func() error { _, err := reader.GetCassandraConnection(); return err },
+		func() error { _, err := reader.GetTLS(); return err },
+		func() error { _, err := reader.GetPersistedQueries(); return err },
+		func() error { _, err := reader.GetTracing(); return err },
+	}
+
+	for _, check := range crossChecks {
+		if err := check(); err != nil {
+			errs = appendDistinctError(errs, err)
+		}
+	}
+
+	return values, errs
+}
+
+// appendDistinctError appends the provided error unless an error with the same message is already reported, as a
+// source that cannot be read fails every setting and the cross checks fail again on the settings already reported.
+func appendDistinctError(errs []error, err error) []error {
+	for _, reported := range errs {
+		if reported.Error() == err.Error() {
+			return errs
+		}
+	}
+
+	return append(errs, err)
+}
@@ -0,0 +1,20 @@
+package config
+
+import "golang.org/x/net/context"
+
+// Source contract, it provides the raw values of the settings. The settings are identified by the same key in every
+// source, e.g. endpoint/listening-port, each source maps the key to its own naming convention.
+type Source interface {
+	// Name returns the name of the source, reported as the origin of the values it provides, e.g. env or consul.
+	Name() string
+
+	// Lookup returns the raw value of the provided setting.
+	// key: Mandatory. The key of the setting.
+	// Returns the value and true if the source provides the setting, false if it does not, or error if something goes wrong.
+	Lookup(key string) (string, bool, error)
+}
+
+// healthChecker is implemented by the sources that depend on a remote service, such as Consul.
+type healthChecker interface {
+	CheckHealth(ctx context.Context) error
+}
@@ -0,0 +1,32 @@
+package contract
+
+import (
+	"fmt"
+
+	"github.com/micro-business/Micro-Business-Core/system"
+)
+
+// NotFoundError is the error returned when the requested tenant or tenant application does not exist.
+type NotFoundError struct {
+	message string
+}
+
+// NewTenantNotFoundError creates the error returned when the provided tenant does not exist.
+func NewTenantNotFoundError(tenantID system.UUID) error {
+	return NotFoundError{message: fmt.Sprintf("Tenant not found. Tenant ID: %s", tenantID.String())}
+}
+
+// NewApplicationNotFoundError creates the error returned when the provided tenant application does not exist.
+func NewApplicationNotFoundError(tenantID system.UUID, applicationID system.UUID) error {
+	return NotFoundError{message: fmt.Sprintf("Tenant Application not found. Tenant ID: %s, Application ID: %s", tenantID.String(), applicationID.String())}
+}
+
+func (err NotFoundError) Error() string {
+	return err.message
+}
+
+// NotFound always returns true. The callers outside the data layer detect the error by checking whether it implements
+// NotFound() bool, so they do not depend on the data layer.
+func (err NotFoundError) NotFound() bool {
+	return true
+}
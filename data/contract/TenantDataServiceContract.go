@@ -1,7 +1,10 @@
 // Package contract defines the tenant data service contract.
 package contract
 
-import "github.com/micro-business/Micro-Business-Core/system"
+import (
+	"github.com/micro-business/Micro-Business-Core/system"
+	"golang.org/x/net/context"
+)
 
 // Tenant defines how a tenant should look like
 type Tenant struct {
@@ -31,6 +34,16 @@ type TenantDataService interface {
 	// Returns either the tenant information or error if something goes wrong.
 	ReadTenant(tenantID system.UUID) (Tenant, error)
 
+	// ReadAllTenants retrieves the list of created tenants.
+	// Returns either the list of created tenants, keyed by tenant unique identifier, or error if something goes wrong.
+	ReadAllTenants() (map[system.UUID]Tenant, error)
+
+	// ReadTenants retrieves the provided tenants at once.
+	// tenantIDs: Mandatory: The unique identifiers of the tenants to retrieve.
+	// Returns either the tenants found, keyed by tenant unique identifier, or error if something goes wrong. The tenants
+	// that do not exist are left out.
+	ReadTenants(tenantIDs []system.UUID) (map[system.UUID]Tenant, error)
+
 	// DeleteTenant deletes an existing tenant information.
 	// tenantID: Mandatory: The unique identifier of the existing tenant to remove.
 	// Returns error if something goes wrong.
@@ -60,9 +73,50 @@ type TenantDataService interface {
 	// Returns either the list of created applications for the provided tenant or error if something goes wrong.
 	ReadAllApplications(tenantID system.UUID) (map[system.UUID]Application, error)
 
+	// ReadApplicationsOfTenants retrieves the list of created applications of the provided tenants at once.
+	// tenantIDs: Mandatory: The unique identifiers of the tenants to retrieve the applications of.
+	// Returns either the list of created applications of every tenant found, keyed by tenant unique identifier, or error if
+	// something goes wrong. The tenants that do not exist are left out.
+	ReadApplicationsOfTenants(tenantIDs []system.UUID) (map[system.UUID]map[system.UUID]Application, error)
+
 	// DeleteApplication deletes an existing tenant application information.
 	// tenantID: Mandatory: The unique identifier of the existing tenant to remove.
 	// applicationID: Mandatory: The unique identifier of the existing application.
 	// Returns error if something goes wrong.
 	DeleteApplication(tenantID system.UUID, applicationID system.UUID) error
+
+	// CreateApplications creates new applications for the provided tenant in a single batch, either all the applications are created or none.
+	// tenantID: Mandatory. The unique identifier of the tenant to create the applications for.
+	// applications: Mandatory. The new applications to create for the provided tenant.
+	// Returns either the unique identifiers of the new applications, in the order of the provided applications, or error if something goes wrong.
+	CreateApplications(tenantID system.UUID, applications []Application) ([]system.UUID, error)
+
+	// UpdateApplications updates existing tenant applications in a single batch.
+	// tenantID: Mandatory: The unique identifier of the existing tenant.
+	// applications: Mandatory. The updated applications information, keyed by application unique identifier.
+	// allOrNothing: Mandatory. If true, no application is updated when any of the applications cannot be updated.
+	// Returns either the errors of the applications that could not be updated, keyed by application unique identifier, or error if something goes wrong.
+	UpdateApplications(tenantID system.UUID, applications map[system.UUID]Application, allOrNothing bool) (map[system.UUID]error, error)
+
+	// DeleteApplications deletes existing tenant applications in a single batch.
+	// tenantID: Mandatory: The unique identifier of the existing tenant.
+	// applicationIDs: Mandatory: The unique identifiers of the existing applications to remove.
+	// allOrNothing: Mandatory. If true, no application is deleted when any of the applications cannot be deleted.
+	// Returns either the errors of the applications that could not be deleted, keyed by application unique identifier, or error if something goes wrong.
+	DeleteApplications(tenantID system.UUID, applicationIDs []system.UUID, allOrNothing bool) (map[system.UUID]error, error)
+
+	// CountTenantsAndApplications counts the tenants and the applications of all tenants.
+	// Returns either the number of tenants and the number of applications or error if something goes wrong.
+	CountTenantsAndApplications() (int, int, error)
+
+	// CheckHealth checks the Cassandra cluster can be reached and its keyspace holds the tables the service requires.
+	// Returns error if the data store cannot serve the requests.
+	CheckHealth() error
+
+	// WithContext returns a copy of the tenant data service bound to the provided request context: the copy writes its logs to the
+	// logger carried by the context, e.g. a logger carrying the correlation identifier of the request being served, and
+	// records its spans as children of the span carried by the context.
+	// ctx: Mandatory. The context of the request being served.
+	// Returns the tenant data service bound to the provided context.
+	WithContext(ctx context.Context) TenantDataService
 }
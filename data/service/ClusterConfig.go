@@ -0,0 +1,149 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+const (
+	// NoRetryPolicy never retries the failed statements.
+	NoRetryPolicy = "none"
+
+	// SimpleRetryPolicy retries the failed statements right away.
+	SimpleRetryPolicy = "simple"
+
+	// ExponentialRetryPolicy retries the failed statements waiting longer and longer between the attempts.
+	ExponentialRetryPolicy = "exponential"
+)
+
+// ClusterOptions defines how the service connects to the Cassandra cluster
+type ClusterOptions struct {
+	// Hosts are the addresses of the nodes the service first connects to, the other nodes are discovered.
+	Hosts []string
+
+	// Keyspace is the keyspace the data is stored under.
+	Keyspace string
+
+	// ProtocolVersion is the version of the Cassandra native protocol.
+	ProtocolVersion int
+
+	// Username is optional. When provided, the service authenticates with the username and the password.
+	Username string
+
+	// Password is the password the service authenticates with.
+	Password string
+
+	// TLSEnabled encrypts the connections to the nodes using TLS.
+	TLSEnabled bool
+
+	// TLSCAFilePath is optional. When provided, the node certificates are verified with the CA bundle instead of the
+	// system CA bundle.
+	TLSCAFilePath string
+
+	// TLSCertificateFilePath is optional. When provided, the client certificate is presented to the nodes.
+	TLSCertificateFilePath string
+
+	// TLSKeyFilePath is the path to the PEM encoded private key of the client certificate.
+	TLSKeyFilePath string
+
+	// TLSHostVerificationEnabled verifies the host name of the nodes against their certificate.
+	TLSHostVerificationEnabled bool
+
+	// ConnectTimeout is how long a connection to a node can take to be established.
+	ConnectTimeout time.Duration
+
+	// QueryTimeout is how long a node can take to answer a statement.
+	QueryTimeout time.Duration
+
+	// RetryPolicy is how the failed statements are retried, either none, simple or exponential.
+	RetryPolicy string
+
+	// RetryAttempts is the number of times a failed statement is retried when a retry policy is used.
+	RetryAttempts int
+
+	// TokenAwareRouting sends the statements to the nodes owning the data they target.
+	TokenAwareRouting bool
+
+	// LocalDatacenter is optional. When provided, the nodes of the datacenter are preferred over the nodes of the other
+	// datacenters.
+	LocalDatacenter string
+
+	// ConnectionsPerHost is the number of connections opened to every node.
+	ConnectionsPerHost int
+}
+
+// NewClusterConfig creates the configuration of the connections to the Cassandra cluster. The host selection policy is
+// not part of the configuration as it cannot be shared between the sessions, see NewHostSelectionPolicy.
+// options: Mandatory. How the service connects to the cluster.
+// Returns either the cluster configuration or error if the retry policy is not supported.
+func NewClusterConfig(options ClusterOptions) (*gocql.ClusterConfig, error) {
+	clusterConfig := gocql.NewCluster(options.Hosts...)
+	clusterConfig.Keyspace = options.Keyspace
+	clusterConfig.ProtoVersion = options.ProtocolVersion
+	clusterConfig.ConnectTimeout = options.ConnectTimeout
+	clusterConfig.Timeout = options.QueryTimeout
+	clusterConfig.NumConns = options.ConnectionsPerHost
+
+	if len(options.Username) != 0 {
+		clusterConfig.Authenticator = gocql.PasswordAuthenticator{Username: options.Username, Password: options.Password}
+	}
+
+	if options.TLSEnabled {
+		clusterConfig.SslOpts = &gocql.SslOptions{
+			CaPath:                 options.TLSCAFilePath,
+			CertPath:               options.TLSCertificateFilePath,
+			KeyPath:                options.TLSKeyFilePath,
+			EnableHostVerification: options.TLSHostVerificationEnabled,
+		}
+	}
+
+	switch options.RetryPolicy {
+	case NoRetryPolicy:
+
+	case SimpleRetryPolicy:
+		clusterConfig.RetryPolicy = &gocql.SimpleRetryPolicy{NumRetries: options.RetryAttempts}
+
+	case ExponentialRetryPolicy:
+		clusterConfig.RetryPolicy = &gocql.ExponentialBackoffRetryPolicy{NumRetries: options.RetryAttempts}
+
+	default:
+		return nil, fmt.Errorf("Retry policy must be either %s, %s or %s. Value: %s", NoRetryPolicy, SimpleRetryPolicy, ExponentialRetryPolicy, options.RetryPolicy)
+	}
+
+	return clusterConfig, nil
+}
+
+// NewHostSelectionPolicy creates the policy choosing the nodes the statements are sent to. A policy holds the nodes of
+// the session it is created for, gocql does not support sharing a token aware policy between sessions, so every session
+// must be created with a new policy, see TenantDataService.HostSelectionPolicy.
+// options: Mandatory. How the service connects to the cluster.
+// Returns the new host selection policy.
+func NewHostSelectionPolicy(options ClusterOptions) gocql.HostSelectionPolicy {
+	hostSelectionPolicy := gocql.RoundRobinHostPolicy()
+
+	if len(options.LocalDatacenter) != 0 {
+		hostSelectionPolicy = gocql.DCAwareRoundRobinPolicy(options.LocalDatacenter)
+	}
+
+	if options.TokenAwareRouting {
+		hostSelectionPolicy = gocql.TokenAwareHostPolicy(hostSelectionPolicy)
+	}
+
+	return hostSelectionPolicy
+}
+
+// ParseConsistency parses the name of a consistency level, e.g. local_quorum.
+// name: Mandatory. The name of the consistency level, in lower or upper case.
+// Returns either the consistency level or error if the name is not a consistency level.
+func ParseConsistency(name string) (gocql.Consistency, error) {
+	consistency, err := gocql.ParseConsistencyWrapper(strings.ToUpper(name))
+
+	if err != nil {
+		return 0, fmt.Errorf("Consistency level is not supported. Value: %s", name)
+	}
+
+	return consistency, nil
+}
@@ -0,0 +1,120 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/micro-business/TenantService/data/service"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewClusterConfig method behaviour", func() {
+	var options service.ClusterOptions
+
+	BeforeEach(func() {
+		options = service.ClusterOptions{
+			Hosts:              []string{"host1", "host2"},
+			Keyspace:           "tenant",
+			ProtocolVersion:    4,
+			ConnectTimeout:     2 * time.Second,
+			QueryTimeout:       3 * time.Second,
+			RetryPolicy:        service.NoRetryPolicy,
+			RetryAttempts:      3,
+			ConnectionsPerHost: 4,
+		}
+	})
+
+	It("should apply the hosts, keyspace, protocol version, timeouts and connections per host", func() {
+		clusterConfig, err := service.NewClusterConfig(options)
+
+		Expect(err).To(BeNil())
+		Expect(clusterConfig.Hosts).To(Equal([]string{"host1", "host2"}))
+		Expect(clusterConfig.Keyspace).To(Equal("tenant"))
+		Expect(clusterConfig.ProtoVersion).To(Equal(4))
+		Expect(clusterConfig.ConnectTimeout).To(Equal(2 * time.Second))
+		Expect(clusterConfig.Timeout).To(Equal(3 * time.Second))
+		Expect(clusterConfig.NumConns).To(Equal(4))
+		Expect(clusterConfig.Authenticator).To(BeNil())
+		Expect(clusterConfig.SslOpts).To(BeNil())
+		Expect(clusterConfig.RetryPolicy).To(BeNil())
+	})
+
+	It("should authenticate with the username and the password if provided", func() {
+		options.Username = "username"
+		options.Password = "password"
+
+		clusterConfig, _ := service.NewClusterConfig(options)
+
+		Expect(clusterConfig.Authenticator).To(Equal(gocql.PasswordAuthenticator{Username: "username", Password: "password"}))
+	})
+
+	It("should encrypt the connections if TLS is enabled", func() {
+		options.TLSEnabled = true
+		options.TLSCAFilePath = "ca.pem"
+		options.TLSHostVerificationEnabled = true
+
+		clusterConfig, _ := service.NewClusterConfig(options)
+
+		Expect(clusterConfig.SslOpts).NotTo(BeNil())
+		Expect(clusterConfig.SslOpts.CaPath).To(Equal("ca.pem"))
+		Expect(clusterConfig.SslOpts.EnableHostVerification).To(BeTrue())
+	})
+
+	It("should retry the failed statements using the provided retry policy", func() {
+		options.RetryPolicy = service.ExponentialRetryPolicy
+
+		clusterConfig, _ := service.NewClusterConfig(options)
+
+		Expect(clusterConfig.RetryPolicy).To(Equal(&gocql.ExponentialBackoffRetryPolicy{NumRetries: 3}))
+	})
+
+	It("should return error if the retry policy is not supported", func() {
+		options.RetryPolicy = "forever"
+
+		_, err := service.NewClusterConfig(options)
+
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should not set a host selection policy that would be shared by the sessions", func() {
+		options.TokenAwareRouting = true
+
+		clusterConfig, _ := service.NewClusterConfig(options)
+
+		Expect(clusterConfig.PoolConfig.HostSelectionPolicy).To(BeNil())
+	})
+
+	It("should let two sessions be created from the same configuration with a new token aware policy each", func() {
+		options.Hosts = []string{"127.0.0.1"}
+		options.ConnectTimeout = 100 * time.Millisecond
+		options.TokenAwareRouting = true
+		options.LocalDatacenter = "datacenter1"
+
+		clusterConfig, _ := service.NewClusterConfig(options)
+		clusterConfig.Port = 1
+
+		for i := 0; i < 2; i++ {
+			sessionConfig := *clusterConfig
+			sessionConfig.PoolConfig.HostSelectionPolicy = service.NewHostSelectionPolicy(options)
+
+			Expect(func() { sessionConfig.CreateSession() }).NotTo(Panic())
+		}
+	})
+
+	It("should parse the consistency levels in lower case", func() {
+		consistency, err := service.ParseConsistency("local_quorum")
+
+		Expect(err).To(BeNil())
+		Expect(consistency).To(Equal(gocql.LocalQuorum))
+
+		_, err = service.ParseConsistency("most")
+		Expect(err).NotTo(BeNil())
+	})
+})
+
+func TestNewClusterConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "NewClusterConfig method behaviour")
+}
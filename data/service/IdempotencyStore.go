@@ -0,0 +1,161 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+	"github.com/micro-business/Micro-Business-Core/common/diagnostics"
+	"github.com/micro-business/TenantService/idempotency"
+)
+
+// maxReservationTTL is how long a request can be reserved at most before it is completed or released. A reservation left
+// by a service instance stopped during the request expires once this elapses, so the client can retry the request.
+const maxReservationTTL = 5 * time.Minute
+
+// IdempotencyStore implements idempotency.Store keeping the requests in Cassandra, so a retry is recognised whichever
+// service instance it is sent to. The requests are written using lightweight transactions, so two instances cannot
+// reserve the same key, and expire using the Cassandra TTL.
+type IdempotencyStore struct {
+	tenantDataService TenantDataService
+
+	mutex sync.Mutex
+	ttl   time.Duration
+}
+
+// NewIdempotencyStore creates a new idempotency store.
+// tenantDataService: Mandatory. The tenant data service the sessions to the Cassandra cluster are created like, its
+// session is shared if it is open, see TenantDataService.Open.
+// ttl: Mandatory. How long a request is kept once it is completed.
+// Returns the new idempotency store.
+func NewIdempotencyStore(tenantDataService TenantDataService, ttl time.Duration) *IdempotencyStore {
+	diagnostics.IsNotNil(tenantDataService.ClusterConfig, "tenantDataService.ClusterConfig", "ClusterConfig must be provided.")
+
+	return &IdempotencyStore{tenantDataService: tenantDataService, ttl: ttl}
+}
+
+// Reserve records the request with the provided fingerprint for the provided key, unless a request is already recorded.
+// key: Mandatory. The idempotency key sent by the client.
+// fingerprint: Mandatory. The fingerprint of the request.
+// Returns either the request already completed with the key, a record that is not completed if the key is reserved
+// for the new request, or ConflictError if the key is used by a different request or by a request still in progress.
+func (store *IdempotencyStore) Reserve(key string, fingerprint string) (idempotency.Record, error) {
+	session, err := store.tenantDataService.createSession(store.tenantDataService.WriteConsistency)
+
+	if err != nil {
+		return idempotency.Record{}, err
+	}
+
+	defer session.Close()
+
+	reservationTTL := store.getTTL()
+
+	if reservationTTL > maxReservationTTL {
+		reservationTTL = maxReservationTTL
+	}
+
+	// A request already recorded is returned in the column order of the table: the key followed by the other columns in
+	// alphabetical order.
+	var existingKey, existingFingerprint, existingResult string
+	var existingCompleted bool
+
+	applied, err := session.Query(
+		"INSERT INTO idempotency"+
+			" (idempotency_key, fingerprint, result, completed)"+
+			" VALUES(?, ?, '', false)"+
+			" IF NOT EXISTS"+
+			" USING TTL ?",
+		key,
+		fingerprint,
+		int(reservationTTL.Seconds())).ScanCAS(&existingKey, &existingCompleted, &existingFingerprint, &existingResult)
+
+	if err != nil {
+		level.Error(store.tenantDataService.logger()).Log("msg", "Failed to reserve the idempotency key.", "err", err)
+
+		return idempotency.Record{}, err
+	}
+
+	if applied {
+		return idempotency.Record{Fingerprint: fingerprint}, nil
+	}
+
+	if existingFingerprint != fingerprint {
+		return idempotency.Record{}, idempotency.NewConflictError("Idempotency key was already used with a different request.")
+	}
+
+	if !existingCompleted {
+		return idempotency.Record{}, idempotency.NewConflictError("A request with the same idempotency key is in progress.")
+	}
+
+	return idempotency.Record{Fingerprint: existingFingerprint, Result: existingResult, Completed: true}, nil
+}
+
+// Complete records the result of the request the provided key is reserved for.
+// key: Mandatory. The idempotency key sent by the client.
+// fingerprint: Mandatory. The fingerprint of the request the key is reserved for.
+// result: Mandatory. The result of the request.
+// Returns error if something goes wrong.
+func (store *IdempotencyStore) Complete(key string, fingerprint string, result string) error {
+	session, err := store.tenantDataService.createSession(store.tenantDataService.WriteConsistency)
+
+	if err != nil {
+		return err
+	}
+
+	defer session.Close()
+
+	// Every column is written again, so they all expire together once the TTL of the completed request elapses.
+	var existingCompleted bool
+
+	_, err = session.Query(
+		"UPDATE idempotency"+
+			" USING TTL ?"+
+			" SET fingerprint = ?, result = ?, completed = true"+
+			" WHERE idempotency_key = ?"+
+			" IF completed = false",
+		int(store.getTTL().Seconds()),
+		fingerprint,
+		result,
+		key).ScanCAS(&existingCompleted)
+
+	return err
+}
+
+// Release removes the reservation of the provided key, so the request can be retried after it failed.
+// key: Mandatory. The idempotency key sent by the client.
+// Returns error if something goes wrong.
+func (store *IdempotencyStore) Release(key string) error {
+	session, err := store.tenantDataService.createSession(store.tenantDataService.WriteConsistency)
+
+	if err != nil {
+		return err
+	}
+
+	defer session.Close()
+
+	var existingCompleted bool
+
+	_, err = session.Query(
+		"DELETE FROM idempotency"+
+			" WHERE idempotency_key = ?"+
+			" IF completed = false",
+		key).ScanCAS(&existingCompleted)
+
+	return err
+}
+
+// SetTTL changes how long the requests completed from then on are kept. The requests already completed keep their expiry.
+// ttl: Mandatory. How long a request is kept once it is completed.
+func (store *IdempotencyStore) SetTTL(ttl time.Duration) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.ttl = ttl
+}
+
+func (store *IdempotencyStore) getTTL() time.Duration {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	return store.ttl
+}
@@ -0,0 +1,107 @@
+// +build integration
+
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/data/service"
+	"github.com/micro-business/TenantService/idempotency"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("IdempotencyStore behaviour", func() {
+	var (
+		store *service.IdempotencyStore
+		other *service.IdempotencyStore
+		key   string
+	)
+
+	BeforeEach(func() {
+		clusterConfig := getClusterConfig()
+		clusterConfig.Keyspace = keyspace
+
+		// Two stores stand for two service instances sharing the cluster.
+		store = service.NewIdempotencyStore(service.TenantDataService{ClusterConfig: clusterConfig}, time.Minute)
+		other = service.NewIdempotencyStore(service.TenantDataService{ClusterConfig: clusterConfig}, time.Minute)
+
+		randomKey, _ := system.RandomUUID()
+		key = randomKey.String()
+	})
+
+	It("should reserve the key for the first request", func() {
+		record, err := store.Reserve(key, "fingerprint")
+
+		Expect(err).To(BeNil())
+		Expect(record.Completed).To(BeFalse())
+	})
+
+	It("should return the result completed by another instance if the same request is sent again", func() {
+		store.Reserve(key, "fingerprint")
+		Expect(store.Complete(key, "fingerprint", "result")).To(BeNil())
+
+		record, err := other.Reserve(key, "fingerprint")
+
+		Expect(err).To(BeNil())
+		Expect(record.Completed).To(BeTrue())
+		Expect(record.Result).To(Equal("result"))
+	})
+
+	It("should return conflict error if another instance reserved the key", func() {
+		store.Reserve(key, "fingerprint")
+
+		_, err := other.Reserve(key, "fingerprint")
+
+		Expect(err).To(BeAssignableToTypeOf(idempotency.ConflictError{}))
+	})
+
+	It("should return conflict error if the key is reused with a different request", func() {
+		store.Reserve(key, "fingerprint")
+		store.Complete(key, "fingerprint", "result")
+
+		_, err := other.Reserve(key, "other fingerprint")
+
+		Expect(err).To(BeAssignableToTypeOf(idempotency.ConflictError{}))
+	})
+
+	It("should let the request be retried once the reservation is released", func() {
+		store.Reserve(key, "fingerprint")
+		Expect(store.Release(key)).To(BeNil())
+
+		record, err := other.Reserve(key, "other fingerprint")
+
+		Expect(err).To(BeNil())
+		Expect(record.Completed).To(BeFalse())
+	})
+
+	It("should not release a completed request", func() {
+		store.Reserve(key, "fingerprint")
+		store.Complete(key, "fingerprint", "result")
+		Expect(store.Release(key)).To(BeNil())
+
+		record, err := other.Reserve(key, "fingerprint")
+
+		Expect(err).To(BeNil())
+		Expect(record.Completed).To(BeTrue())
+	})
+
+	It("should forget the completed request once the TTL elapses", func() {
+		store.SetTTL(time.Second)
+		store.Reserve(key, "fingerprint")
+		store.Complete(key, "fingerprint", "result")
+
+		Eventually(func() bool {
+			record, err := other.Reserve(key, "other fingerprint")
+
+			return err == nil && !record.Completed
+		}, 5*time.Second, 500*time.Millisecond).Should(BeTrue())
+	})
+})
+
+func TestIdempotencyStoreBehaviour(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "IdempotencyStore behaviour")
+}
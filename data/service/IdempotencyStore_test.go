@@ -0,0 +1,38 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/micro-business/TenantService/data/service"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("IdempotencyStore input parameters and dependency test", func() {
+	Context("when cluster configuration not provided", func() {
+		It("should panic", func() {
+			Ω(func() { service.NewIdempotencyStore(service.TenantDataService{}, time.Minute) }).Should(Panic())
+		})
+	})
+
+	Context("when Cassandra cannot be reached", func() {
+		It("should return error", func() {
+			clusterConfig := gocql.NewCluster("127.0.0.1")
+			clusterConfig.Port = 1
+			clusterConfig.ConnectTimeout = 100 * time.Millisecond
+			store := service.NewIdempotencyStore(service.TenantDataService{ClusterConfig: clusterConfig}, time.Minute)
+
+			_, err := store.Reserve("key", "fingerprint")
+			Expect(err).NotTo(BeNil())
+			Expect(store.Complete("key", "fingerprint", "result")).NotTo(BeNil())
+			Expect(store.Release("key")).NotTo(BeNil())
+		})
+	})
+})
+
+func TestIdempotencyStore(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "IdempotencyStore input parameters and dependency test")
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/gocql/gocql"
+)
+
+// sharedSession holds the session to the Cassandra cluster shared by the operations of an open tenant data service and of
+// its copies, see TenantDataService.Open.
+type sharedSession struct {
+	mutex   sync.Mutex
+	session *gocql.Session
+	closed  bool
+}
+
+// get returns the shared session, creating it using the provided function if it is not created yet or was closed.
+// Returns either the shared session or error if the session cannot be created or the tenant data service is closed.
+func (shared *sharedSession) get(create func() (*gocql.Session, error)) (*gocql.Session, error) {
+	shared.mutex.Lock()
+	defer shared.mutex.Unlock()
+
+	if shared.closed {
+		return nil, errors.New("Tenant data service is closed.")
+	}
+
+	if shared.session == nil || shared.session.Closed() {
+		session, err := create()
+
+		if err != nil {
+			return nil, err
+		}
+
+		shared.session = session
+	}
+
+	return shared.session, nil
+}
+
+// close closes the shared session, the following operations fail instead of creating a new one.
+func (shared *sharedSession) close() {
+	shared.mutex.Lock()
+	defer shared.mutex.Unlock()
+
+	shared.closed = true
+
+	if shared.session != nil {
+		shared.session.Close()
+		shared.session = nil
+	}
+}
+
+// cassandraSession executes the statements of a single operation at the consistency level of the operation, recording them
+// as children of the span carried by the context the tenant data service is bound to. The statements are executed using
+// either the shared session of an open tenant data service or a session created for the operation.
+type cassandraSession struct {
+	session       *gocql.Session
+	owned         bool
+	consistency   *gocql.Consistency
+	queryObserver gocql.QueryObserver
+	batchObserver gocql.BatchObserver
+}
+
+// Query creates a query executing the provided statement with the provided values.
+func (session cassandraSession) Query(statement string, values ...interface{}) *gocql.Query {
+	query := session.session.Query(statement, values...).Observer(session.queryObserver)
+
+	if session.consistency != nil {
+		query = query.Consistency(*session.consistency)
+	}
+
+	return query
+}
+
+// NewBatch creates a batch of the provided type.
+func (session cassandraSession) NewBatch(batchType gocql.BatchType) *gocql.Batch {
+	batch := session.session.NewBatch(batchType).Observer(session.batchObserver)
+
+	if session.consistency != nil {
+		batch.SetConsistency(*session.consistency)
+	}
+
+	return batch
+}
+
+// ExecuteBatch executes the provided batch.
+func (session cassandraSession) ExecuteBatch(batch *gocql.Batch) error {
+	return session.session.ExecuteBatch(batch)
+}
+
+// Close closes the session if it was created for the operation, the shared session is left open.
+func (session cassandraSession) Close() {
+	if session.owned {
+		session.session.Close()
+	}
+}
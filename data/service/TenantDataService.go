@@ -2,17 +2,45 @@ package service
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
 	"github.com/gocql/gocql"
 	"github.com/micro-business/Micro-Business-Core/common/diagnostics"
 	"github.com/micro-business/Micro-Business-Core/system"
 	"github.com/micro-business/TenantService/data/contract"
+	"github.com/micro-business/TenantService/logging"
+	"github.com/micro-business/TenantService/tracing"
+	"golang.org/x/net/context"
 )
 
+// requiredTables are the tables of the keyspace the service reads and writes.
+var requiredTables = []string{"tenant", "application", "idempotency"}
+
 // TenantDataService provides access to add new tenant and update/retrieve/remove an existing tenant.
 type TenantDataService struct {
 	UUIDGeneratorService system.UUIDGeneratorService
 	ClusterConfig        *gocql.ClusterConfig
+
+	// HostSelectionPolicy is optional. When provided, every session is created with a new policy returned by the function
+	// instead of the policy of ClusterConfig, see NewHostSelectionPolicy.
+	HostSelectionPolicy func() gocql.HostSelectionPolicy
+
+	// Logger is optional. When provided, the failures to reach Cassandra are logged. The logger carried by the context
+	// the tenant data service is bound to, see WithContext, takes precedence.
+	Logger log.Logger
+
+	// ReadConsistency is optional. When provided, the operations only reading data execute their statements at this
+	// consistency level instead of the consistency level of ClusterConfig.
+	ReadConsistency *gocql.Consistency
+
+	// WriteConsistency is optional. When provided, the operations writing data execute their statements, including the
+	// reads checking the tenant or application exists, at this consistency level instead of the consistency level of ClusterConfig.
+	WriteConsistency *gocql.Consistency
+
+	ctx      context.Context
+	sessions *sharedSession
 }
 
 // CreateTenant  creates a new tenant.
@@ -28,7 +56,7 @@ func (tenantDataService TenantDataService) CreateTenant(tenant contract.Tenant)
 		return system.EmptyUUID, err
 	}
 
-	session, err := tenantDataService.ClusterConfig.CreateSession()
+	session, err := tenantDataService.createSession(tenantDataService.WriteConsistency)
 
 	if err != nil {
 		return system.EmptyUUID, err
@@ -48,7 +76,7 @@ func (tenantDataService TenantDataService) CreateTenant(tenant contract.Tenant)
 func (tenantDataService TenantDataService) UpdateTenant(tenantID system.UUID, tenant contract.Tenant) error {
 	diagnostics.IsNotNil(tenantDataService.ClusterConfig, "tenantDataServic.ClusterConfig", "ClusterConfig must be provided.")
 
-	session, err := tenantDataService.ClusterConfig.CreateSession()
+	session, err := tenantDataService.createSession(tenantDataService.WriteConsistency)
 
 	if err != nil {
 		return err
@@ -57,7 +85,7 @@ func (tenantDataService TenantDataService) UpdateTenant(tenantID system.UUID, te
 	defer session.Close()
 
 	if !doesTenantExist(tenantID, session) {
-		return fmt.Errorf("Tenant not found. Tenant ID: %s", tenantID.String())
+		return contract.NewTenantNotFoundError(tenantID)
 	}
 
 	return addOrUpdateTenant(tenantID, tenant, session)
@@ -69,7 +97,7 @@ func (tenantDataService TenantDataService) UpdateTenant(tenantID system.UUID, te
 func (tenantDataService TenantDataService) ReadTenant(tenantID system.UUID) (contract.Tenant, error) {
 	diagnostics.IsNotNil(tenantDataService.ClusterConfig, "tenantDataServic.ClusterConfig", "ClusterConfig must be provided.")
 
-	session, err := tenantDataService.ClusterConfig.CreateSession()
+	session, err := tenantDataService.createSession(tenantDataService.ReadConsistency)
 
 	if err != nil {
 		return contract.Tenant{}, err
@@ -81,13 +109,51 @@ func (tenantDataService TenantDataService) ReadTenant(tenantID system.UUID) (con
 
 }
 
+// ReadAllTenants retrieves the list of created tenants.
+// Returns either the list of created tenants, keyed by tenant unique identifier, or error if something goes wrong.
+func (tenantDataService TenantDataService) ReadAllTenants() (map[system.UUID]contract.Tenant, error) {
+	diagnostics.IsNotNil(tenantDataService.ClusterConfig, "tenantDataServic.ClusterConfig", "ClusterConfig must be provided.")
+
+	session, err := tenantDataService.createSession(tenantDataService.ReadConsistency)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer session.Close()
+
+	return readAllTenants(session)
+}
+
+// ReadTenants retrieves the provided tenants at once.
+// tenantIDs: Mandatory: The unique identifiers of the tenants to retrieve.
+// Returns either the tenants found, keyed by tenant unique identifier, or error if something goes wrong. The tenants
+// that do not exist are left out.
+func (tenantDataService TenantDataService) ReadTenants(tenantIDs []system.UUID) (map[system.UUID]contract.Tenant, error) {
+	diagnostics.IsNotNil(tenantDataService.ClusterConfig, "tenantDataServic.ClusterConfig", "ClusterConfig must be provided.")
+
+	if len(tenantIDs) == 0 {
+		return make(map[system.UUID]contract.Tenant), nil
+	}
+
+	session, err := tenantDataService.createSession(tenantDataService.ReadConsistency)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer session.Close()
+
+	return readTenants(tenantIDs, session)
+}
+
 // DeleteTenant deletes an existing tenant information.
 // tenantID: Mandatory: The unique identifier of the existing tenant to remove.
 // Returns error if something goes wrong.
 func (tenantDataService TenantDataService) DeleteTenant(tenantID system.UUID) error {
 	diagnostics.IsNotNil(tenantDataService.ClusterConfig, "tenantDataServic.ClusterConfig", "ClusterConfig must be provided.")
 
-	session, err := tenantDataService.ClusterConfig.CreateSession()
+	session, err := tenantDataService.createSession(tenantDataService.WriteConsistency)
 
 	if err != nil {
 		return err
@@ -96,7 +162,7 @@ func (tenantDataService TenantDataService) DeleteTenant(tenantID system.UUID) er
 	defer session.Close()
 
 	if !doesTenantExist(tenantID, session) {
-		return fmt.Errorf("Tenant not found. Tenant ID: %s", tenantID.String())
+		return contract.NewTenantNotFoundError(tenantID)
 	}
 
 	mappedTenantID := mapSystemUUIDToGocqlUUID(tenantID)
@@ -117,7 +183,7 @@ func (tenantDataService TenantDataService) CreateApplication(tenantID system.UUI
 	diagnostics.IsNotNil(tenantDataService.UUIDGeneratorService, "tenantDataService.UUIDGeneratorService", "UUIDGeneratorService must be provided.")
 	diagnostics.IsNotNil(tenantDataService.ClusterConfig, "tenantDataServic.ClusterConfig", "ClusterConfig must be provided.")
 
-	session, err := tenantDataService.ClusterConfig.CreateSession()
+	session, err := tenantDataService.createSession(tenantDataService.WriteConsistency)
 
 	if err != nil {
 		return system.EmptyUUID, err
@@ -126,7 +192,7 @@ func (tenantDataService TenantDataService) CreateApplication(tenantID system.UUI
 	defer session.Close()
 
 	if !doesTenantExist(tenantID, session) {
-		return system.EmptyUUID, fmt.Errorf("Tenant not found. Tenant ID: %s", tenantID.String())
+		return system.EmptyUUID, contract.NewTenantNotFoundError(tenantID)
 	}
 
 	applicationID, err := tenantDataService.UUIDGeneratorService.GenerateRandomUUID()
@@ -146,7 +212,7 @@ func (tenantDataService TenantDataService) CreateApplication(tenantID system.UUI
 // application: Mandatory. The reference to the updated application information.
 // Returns error if something goes wrong.
 func (tenantDataService TenantDataService) UpdateApplication(tenantID system.UUID, applicationID system.UUID, application contract.Application) error {
-	session, err := tenantDataService.ClusterConfig.CreateSession()
+	session, err := tenantDataService.createSession(tenantDataService.WriteConsistency)
 
 	if err != nil {
 		return err
@@ -155,11 +221,11 @@ func (tenantDataService TenantDataService) UpdateApplication(tenantID system.UUI
 	defer session.Close()
 
 	if !doesTenantExist(tenantID, session) {
-		return fmt.Errorf("Tenant not found. Tenant ID: %s", tenantID.String())
+		return contract.NewTenantNotFoundError(tenantID)
 	}
 
 	if !doesApplicationExist(tenantID, applicationID, session) {
-		return fmt.Errorf("Tenant Application not found. Tenant ID: %s, Application ID: %s", tenantID.String(), applicationID.String())
+		return contract.NewApplicationNotFoundError(tenantID, applicationID)
 	}
 
 	return addOrUpdateApplication(tenantID, applicationID, application, session)
@@ -170,7 +236,7 @@ func (tenantDataService TenantDataService) UpdateApplication(tenantID system.UUI
 // applicationID: Mandatory: The unique identifier of the existing application.
 // Returns either the tenant application information or error if something goes wrong.
 func (tenantDataService TenantDataService) ReadApplication(tenantID system.UUID, applicationID system.UUID) (contract.Application, error) {
-	session, err := tenantDataService.ClusterConfig.CreateSession()
+	session, err := tenantDataService.createSession(tenantDataService.ReadConsistency)
 
 	if err != nil {
 		return contract.Application{}, err
@@ -179,7 +245,7 @@ func (tenantDataService TenantDataService) ReadApplication(tenantID system.UUID,
 	defer session.Close()
 
 	if !doesTenantExist(tenantID, session) {
-		return contract.Application{}, fmt.Errorf("Tenant not found. Tenant ID: %s", tenantID.String())
+		return contract.Application{}, contract.NewTenantNotFoundError(tenantID)
 	}
 
 	return readApplication(tenantID, applicationID, session)
@@ -189,7 +255,7 @@ func (tenantDataService TenantDataService) ReadApplication(tenantID system.UUID,
 // tenantID: Mandatory: The unique identifier of the existing tenant.
 // Returns either the list of created applications for the provided tenant or error if something goes wrong.
 func (tenantDataService TenantDataService) ReadAllApplications(tenantID system.UUID) (map[system.UUID]contract.Application, error) {
-	session, err := tenantDataService.ClusterConfig.CreateSession()
+	session, err := tenantDataService.createSession(tenantDataService.ReadConsistency)
 
 	if err != nil {
 		return nil, err
@@ -198,19 +264,78 @@ func (tenantDataService TenantDataService) ReadAllApplications(tenantID system.U
 	defer session.Close()
 
 	if !doesTenantExist(tenantID, session) {
-		return nil, fmt.Errorf("Tenant not found. Tenant ID: %s", tenantID.String())
+		return nil, contract.NewTenantNotFoundError(tenantID)
 	}
 
 	return readAllApplications(tenantID, session), nil
 
 }
 
+// ReadApplicationsOfTenants retrieves the list of created applications of the provided tenants at once.
+// tenantIDs: Mandatory: The unique identifiers of the tenants to retrieve the applications of.
+// Returns either the list of created applications of every tenant found, keyed by tenant unique identifier, or error if
+// something goes wrong. The tenants that do not exist are left out.
+func (tenantDataService TenantDataService) ReadApplicationsOfTenants(tenantIDs []system.UUID) (map[system.UUID]map[system.UUID]contract.Application, error) {
+	diagnostics.IsNotNil(tenantDataService.ClusterConfig, "tenantDataServic.ClusterConfig", "ClusterConfig must be provided.")
+
+	applicationsOfTenants := make(map[system.UUID]map[system.UUID]contract.Application)
+
+	if len(tenantIDs) == 0 {
+		return applicationsOfTenants, nil
+	}
+
+	session, err := tenantDataService.createSession(tenantDataService.ReadConsistency)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer session.Close()
+
+	tenants, err := readTenants(tenantIDs, session)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tenants) == 0 {
+		return applicationsOfTenants, nil
+	}
+
+	existingTenantIDs := make([]system.UUID, 0, len(tenants))
+
+	for tenantID := range tenants {
+		existingTenantIDs = append(existingTenantIDs, tenantID)
+		applicationsOfTenants[tenantID] = make(map[system.UUID]contract.Application)
+	}
+
+	iter := session.Query(
+		"SELECT tenant_id, application_id, name"+
+			" FROM application"+
+			" WHERE"+
+			" tenant_id IN ?",
+		mapSystemUUIDsToGocqlUUIDs(existingTenantIDs)).Iter()
+
+	var tenantID, applicationID gocql.UUID
+	var name string
+
+	for iter.Scan(&tenantID, &applicationID, &name) {
+		applicationsOfTenants[mapGocqlUUIDToSystemUUID(tenantID)][mapGocqlUUIDToSystemUUID(applicationID)] = contract.Application{Name: name}
+	}
+
+	if err = iter.Close(); err != nil {
+		return nil, err
+	}
+
+	return applicationsOfTenants, nil
+}
+
 // DeleteApplication deletes an existing tenant application information.
 // tenantID: Mandatory: The unique identifier of the existing tenant to remove.
 // applicationID: Mandatory: The unique identifier of the existing application.
 // Returns error if something goes wrong.
 func (tenantDataService TenantDataService) DeleteApplication(tenantID system.UUID, applicationID system.UUID) error {
-	session, err := tenantDataService.ClusterConfig.CreateSession()
+	session, err := tenantDataService.createSession(tenantDataService.WriteConsistency)
 
 	if err != nil {
 		return err
@@ -219,11 +344,11 @@ func (tenantDataService TenantDataService) DeleteApplication(tenantID system.UUI
 	defer session.Close()
 
 	if !doesTenantExist(tenantID, session) {
-		return fmt.Errorf("Tenant not found. Tenant ID: %s", tenantID.String())
+		return contract.NewTenantNotFoundError(tenantID)
 	}
 
 	if !doesApplicationExist(tenantID, applicationID, session) {
-		return fmt.Errorf("Tenant Application not found. Tenant ID: %s, Application ID: %s", tenantID.String(), applicationID.String())
+		return contract.NewApplicationNotFoundError(tenantID, applicationID)
 	}
 
 	mappedTenantID := mapSystemUUIDToGocqlUUID(tenantID)
@@ -239,6 +364,337 @@ func (tenantDataService TenantDataService) DeleteApplication(tenantID system.UUI
 		Exec()
 }
 
+// CreateApplications creates new applications for the provided tenant in a single batch, either all the applications are created or none.
+// tenantID: Mandatory. The unique identifier of the tenant to create the applications for.
+// applications: Mandatory. The new applications to create for the provided tenant.
+// Returns either the unique identifiers of the new applications, in the order of the provided applications, or error if something goes wrong.
+func (tenantDataService TenantDataService) CreateApplications(tenantID system.UUID, applications []contract.Application) ([]system.UUID, error) {
+	diagnostics.IsNotNil(tenantDataService.UUIDGeneratorService, "tenantDataService.UUIDGeneratorService", "UUIDGeneratorService must be provided.")
+	diagnostics.IsNotNil(tenantDataService.ClusterConfig, "tenantDataServic.ClusterConfig", "ClusterConfig must be provided.")
+
+	session, err := tenantDataService.createSession(tenantDataService.WriteConsistency)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer session.Close()
+
+	if !doesTenantExist(tenantID, session) {
+		return nil, contract.NewTenantNotFoundError(tenantID)
+	}
+
+	applicationIDs := make([]system.UUID, 0, len(applications))
+	batch := newTenantPartitionBatch(session)
+
+	for _, application := range applications {
+		applicationID, err := tenantDataService.UUIDGeneratorService.GenerateRandomUUID()
+
+		if err != nil {
+			return nil, err
+		}
+
+		applicationIDs = append(applicationIDs, applicationID)
+		addApplicationToBatch(tenantID, applicationID, application, batch)
+	}
+
+	if batch.Size() != 0 {
+		if err = tenantDataService.executeBatch(session, batch); err != nil {
+			return nil, err
+		}
+	}
+
+	return applicationIDs, nil
+}
+
+// UpdateApplications updates existing tenant applications in a single batch.
+// tenantID: Mandatory: The unique identifier of the existing tenant.
+// applications: Mandatory. The updated applications information, keyed by application unique identifier.
+// allOrNothing: Mandatory. If true, no application is updated when any of the applications cannot be updated.
+// Returns either the errors of the applications that could not be updated, keyed by application unique identifier, or error if something goes wrong.
+func (tenantDataService TenantDataService) UpdateApplications(tenantID system.UUID, applications map[system.UUID]contract.Application, allOrNothing bool) (map[system.UUID]error, error) {
+	diagnostics.IsNotNil(tenantDataService.ClusterConfig, "tenantDataServic.ClusterConfig", "ClusterConfig must be provided.")
+
+	session, err := tenantDataService.createSession(tenantDataService.WriteConsistency)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer session.Close()
+
+	if !doesTenantExist(tenantID, session) {
+		return nil, contract.NewTenantNotFoundError(tenantID)
+	}
+
+	applicationIDs := make([]system.UUID, 0, len(applications))
+
+	for applicationID := range applications {
+		applicationIDs = append(applicationIDs, applicationID)
+	}
+
+	existingApplicationIDs, err := readExistingApplicationIDs(tenantID, applicationIDs, session)
+
+	if err != nil {
+		return nil, err
+	}
+
+	applicationErrors := make(map[system.UUID]error)
+	batch := newTenantPartitionBatch(session)
+
+	for applicationID, application := range applications {
+		if !existingApplicationIDs[applicationID] {
+			applicationErrors[applicationID] = contract.NewApplicationNotFoundError(tenantID, applicationID)
+
+			continue
+		}
+
+		addApplicationToBatch(tenantID, applicationID, application, batch)
+	}
+
+	if (allOrNothing && len(applicationErrors) != 0) || batch.Size() == 0 {
+		return applicationErrors, nil
+	}
+
+	if err = tenantDataService.executeBatch(session, batch); err != nil {
+		return nil, err
+	}
+
+	return applicationErrors, nil
+}
+
+// DeleteApplications deletes existing tenant applications in a single batch.
+// tenantID: Mandatory: The unique identifier of the existing tenant.
+// applicationIDs: Mandatory: The unique identifiers of the existing applications to remove.
+// allOrNothing: Mandatory. If true, no application is deleted when any of the applications cannot be deleted.
+// Returns either the errors of the applications that could not be deleted, keyed by application unique identifier, or error if something goes wrong.
+func (tenantDataService TenantDataService) DeleteApplications(tenantID system.UUID, applicationIDs []system.UUID, allOrNothing bool) (map[system.UUID]error, error) {
+	diagnostics.IsNotNil(tenantDataService.ClusterConfig, "tenantDataServic.ClusterConfig", "ClusterConfig must be provided.")
+
+	session, err := tenantDataService.createSession(tenantDataService.WriteConsistency)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer session.Close()
+
+	if !doesTenantExist(tenantID, session) {
+		return nil, contract.NewTenantNotFoundError(tenantID)
+	}
+
+	existingApplicationIDs, err := readExistingApplicationIDs(tenantID, applicationIDs, session)
+
+	if err != nil {
+		return nil, err
+	}
+
+	applicationErrors := make(map[system.UUID]error)
+	batch := newTenantPartitionBatch(session)
+	mappedTenantID := mapSystemUUIDToGocqlUUID(tenantID)
+
+	for _, applicationID := range applicationIDs {
+		if !existingApplicationIDs[applicationID] {
+			applicationErrors[applicationID] = contract.NewApplicationNotFoundError(tenantID, applicationID)
+
+			continue
+		}
+
+		batch.Query(
+			"DELETE FROM application"+
+				" WHERE"+
+				" tenant_id = ?"+
+				" AND application_id = ?",
+			mappedTenantID,
+			mapSystemUUIDToGocqlUUID(applicationID))
+	}
+
+	if (allOrNothing && len(applicationErrors) != 0) || batch.Size() == 0 {
+		return applicationErrors, nil
+	}
+
+	if err = tenantDataService.executeBatch(session, batch); err != nil {
+		return nil, err
+	}
+
+	return applicationErrors, nil
+}
+
+// CountTenantsAndApplications counts the tenants and the applications of all tenants.
+// Returns either the number of tenants and the number of applications or error if something goes wrong.
+func (tenantDataService TenantDataService) CountTenantsAndApplications() (int, int, error) {
+	diagnostics.IsNotNil(tenantDataService.ClusterConfig, "tenantDataServic.ClusterConfig", "ClusterConfig must be provided.")
+
+	session, err := tenantDataService.createSession(tenantDataService.ReadConsistency)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	defer session.Close()
+
+	var tenantCount, applicationCount int64
+
+	if err = session.Query("SELECT COUNT(*) FROM tenant").Scan(&tenantCount); err != nil {
+		return 0, 0, err
+	}
+
+	if err = session.Query("SELECT COUNT(*) FROM application").Scan(&applicationCount); err != nil {
+		return 0, 0, err
+	}
+
+	return int(tenantCount), int(applicationCount), nil
+}
+
+// CheckHealth checks the Cassandra cluster can be reached and its keyspace holds the tables the service requires. The
+// query is cancelled once the context the tenant data service is bound to is done, see WithContext.
+// Returns error if the data store cannot serve the requests.
+func (tenantDataService TenantDataService) CheckHealth() error {
+	diagnostics.IsNotNil(tenantDataService.ClusterConfig, "tenantDataServic.ClusterConfig", "ClusterConfig must be provided.")
+
+	session, err := tenantDataService.createSession(tenantDataService.ReadConsistency)
+
+	if err != nil {
+		return err
+	}
+
+	defer session.Close()
+
+	iter := session.Query(
+		"SELECT table_name FROM system_schema.tables WHERE keyspace_name = ?",
+		tenantDataService.ClusterConfig.Keyspace).WithContext(tenantDataService.context()).Iter()
+
+	var tableName string
+	existingTables := make(map[string]bool)
+
+	for iter.Scan(&tableName) {
+		existingTables[tableName] = true
+	}
+
+	if err = iter.Close(); err != nil {
+		return err
+	}
+
+	missingTables := []string{}
+
+	for _, requiredTable := range requiredTables {
+		if !existingTables[requiredTable] {
+			missingTables = append(missingTables, requiredTable)
+		}
+	}
+
+	if len(missingTables) != 0 {
+		return fmt.Errorf("Cassandra keyspace does not hold the required tables. Keyspace: %s, Missing tables: %s", tenantDataService.ClusterConfig.Keyspace, strings.Join(missingTables, ", "))
+	}
+
+	return nil
+}
+
+// Open makes the tenant data service, and the copies made of it from then on, execute the statements of every operation
+// using a single session to the Cassandra cluster instead of creating a session per operation. The session is created by
+// the first operation, so the service can be started while the cluster cannot be reached, and created again by the next
+// operation if it fails. Open must be called before the tenant data service is copied, e.g. by NewIdempotencyStore.
+func (tenantDataService *TenantDataService) Open() {
+	diagnostics.IsNotNil(tenantDataService.ClusterConfig, "tenantDataServic.ClusterConfig", "ClusterConfig must be provided.")
+
+	tenantDataService.sessions = &sharedSession{}
+}
+
+// Close closes the session shared by the operations of the open tenant data service and of its copies. The operations
+// executed once it is closed fail. Nothing is done if the tenant data service is not open.
+func (tenantDataService TenantDataService) Close() {
+	if tenantDataService.sessions != nil {
+		tenantDataService.sessions.close()
+	}
+}
+
+// WithContext returns a copy of the tenant data service bound to the provided request context. The copy writes its logs
+// to the logger carried by the context, see logging.FromContext, and records a span for every Cassandra statement as a
+// child of the span carried by the context.
+// ctx: Mandatory. The context of the request being served.
+// Returns the tenant data service bound to the provided context.
+func (tenantDataService TenantDataService) WithContext(ctx context.Context) contract.TenantDataService {
+	tenantDataService.ctx = ctx
+
+	return tenantDataService
+}
+
+// context returns the context the tenant data service is bound to, the background context if it is not bound to any.
+func (tenantDataService TenantDataService) context() context.Context {
+	if tenantDataService.ctx == nil {
+		return context.Background()
+	}
+
+	return tenantDataService.ctx
+}
+
+// logger returns the logger carried by the bound context, or the Logger of the tenant data service if the context carries none.
+func (tenantDataService TenantDataService) logger() log.Logger {
+	if logger, ok := logging.FromContext(tenantDataService.context()); ok {
+		return logger
+	}
+
+	return logging.OrNop(tenantDataService.Logger)
+}
+
+// createSession returns the session executing the statements of an operation, the shared session if the tenant data service
+// is open, otherwise a new session to the Cassandra cluster, logging the failure if the cluster cannot be reached. The
+// statements executed by the session are recorded as children of the span carried by the bound context.
+// consistency: Optional. The consistency level of the statements executed by the session, the consistency level of
+// ClusterConfig is used if nil.
+func (tenantDataService TenantDataService) createSession(consistency *gocql.Consistency) (cassandraSession, error) {
+	session := cassandraSession{
+		consistency:   consistency,
+		queryObserver: tracing.QueryObserver(tenantDataService.context(), tenantDataService.ClusterConfig.QueryObserver),
+		batchObserver: tracing.BatchObserver(tenantDataService.context(), tenantDataService.ClusterConfig.BatchObserver),
+	}
+
+	var err error
+
+	if tenantDataService.sessions != nil {
+		session.session, err = tenantDataService.sessions.get(tenantDataService.connect)
+	} else {
+		session.session, err = tenantDataService.connect()
+		session.owned = true
+	}
+
+	return session, err
+}
+
+// connect creates a new session to the Cassandra cluster, logging the failure if the cluster cannot be reached.
+func (tenantDataService TenantDataService) connect() (*gocql.Session, error) {
+	clusterConfig := *tenantDataService.ClusterConfig
+
+	if tenantDataService.HostSelectionPolicy != nil {
+		clusterConfig.PoolConfig.HostSelectionPolicy = tenantDataService.HostSelectionPolicy()
+	}
+
+	session, err := clusterConfig.CreateSession()
+
+	if err != nil {
+		level.Error(tenantDataService.logger()).Log(
+			"msg", "Failed to connect to Cassandra.",
+			"hosts", strings.Join(tenantDataService.ClusterConfig.Hosts, ","),
+			"keyspace", tenantDataService.ClusterConfig.Keyspace,
+			"err", err)
+	}
+
+	return session, err
+}
+
+// executeBatch executes the provided batch, logging the failure along with the number of statements of the batch.
+func (tenantDataService TenantDataService) executeBatch(session cassandraSession, batch *gocql.Batch) error {
+	err := session.ExecuteBatch(batch)
+
+	if err != nil {
+		level.Error(tenantDataService.logger()).Log("msg", "Failed to execute Cassandra batch.", "statements", batch.Size(), "err", err)
+	} else {
+		level.Debug(tenantDataService.logger()).Log("msg", "Cassandra batch executed.", "statements", batch.Size())
+	}
+
+	return err
+}
+
 // mapSystemUUIDToGocqlUUID maps the system type UUID to gocql UUID type
 func mapSystemUUIDToGocqlUUID(uuid system.UUID) gocql.UUID {
 	mappedUUID, _ := gocql.UUIDFromBytes(uuid.Bytes())
@@ -246,8 +702,19 @@ func mapSystemUUIDToGocqlUUID(uuid system.UUID) gocql.UUID {
 	return mappedUUID
 }
 
+// mapSystemUUIDsToGocqlUUIDs maps the system type UUIDs to gocql UUID type
+func mapSystemUUIDsToGocqlUUIDs(uuids []system.UUID) []gocql.UUID {
+	mappedUUIDs := make([]gocql.UUID, 0, len(uuids))
+
+	for _, uuid := range uuids {
+		mappedUUIDs = append(mappedUUIDs, mapSystemUUIDToGocqlUUID(uuid))
+	}
+
+	return mappedUUIDs
+}
+
 // addOrUpdateTenant adds new tenant to tenant table
-func addOrUpdateTenant(tenantID system.UUID, tenant contract.Tenant, session *gocql.Session) error {
+func addOrUpdateTenant(tenantID system.UUID, tenant contract.Tenant, session cassandraSession) error {
 	mappedTenantID := mapSystemUUIDToGocqlUUID(tenantID)
 
 	return session.Query(
@@ -260,7 +727,7 @@ func addOrUpdateTenant(tenantID system.UUID, tenant contract.Tenant, session *go
 }
 
 // readTenant takes the provided tenantID and tries to read the tenant information from database
-func readTenant(tenantID system.UUID, session *gocql.Session) (contract.Tenant, error) {
+func readTenant(tenantID system.UUID, session cassandraSession) (contract.Tenant, error) {
 	iter := session.Query(
 		"SELECT secret_key"+
 			" FROM tenant"+
@@ -273,15 +740,60 @@ func readTenant(tenantID system.UUID, session *gocql.Session) (contract.Tenant,
 	tenant := contract.Tenant{}
 
 	if !iter.Scan(&tenant.SecretKey) {
-		return contract.Tenant{}, fmt.Errorf("Tenant not found. Tenant ID: %s", tenantID.String())
+		return contract.Tenant{}, contract.NewTenantNotFoundError(tenantID)
 	}
 
 	return tenant, nil
 
 }
 
+// readAllTenants reads all the tenants information from database
+func readAllTenants(session cassandraSession) (map[system.UUID]contract.Tenant, error) {
+	iter := session.Query(
+		"SELECT tenant_id, secret_key" +
+			" FROM tenant").Iter()
+
+	var tenantID gocql.UUID
+	var secretKey string
+	tenants := make(map[system.UUID]contract.Tenant)
+
+	for iter.Scan(&tenantID, &secretKey) {
+		tenants[mapGocqlUUIDToSystemUUID(tenantID)] = contract.Tenant{SecretKey: secretKey}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	return tenants, nil
+}
+
+// readTenants takes the provided tenantIDs and reads the information of the ones that exist from database
+func readTenants(tenantIDs []system.UUID, session cassandraSession) (map[system.UUID]contract.Tenant, error) {
+	iter := session.Query(
+		"SELECT tenant_id, secret_key"+
+			" FROM tenant"+
+			" WHERE"+
+			" tenant_id IN ?",
+		mapSystemUUIDsToGocqlUUIDs(tenantIDs)).Iter()
+
+	var tenantID gocql.UUID
+	var secretKey string
+	tenants := make(map[system.UUID]contract.Tenant)
+
+	for iter.Scan(&tenantID, &secretKey) {
+		tenants[mapGocqlUUIDToSystemUUID(tenantID)] = contract.Tenant{SecretKey: secretKey}
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	return tenants, nil
+}
+
 // doesTenantExist checks whether the provided tenant exists in database
-func doesTenantExist(tenantID system.UUID, session *gocql.Session) bool {
+func doesTenantExist(tenantID system.UUID, session cassandraSession) bool {
 	iter := session.Query(
 		"SELECT secret_key"+
 			" FROM tenant"+
@@ -297,7 +809,7 @@ func doesTenantExist(tenantID system.UUID, session *gocql.Session) bool {
 }
 
 // addOrUpdateApplication adds new qpplication to tenant application table
-func addOrUpdateApplication(tenantID, applicationID system.UUID, application contract.Application, session *gocql.Session) error {
+func addOrUpdateApplication(tenantID, applicationID system.UUID, application contract.Application, session cassandraSession) error {
 	mappedTenantID := mapSystemUUIDToGocqlUUID(tenantID)
 	mappedApplicationID := mapSystemUUIDToGocqlUUID(applicationID)
 
@@ -311,8 +823,25 @@ func addOrUpdateApplication(tenantID, applicationID system.UUID, application con
 		Exec()
 }
 
+// newTenantPartitionBatch creates a batch for the statements of a single tenant. All the statements of the batch target the
+// same partition, so the batch is applied atomically and in isolation without the overhead of the batch log.
+func newTenantPartitionBatch(session cassandraSession) *gocql.Batch {
+	return session.NewBatch(gocql.UnloggedBatch)
+}
+
+// addApplicationToBatch adds the statement that adds or updates the provided application to the batch
+func addApplicationToBatch(tenantID, applicationID system.UUID, application contract.Application, batch *gocql.Batch) {
+	batch.Query(
+		"INSERT INTO application"+
+			" (tenant_id, application_id, name)"+
+			" VALUES(?, ?, ?)",
+		mapSystemUUIDToGocqlUUID(tenantID),
+		mapSystemUUIDToGocqlUUID(applicationID),
+		application.Name)
+}
+
 // readApplication takes the provided tenantID and applicationID and read the tenant application information from database
-func readApplication(tenantID, applicationID system.UUID, session *gocql.Session) (contract.Application, error) {
+func readApplication(tenantID, applicationID system.UUID, session cassandraSession) (contract.Application, error) {
 	iter := session.Query(
 		"SELECT name"+
 			" FROM application"+
@@ -327,7 +856,7 @@ func readApplication(tenantID, applicationID system.UUID, session *gocql.Session
 	application := contract.Application{}
 
 	if !iter.Scan(&application.Name) {
-		return contract.Application{}, fmt.Errorf("Tenant Application not found. Tenant ID: %s, Application ID: %s", tenantID.String(), applicationID.String())
+		return contract.Application{}, contract.NewApplicationNotFoundError(tenantID, applicationID)
 	}
 
 	return application, nil
@@ -335,7 +864,7 @@ func readApplication(tenantID, applicationID system.UUID, session *gocql.Session
 }
 
 // readAllApplications takes the provided tenantID and read all the tenant applications information from database
-func readAllApplications(tenantID system.UUID, session *gocql.Session) map[system.UUID]contract.Application {
+func readAllApplications(tenantID system.UUID, session cassandraSession) map[system.UUID]contract.Application {
 	iter := session.Query(
 		"SELECT application_id, name"+
 			" FROM application"+
@@ -355,7 +884,7 @@ func readAllApplications(tenantID system.UUID, session *gocql.Session) map[syste
 }
 
 // doesApplicationExist checks whether the provided tenant application exists in database
-func doesApplicationExist(tenantID system.UUID, applicationID system.UUID, session *gocql.Session) bool {
+func doesApplicationExist(tenantID system.UUID, applicationID system.UUID, session cassandraSession) bool {
 	iter := session.Query(
 		"SELECT name"+
 			" FROM application"+
@@ -370,6 +899,32 @@ func doesApplicationExist(tenantID system.UUID, applicationID system.UUID, sessi
 	return iter.Scan(&name)
 }
 
+// readExistingApplicationIDs takes the provided tenantID and applicationIDs and returns the ones that exist in database
+func readExistingApplicationIDs(tenantID system.UUID, applicationIDs []system.UUID, session cassandraSession) (map[system.UUID]bool, error) {
+	existingApplicationIDs := make(map[system.UUID]bool)
+
+	if len(applicationIDs) == 0 {
+		return existingApplicationIDs, nil
+	}
+
+	iter := session.Query(
+		"SELECT application_id"+
+			" FROM application"+
+			" WHERE"+
+			" tenant_id = ?"+
+			" AND application_id IN ?",
+		mapSystemUUIDToGocqlUUID(tenantID),
+		mapSystemUUIDsToGocqlUUIDs(applicationIDs)).Iter()
+
+	var applicationID gocql.UUID
+
+	for iter.Scan(&applicationID) {
+		existingApplicationIDs[mapGocqlUUIDToSystemUUID(applicationID)] = true
+	}
+
+	return existingApplicationIDs, iter.Close()
+}
+
 // mapGocqlUUIDToSystemUUID maps the system type UUID to gocql UUID type
 func mapGocqlUUIDToSystemUUID(uuid gocql.UUID) system.UUID {
 	mappedUUID, _ := system.UUIDFromBytes(uuid.Bytes())
@@ -0,0 +1,134 @@
+// +build integration
+
+package service_test
+
+import (
+	"testing"
+
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/data/contract"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bulk application methods behaviour", func() {
+	Context("when creating new applications", func() {
+		It("should return error if tenant does not exist", func() {
+			invalidTenantID, _ := system.RandomUUID()
+			applicationIDs, err := createService().CreateApplications(invalidTenantID, []contract.Application{createApplicationInfo()})
+
+			Expect(applicationIDs).To(BeNil())
+			Expect(err).To(Equal(contract.NewTenantNotFoundError(invalidTenantID)))
+		})
+
+		It("should insert all the applications into application table", func() {
+			tenantID, _, err := createTenant(keyspace)
+			Expect(err).To(BeNil())
+
+			applications := []contract.Application{createApplicationInfo(), createApplicationInfo(), createApplicationInfo()}
+			applicationIDs, err := createService().CreateApplications(tenantID, applications)
+
+			Expect(err).To(BeNil())
+			Expect(applicationIDs).To(HaveLen(len(applications)))
+
+			for index, applicationID := range applicationIDs {
+				application, err := createService().ReadApplication(tenantID, applicationID)
+
+				Expect(err).To(BeNil())
+				Expect(application).To(Equal(applications[index]))
+			}
+		})
+	})
+
+	Context("when updating existing applications", func() {
+		It("should update the existing applications and report the missing ones", func() {
+			tenantID, _, existingApplications, err := createApplications(keyspace)
+			Expect(err).To(BeNil())
+
+			missingApplicationID, _ := system.RandomUUID()
+			updatedApplications := map[system.UUID]contract.Application{missingApplicationID: createApplicationInfo()}
+
+			for applicationID := range existingApplications {
+				updatedApplications[applicationID] = createApplicationInfo()
+			}
+
+			applicationErrors, err := createService().UpdateApplications(tenantID, updatedApplications, false)
+
+			Expect(err).To(BeNil())
+			Expect(applicationErrors).To(HaveLen(1))
+			Expect(applicationErrors).To(HaveKey(missingApplicationID))
+
+			for applicationID := range existingApplications {
+				application, err := createService().ReadApplication(tenantID, applicationID)
+
+				Expect(err).To(BeNil())
+				Expect(application).To(Equal(updatedApplications[applicationID]))
+			}
+		})
+
+		It("should not update any application if any of them is missing and all or nothing is requested", func() {
+			tenantID, _, existingApplications, err := createApplications(keyspace)
+			Expect(err).To(BeNil())
+
+			missingApplicationID, _ := system.RandomUUID()
+			updatedApplications := map[system.UUID]contract.Application{missingApplicationID: createApplicationInfo()}
+
+			for applicationID := range existingApplications {
+				updatedApplications[applicationID] = createApplicationInfo()
+			}
+
+			applicationErrors, err := createService().UpdateApplications(tenantID, updatedApplications, true)
+
+			Expect(err).To(BeNil())
+			Expect(applicationErrors).To(HaveKey(missingApplicationID))
+
+			Expect(createService().ReadAllApplications(tenantID)).To(Equal(existingApplications))
+		})
+	})
+
+	Context("when deleting existing applications", func() {
+		It("should delete the existing applications and report the missing ones", func() {
+			tenantID, _, existingApplications, err := createApplications(keyspace)
+			Expect(err).To(BeNil())
+
+			missingApplicationID, _ := system.RandomUUID()
+			applicationIDs := []system.UUID{missingApplicationID}
+
+			for applicationID := range existingApplications {
+				applicationIDs = append(applicationIDs, applicationID)
+			}
+
+			applicationErrors, err := createService().DeleteApplications(tenantID, applicationIDs, false)
+
+			Expect(err).To(BeNil())
+			Expect(applicationErrors).To(HaveLen(1))
+			Expect(applicationErrors).To(HaveKey(missingApplicationID))
+
+			Expect(createService().ReadAllApplications(tenantID)).To(BeEmpty())
+		})
+
+		It("should not delete any application if any of them is missing and all or nothing is requested", func() {
+			tenantID, _, existingApplications, err := createApplications(keyspace)
+			Expect(err).To(BeNil())
+
+			missingApplicationID, _ := system.RandomUUID()
+			applicationIDs := []system.UUID{missingApplicationID}
+
+			for applicationID := range existingApplications {
+				applicationIDs = append(applicationIDs, applicationID)
+			}
+
+			applicationErrors, err := createService().DeleteApplications(tenantID, applicationIDs, true)
+
+			Expect(err).To(BeNil())
+			Expect(applicationErrors).To(HaveKey(missingApplicationID))
+
+			Expect(createService().ReadAllApplications(tenantID)).To(Equal(existingApplications))
+		})
+	})
+})
+
+func TestBulkApplicationsBehaviour(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Bulk application methods behaviour")
+}
@@ -0,0 +1,73 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/data/contract"
+	"github.com/micro-business/TenantService/data/service"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bulk application methods input parameters and dependency test", func() {
+	var (
+		mockCtrl                 *gomock.Controller
+		tenantDataService        *service.TenantDataService
+		mockUUIDGeneratorService *MockUUIDGeneratorService
+		validTenantID            system.UUID
+		validApplicationID       system.UUID
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockUUIDGeneratorService = NewMockUUIDGeneratorService(mockCtrl)
+		tenantDataService = &service.TenantDataService{UUIDGeneratorService: mockUUIDGeneratorService, ClusterConfig: &gocql.ClusterConfig{}}
+
+		validTenantID, _ = system.RandomUUID()
+		validApplicationID, _ = system.RandomUUID()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("when UUID generator service not provided", func() {
+		It("should panic when creating applications", func() {
+			tenantDataService.UUIDGeneratorService = nil
+
+			Ω(func() {
+				tenantDataService.CreateApplications(validTenantID, []contract.Application{createApplicationInfo()})
+			}).Should(Panic())
+		})
+	})
+
+	Context("when cluster configuration not provided", func() {
+		BeforeEach(func() {
+			tenantDataService.ClusterConfig = nil
+		})
+
+		It("should panic when creating applications", func() {
+			Ω(func() {
+				tenantDataService.CreateApplications(validTenantID, []contract.Application{createApplicationInfo()})
+			}).Should(Panic())
+		})
+
+		It("should panic when updating applications", func() {
+			applications := map[system.UUID]contract.Application{validApplicationID: createApplicationInfo()}
+
+			Ω(func() { tenantDataService.UpdateApplications(validTenantID, applications, true) }).Should(Panic())
+		})
+
+		It("should panic when deleting applications", func() {
+			Ω(func() { tenantDataService.DeleteApplications(validTenantID, []system.UUID{validApplicationID}, true) }).Should(Panic())
+		})
+	})
+})
+
+func TestBulkApplications(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Bulk application methods input parameters and dependency test")
+}
@@ -0,0 +1,41 @@
+// +build integration
+
+package service_test
+
+import (
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/micro-business/TenantService/data/service"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckHealth method behaviour", func() {
+	var (
+		tenantDataService *service.TenantDataService
+		clusterConfig     *gocql.ClusterConfig
+	)
+
+	BeforeEach(func() {
+		clusterConfig = getClusterConfig()
+		clusterConfig.Keyspace = keyspace
+
+		tenantDataService = &service.TenantDataService{ClusterConfig: clusterConfig}
+	})
+
+	It("should succeed if the keyspace holds the required tables", func() {
+		Expect(tenantDataService.CheckHealth()).To(BeNil())
+	})
+
+	It("should fail if the keyspace does not exist", func() {
+		clusterConfig.Keyspace = "missing_keyspace"
+
+		Expect(tenantDataService.CheckHealth()).NotTo(BeNil())
+	})
+})
+
+func TestCheckHealthBehaviour(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CheckHealth method behaviour")
+}
@@ -0,0 +1,54 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/micro-business/TenantService/data/service"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckHealth method input parameters and dependency test", func() {
+	Context("when cluster configuration not provided", func() {
+		It("should panic", func() {
+			tenantDataService := &service.TenantDataService{ClusterConfig: nil}
+
+			Ω(func() { tenantDataService.CheckHealth() }).Should(Panic())
+		})
+	})
+
+	Context("when Cassandra cannot be reached and no logger is provided", func() {
+		It("should return error", func() {
+			clusterConfig := gocql.NewCluster("127.0.0.1")
+			clusterConfig.Port = 1
+			clusterConfig.ConnectTimeout = 100 * time.Millisecond
+			tenantDataService := &service.TenantDataService{ClusterConfig: clusterConfig}
+
+			Expect(tenantDataService.CheckHealth()).NotTo(BeNil())
+		})
+	})
+
+	Context("when the sessions are created with a token aware host selection policy", func() {
+		It("should create every session with a new policy", func() {
+			options := service.ClusterOptions{Hosts: []string{"127.0.0.1"}, ProtocolVersion: 4, ConnectTimeout: 100 * time.Millisecond, RetryPolicy: service.NoRetryPolicy, ConnectionsPerHost: 1, TokenAwareRouting: true}
+			clusterConfig, err := service.NewClusterConfig(options)
+			Expect(err).To(BeNil())
+			clusterConfig.Port = 1
+
+			tenantDataService := &service.TenantDataService{
+				ClusterConfig:       clusterConfig,
+				HostSelectionPolicy: func() gocql.HostSelectionPolicy { return service.NewHostSelectionPolicy(options) },
+			}
+
+			Expect(tenantDataService.CheckHealth()).NotTo(BeNil())
+			Expect(tenantDataService.CheckHealth()).NotTo(BeNil())
+		})
+	})
+})
+
+func TestCheckHealth(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CheckHealth method input parameters and dependency test")
+}
@@ -86,6 +86,13 @@ func createTenantKeyspaceAndAllRequiredTables(keyspace string) {
 			".application(tenant_id UUID, application_id UUID, name text," +
 			" PRIMARY KEY(tenant_id, application_id));").
 		Exec()).To(BeNil())
+
+	Expect(session.Query(
+		"CREATE TABLE " +
+			keyspace +
+			".idempotency(idempotency_key text, fingerprint text, result text, completed boolean," +
+			" PRIMARY KEY(idempotency_key));").
+		Exec()).To(BeNil())
 }
 
 func createTenant(keyspace string) (system.UUID, contract.Tenant, error) {
@@ -0,0 +1,45 @@
+// +build integration
+
+package service_test
+
+import (
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/micro-business/TenantService/data/service"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CountTenantsAndApplications method behaviour", func() {
+	var (
+		tenantDataService *service.TenantDataService
+		clusterConfig     *gocql.ClusterConfig
+	)
+
+	BeforeEach(func() {
+		clusterConfig = getClusterConfig()
+		clusterConfig.Keyspace = keyspace
+
+		tenantDataService = &service.TenantDataService{ClusterConfig: clusterConfig}
+	})
+
+	It("should count the newly created tenant and application", func() {
+		tenantCountBefore, applicationCountBefore, err := tenantDataService.CountTenantsAndApplications()
+		Expect(err).To(BeNil())
+
+		_, _, _, _, err = createApplication(keyspace)
+		Expect(err).To(BeNil())
+
+		tenantCount, applicationCount, err := tenantDataService.CountTenantsAndApplications()
+
+		Expect(err).To(BeNil())
+		Expect(tenantCount).To(Equal(tenantCountBefore + 1))
+		Expect(applicationCount).To(Equal(applicationCountBefore + 1))
+	})
+})
+
+func TestCountTenantsAndApplicationsBehaviour(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CountTenantsAndApplications method behaviour")
+}
@@ -0,0 +1,24 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/micro-business/TenantService/data/service"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CountTenantsAndApplications method input parameters and dependency test", func() {
+	Context("when cluster configuration not provided", func() {
+		It("should panic", func() {
+			tenantDataService := &service.TenantDataService{ClusterConfig: nil}
+
+			Ω(func() { tenantDataService.CountTenantsAndApplications() }).Should(Panic())
+		})
+	})
+})
+
+func TestCountTenantsAndApplications(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CountTenantsAndApplications method input parameters and dependency test")
+}
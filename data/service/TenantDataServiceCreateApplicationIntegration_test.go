@@ -4,12 +4,12 @@ package service_test
 
 import (
 	"errors"
-	"fmt"
 	"testing"
 
 	"github.com/gocql/gocql"
 	"github.com/golang/mock/gomock"
 	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/data/contract"
 	"github.com/micro-business/TenantService/data/service"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -80,7 +80,7 @@ var _ = Describe("CreateApplication method behaviour", func() {
 			newApplicationID, err := tenantDataService.CreateApplication(invalidTenantID, createApplicationInfo())
 
 			Expect(newApplicationID).To(Equal(system.EmptyUUID))
-			Expect(err).To(Equal(fmt.Errorf("Tenant not found. Tenant ID: %s", invalidTenantID.String())))
+			Expect(err).To(Equal(contract.NewTenantNotFoundError(invalidTenantID)))
 		})
 
 		It("should insert the record into application table", func() {
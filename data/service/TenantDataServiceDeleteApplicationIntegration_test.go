@@ -3,11 +3,11 @@
 package service_test
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/gocql/gocql"
 	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/data/contract"
 	"github.com/micro-business/TenantService/data/service"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -32,7 +32,7 @@ var _ = Describe("DeleteApplication method behaviour", func() {
 			Expect(err).To(BeNil())
 
 			invalidTenantID, _ := system.RandomUUID()
-			Expect(tenantDataService.DeleteApplication(invalidTenantID, applicationID)).To(Equal(fmt.Errorf("Tenant not found. Tenant ID: %s", invalidTenantID.String())))
+			Expect(tenantDataService.DeleteApplication(invalidTenantID, applicationID)).To(Equal(contract.NewTenantNotFoundError(invalidTenantID)))
 		})
 
 		It("should return error if application does not exist", func() {
@@ -40,7 +40,7 @@ var _ = Describe("DeleteApplication method behaviour", func() {
 			Expect(err).To(BeNil())
 
 			invalidApplicationID, _ := system.RandomUUID()
-			Expect(tenantDataService.DeleteApplication(tenantID, invalidApplicationID)).To(Equal(fmt.Errorf("Tenant Application not found. Tenant ID: %s, Application ID: %s", tenantID.String(), invalidApplicationID.String())))
+			Expect(tenantDataService.DeleteApplication(tenantID, invalidApplicationID)).To(Equal(contract.NewApplicationNotFoundError(tenantID, invalidApplicationID)))
 		})
 
 		It("should remove the record from application table", func() {
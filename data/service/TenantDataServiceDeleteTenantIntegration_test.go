@@ -3,11 +3,11 @@
 package service_test
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/gocql/gocql"
 	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/data/contract"
 	"github.com/micro-business/TenantService/data/service"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -29,7 +29,7 @@ var _ = Describe("DeleteTenant method behaviour", func() {
 	Context("when deleting existing tenant", func() {
 		It("should return error if tenant does not exist", func() {
 			invalidTenantID, _ := system.RandomUUID()
-			Expect(tenantDataService.DeleteTenant(invalidTenantID)).To(Equal(fmt.Errorf("Tenant not found. Tenant ID: %s", invalidTenantID.String())))
+			Expect(tenantDataService.DeleteTenant(invalidTenantID)).To(Equal(contract.NewTenantNotFoundError(invalidTenantID)))
 		})
 
 		It("should remove the record from tenant table", func() {
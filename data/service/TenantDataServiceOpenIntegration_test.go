@@ -0,0 +1,53 @@
+// +build integration
+
+package service_test
+
+import (
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/data/contract"
+	"github.com/micro-business/TenantService/data/service"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Open method behaviour against Cassandra", func() {
+	var (
+		tenantDataService *service.TenantDataService
+		clusterConfig     *gocql.ClusterConfig
+	)
+
+	BeforeEach(func() {
+		clusterConfig = getClusterConfig()
+		clusterConfig.Keyspace = keyspace
+
+		tenantDataService = &service.TenantDataService{ClusterConfig: clusterConfig, UUIDGeneratorService: &system.UUIDGeneratorServiceImpl{}}
+		tenantDataService.Open()
+	})
+
+	AfterEach(func() {
+		tenantDataService.Close()
+	})
+
+	It("should execute the operations of the tenant data service and of its copies using the shared session", func() {
+		randomValue, _ := system.RandomUUID()
+		tenant := contract.Tenant{SecretKey: randomValue.String()}
+
+		tenantID, err := tenantDataService.CreateTenant(tenant)
+		Expect(err).To(BeNil())
+
+		copiedTenantDataService := *tenantDataService
+		returnedTenant, err := copiedTenantDataService.ReadTenant(tenantID)
+
+		Expect(err).To(BeNil())
+		Expect(returnedTenant).To(Equal(tenant))
+		Expect(tenantDataService.CheckHealth()).To(BeNil())
+	})
+})
+
+func TestOpenBehaviour(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Open method behaviour against Cassandra")
+}
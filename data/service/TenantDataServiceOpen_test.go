@@ -0,0 +1,71 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/micro-business/TenantService/data/service"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Open method behaviour", func() {
+	var (
+		tenantDataService *service.TenantDataService
+		policiesCreated   int
+	)
+
+	BeforeEach(func() {
+		clusterConfig := gocql.NewCluster("127.0.0.1")
+		clusterConfig.Port = 1
+		clusterConfig.ConnectTimeout = 100 * time.Millisecond
+		policiesCreated = 0
+
+		tenantDataService = &service.TenantDataService{
+			ClusterConfig: clusterConfig,
+			HostSelectionPolicy: func() gocql.HostSelectionPolicy {
+				policiesCreated++
+
+				return gocql.RoundRobinHostPolicy()
+			},
+		}
+	})
+
+	Context("when cluster configuration not provided", func() {
+		It("should panic", func() {
+			tenantDataService.ClusterConfig = nil
+
+			Ω(func() { tenantDataService.Open() }).Should(Panic())
+		})
+	})
+
+	It("should try to create the shared session again if Cassandra could not be reached", func() {
+		tenantDataService.Open()
+
+		Expect(tenantDataService.CheckHealth()).NotTo(BeNil())
+		Expect(tenantDataService.CheckHealth()).NotTo(BeNil())
+		Expect(policiesCreated).To(Equal(2))
+	})
+
+	It("should fail the operations without connecting to Cassandra once closed", func() {
+		tenantDataService.Open()
+		copiedTenantDataService := *tenantDataService
+
+		tenantDataService.Close()
+
+		Expect(copiedTenantDataService.CheckHealth()).To(MatchError("Tenant data service is closed."))
+		Expect(policiesCreated).To(Equal(0))
+	})
+
+	It("should do nothing when closed if not open", func() {
+		tenantDataService.Close()
+
+		Expect(tenantDataService.CheckHealth()).NotTo(MatchError("Tenant data service is closed."))
+	})
+})
+
+func TestOpen(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Open method behaviour")
+}
@@ -3,11 +3,11 @@
 package service_test
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/gocql/gocql"
 	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/data/contract"
 	"github.com/micro-business/TenantService/data/service"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -33,7 +33,7 @@ var _ = Describe("ReadAllApplications method behaviour", func() {
 		invalidTenantID, _ := system.RandomUUID()
 		applications, err := tenantDataService.ReadAllApplications(invalidTenantID)
 
-		Expect(err).To(Equal(fmt.Errorf("Tenant not found. Tenant ID: %s", invalidTenantID.String())))
+		Expect(err).To(Equal(contract.NewTenantNotFoundError(invalidTenantID)))
 		Eventually(applications).Should(HaveLen(0))
 	})
 
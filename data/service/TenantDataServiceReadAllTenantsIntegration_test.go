@@ -0,0 +1,41 @@
+// +build integration
+
+package service_test
+
+import (
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/micro-business/TenantService/data/service"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadAllTenants method behaviour", func() {
+	var (
+		tenantDataService *service.TenantDataService
+		clusterConfig     *gocql.ClusterConfig
+	)
+
+	BeforeEach(func() {
+		clusterConfig = getClusterConfig()
+		clusterConfig.Keyspace = keyspace
+
+		tenantDataService = &service.TenantDataService{ClusterConfig: clusterConfig}
+	})
+
+	It("should return the existing tenants", func() {
+		tenantID, expectedTenant, err := createTenant(keyspace)
+		Expect(err).To(BeNil())
+
+		returnedTenants, err := tenantDataService.ReadAllTenants()
+
+		Expect(err).To(BeNil())
+		Expect(returnedTenants).To(HaveKeyWithValue(tenantID, expectedTenant))
+	})
+})
+
+func TestReadAllTenantsBehaviour(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ReadAllTenants method behaviour")
+}
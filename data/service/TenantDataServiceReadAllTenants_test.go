@@ -0,0 +1,24 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/micro-business/TenantService/data/service"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadAllTenants method input parameters and dependency test", func() {
+	Context("when cluster configuration not provided", func() {
+		It("should panic", func() {
+			tenantDataService := &service.TenantDataService{ClusterConfig: nil}
+
+			Ω(func() { tenantDataService.ReadAllTenants() }).Should(Panic())
+		})
+	})
+})
+
+func TestReadAllTenants(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ReadAllTenants method input parameters and dependency test")
+}
@@ -3,7 +3,6 @@
 package service_test
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/gocql/gocql"
@@ -34,7 +33,7 @@ var _ = Describe("ReadApplication method behaviour", func() {
 		invalidTenantID, _ := system.RandomUUID()
 		application, err := tenantDataService.ReadApplication(invalidTenantID, applicationID)
 
-		Expect(err).To(Equal(fmt.Errorf("Tenant not found. Tenant ID: %s", invalidTenantID.String())))
+		Expect(err).To(Equal(contract.NewTenantNotFoundError(invalidTenantID)))
 		Expect(application).To(Equal(contract.Application{}))
 	})
 
@@ -44,7 +43,7 @@ var _ = Describe("ReadApplication method behaviour", func() {
 
 		invalidApplicationID, _ := system.RandomUUID()
 		application, err := tenantDataService.ReadApplication(tenantID, invalidApplicationID)
-		Expect(err).To(Equal(fmt.Errorf("Tenant Application not found. Tenant ID: %s, Application ID: %s", tenantID.String(), invalidApplicationID.String())))
+		Expect(err).To(Equal(contract.NewApplicationNotFoundError(tenantID, invalidApplicationID)))
 		Expect(application).To(Equal(contract.Application{}))
 	})
 
@@ -0,0 +1,51 @@
+// +build integration
+
+package service_test
+
+import (
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/data/contract"
+	"github.com/micro-business/TenantService/data/service"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadApplicationsOfTenants method behaviour", func() {
+	var (
+		tenantDataService *service.TenantDataService
+		clusterConfig     *gocql.ClusterConfig
+	)
+
+	BeforeEach(func() {
+		clusterConfig = getClusterConfig()
+		clusterConfig.Keyspace = keyspace
+
+		tenantDataService = &service.TenantDataService{ClusterConfig: clusterConfig}
+	})
+
+	It("should return the applications of the existing tenants and leave out the tenants that do not exist", func() {
+		tenantID, _, expectedApplications, err := createApplications(keyspace)
+		Expect(err).To(BeNil())
+
+		tenantWithoutApplicationID, _, err := createTenant(keyspace)
+		Expect(err).To(BeNil())
+
+		invalidTenantID, _ := system.RandomUUID()
+
+		returnedApplicationsOfTenants, err := tenantDataService.ReadApplicationsOfTenants([]system.UUID{tenantID, tenantWithoutApplicationID, invalidTenantID})
+
+		Expect(err).To(BeNil())
+		Expect(returnedApplicationsOfTenants).To(Equal(map[system.UUID]map[system.UUID]contract.Application{
+			tenantID:                   expectedApplications,
+			tenantWithoutApplicationID: {},
+		}))
+	})
+})
+
+func TestReadApplicationsOfTenantsBehaviour(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ReadApplicationsOfTenants method behaviour")
+}
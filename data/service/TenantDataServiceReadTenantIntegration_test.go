@@ -3,11 +3,11 @@
 package service_test
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/gocql/gocql"
 	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/data/contract"
 	"github.com/micro-business/TenantService/data/service"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -30,7 +30,7 @@ var _ = Describe("ReadTenant method behaviour", func() {
 		invalidTenantID, _ := system.RandomUUID()
 		_, err := tenantDataService.ReadTenant(invalidTenantID)
 
-		Expect(err).To(Equal(fmt.Errorf("Tenant not found. Tenant ID: %s", invalidTenantID.String())))
+		Expect(err).To(Equal(contract.NewTenantNotFoundError(invalidTenantID)))
 	})
 
 	It("should return the existing tenant", func() {
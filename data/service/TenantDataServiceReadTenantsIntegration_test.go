@@ -0,0 +1,48 @@
+// +build integration
+
+package service_test
+
+import (
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/data/contract"
+	"github.com/micro-business/TenantService/data/service"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadTenants method behaviour", func() {
+	var (
+		tenantDataService *service.TenantDataService
+		clusterConfig     *gocql.ClusterConfig
+	)
+
+	BeforeEach(func() {
+		clusterConfig = getClusterConfig()
+		clusterConfig.Keyspace = keyspace
+
+		tenantDataService = &service.TenantDataService{ClusterConfig: clusterConfig}
+	})
+
+	It("should return the existing tenants and leave out the ones that do not exist", func() {
+		firstTenantID, firstTenant, err := createTenant(keyspace)
+		Expect(err).To(BeNil())
+
+		secondTenantID, secondTenant, err := createTenant(keyspace)
+		Expect(err).To(BeNil())
+
+		invalidTenantID, _ := system.RandomUUID()
+
+		returnedTenants, err := tenantDataService.ReadTenants([]system.UUID{firstTenantID, invalidTenantID, secondTenantID})
+
+		Expect(err).To(BeNil())
+		Expect(returnedTenants).To(Equal(map[system.UUID]contract.Tenant{firstTenantID: firstTenant, secondTenantID: secondTenant}))
+	})
+})
+
+func TestReadTenantsBehaviour(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ReadTenants method behaviour")
+}
@@ -0,0 +1,43 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/data/service"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadTenants method input parameters and dependency test", func() {
+	var (
+		tenantDataService *service.TenantDataService
+	)
+
+	BeforeEach(func() {
+		tenantDataService = &service.TenantDataService{ClusterConfig: &gocql.ClusterConfig{}}
+	})
+
+	Context("when cluster configuration not provided", func() {
+		It("should panic", func() {
+			tenantDataService.ClusterConfig = nil
+
+			validTenantID, _ := system.RandomUUID()
+
+			Ω(func() { tenantDataService.ReadTenants([]system.UUID{validTenantID}) }).Should(Panic())
+		})
+	})
+
+	It("should return nothing without connecting to Cassandra when no tenant provided", func() {
+		returned, err := tenantDataService.ReadTenants([]system.UUID{})
+
+		Expect(err).To(BeNil())
+		Expect(returned).To(BeEmpty())
+	})
+})
+
+func TestReadTenants(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ReadTenants method input parameters and dependency test")
+}
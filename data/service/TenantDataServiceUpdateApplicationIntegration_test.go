@@ -3,11 +3,11 @@
 package service_test
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/gocql/gocql"
 	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/data/contract"
 	"github.com/micro-business/TenantService/data/service"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -32,7 +32,7 @@ var _ = Describe("UpdateApplication method behaviour", func() {
 			Expect(err).To(BeNil())
 
 			invalidTenantID, _ := system.RandomUUID()
-			Expect(tenantDataService.UpdateApplication(invalidTenantID, applicationID, createApplicationInfo())).To(Equal(fmt.Errorf("Tenant not found. Tenant ID: %s", invalidTenantID.String())))
+			Expect(tenantDataService.UpdateApplication(invalidTenantID, applicationID, createApplicationInfo())).To(Equal(contract.NewTenantNotFoundError(invalidTenantID)))
 		})
 
 		It("should return error if application does not exist", func() {
@@ -40,7 +40,7 @@ var _ = Describe("UpdateApplication method behaviour", func() {
 			Expect(err).To(BeNil())
 
 			invalidApplicationID, _ := system.RandomUUID()
-			Expect(tenantDataService.UpdateApplication(tenantID, invalidApplicationID, createApplicationInfo())).To(Equal(fmt.Errorf("Tenant Application not found. Tenant ID: %s, Application ID: %s", tenantID.String(), invalidApplicationID.String())))
+			Expect(tenantDataService.UpdateApplication(tenantID, invalidApplicationID, createApplicationInfo())).To(Equal(contract.NewApplicationNotFoundError(tenantID, invalidApplicationID)))
 		})
 
 		It("should update the record in application table", func() {
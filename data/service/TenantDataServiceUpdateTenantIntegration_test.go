@@ -3,7 +3,6 @@
 package service_test
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/gocql/gocql"
@@ -35,7 +34,7 @@ var _ = Describe("UpdateTenant method behaviour", func() {
 			invalidTenantID, _ := system.RandomUUID()
 			err := tenantDataService.UpdateTenant(invalidTenantID, tenant)
 
-			Expect(err).To(Equal(fmt.Errorf("Tenant not found. Tenant ID: %s", invalidTenantID.String())))
+			Expect(err).To(Equal(contract.NewTenantNotFoundError(invalidTenantID)))
 		})
 
 		It("should update the record in tenant table", func() {
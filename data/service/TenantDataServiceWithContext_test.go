@@ -0,0 +1,30 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/gocql/gocql"
+	"github.com/micro-business/TenantService/data/service"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("WithContext method behaviour", func() {
+	It("should return a copy of the tenant data service keeping its dependencies", func() {
+		logger := log.NewNopLogger()
+		clusterConfig := gocql.NewCluster("127.0.0.1")
+		tenantDataService := service.TenantDataService{ClusterConfig: clusterConfig, Logger: logger}
+
+		scopedTenantDataService := tenantDataService.WithContext(context.Background()).(service.TenantDataService)
+
+		Expect(scopedTenantDataService.ClusterConfig).To(BeIdenticalTo(clusterConfig))
+		Expect(scopedTenantDataService.Logger).To(Equal(logger))
+	})
+})
+
+func TestWithContext(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "WithContext method behaviour")
+}
@@ -0,0 +1,143 @@
+package endpoint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/micro-business/TenantService/endpoint/graphqlendpoint"
+	"golang.org/x/net/context"
+)
+
+// requestError is returned when the request message sent by the client is not acceptable. It carries the HTTP status code
+// that go-kit uses to answer the request.
+type requestError struct {
+	statusCode int
+	message    string
+}
+
+func (err requestError) Error() string {
+	return err.message
+}
+
+// StatusCode returns the HTTP status code of the error.
+func (err requestError) StatusCode() int {
+	return err.statusCode
+}
+
+// createAPIRequestDecoder creates the decoder of the request messages sent by the client, rejecting request bodies larger
+// than the provided maximum size.
+func createAPIRequestDecoder(maxRequestBodySize int64) httptransport.DecodeRequestFunc {
+	return func(context context.Context, httpRequest *http.Request) (interface{}, error) {
+		return decodeAPIRequest(context, httpRequest, maxRequestBodySize)
+	}
+}
+
+// decodeAPIRequest decodes the request message sent by the client following GraphQL over HTTP conventions. The request message
+// can be sent using GET method as part of URL or can be the payload of a POST HTTP message, either a JSON object with query,
+// variables, operationName and extensions members or a GraphQL document sent as application/graphql. POST requests without
+// content type are treated as GraphQL documents too, to keep supporting the clients that predate JSON requests. The query
+// can be omitted if the request carries the hash of a persisted query in its extensions.
+func decodeAPIRequest(context context.Context, httpRequest *http.Request, maxRequestBodySize int64) (interface{}, error) {
+	switch httpRequest.Method {
+	case http.MethodGet:
+		return decodeAPIRequestFromURL(httpRequest)
+
+	case http.MethodPost:
+		return decodeAPIRequestFromBody(httpRequest, maxRequestBodySize)
+	}
+
+	return nil, requestError{
+		statusCode: http.StatusMethodNotAllowed,
+		message:    fmt.Sprintf("HTTP method %s is not supported. Use GET or POST.", httpRequest.Method),
+	}
+}
+
+// decodeAPIRequestFromURL decodes the request message sent as part of the URL of a GET request.
+func decodeAPIRequestFromURL(httpRequest *http.Request) (graphqlendpoint.Request, error) {
+	values := httpRequest.URL.Query()
+	request := graphqlendpoint.Request{
+		Query:         values.Get("query"),
+		OperationName: values.Get("operationName"),
+		QueryOnly:     true,
+	}
+
+	if variables := values.Get("variables"); len(variables) != 0 {
+		if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+			return graphqlendpoint.Request{}, newBadRequestError("variables parameter must be a JSON object. Error: " + err.Error())
+		}
+	}
+
+	if extensions := values.Get("extensions"); len(extensions) != 0 {
+		if err := json.Unmarshal([]byte(extensions), &request.Extensions); err != nil {
+			return graphqlendpoint.Request{}, newBadRequestError("extensions parameter must be a JSON object. Error: " + err.Error())
+		}
+	}
+
+	if len(request.Query) == 0 && !graphqlendpoint.HasPersistedQuery(request) {
+		return graphqlendpoint.Request{}, newBadRequestError("query parameter must be provided.")
+	}
+
+	return request, nil
+}
+
+// decodeAPIRequestFromBody decodes the request message sent as the payload of a POST request.
+func decodeAPIRequestFromBody(httpRequest *http.Request, maxRequestBodySize int64) (graphqlendpoint.Request, error) {
+	mediaType := ""
+
+	if contentType := httpRequest.Header.Get("Content-Type"); len(contentType) != 0 {
+		var err error
+
+		if mediaType, _, err = mime.ParseMediaType(contentType); err != nil {
+			return graphqlendpoint.Request{}, newBadRequestError("Content-Type header is malformed. Error: " + err.Error())
+		}
+	}
+
+	if mediaType != "" && mediaType != "application/json" && mediaType != "application/graphql" {
+		return graphqlendpoint.Request{}, requestError{
+			statusCode: http.StatusUnsupportedMediaType,
+			message:    fmt.Sprintf("Content type %s is not supported. Use application/json or application/graphql.", mediaType),
+		}
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(httpRequest.Body, maxRequestBodySize+1))
+
+	if err != nil {
+		return graphqlendpoint.Request{}, err
+	}
+
+	if int64(len(body)) > maxRequestBodySize {
+		return graphqlendpoint.Request{}, requestError{
+			statusCode: http.StatusRequestEntityTooLarge,
+			message:    fmt.Sprintf("Request body exceeds the maximum allowed size of %d bytes.", maxRequestBodySize),
+		}
+	}
+
+	if mediaType != "application/json" {
+		if len(body) == 0 {
+			return graphqlendpoint.Request{}, newBadRequestError("Request body must contain a GraphQL document.")
+		}
+
+		return graphqlendpoint.Request{Query: string(body)}, nil
+	}
+
+	request := graphqlendpoint.Request{}
+
+	if err = json.Unmarshal(body, &request); err != nil {
+		return graphqlendpoint.Request{}, newBadRequestError("Request body must be a JSON object. Error: " + err.Error())
+	}
+
+	if len(request.Query) == 0 && !graphqlendpoint.HasPersistedQuery(request) {
+		return graphqlendpoint.Request{}, newBadRequestError("query member must be provided.")
+	}
+
+	return request, nil
+}
+
+func newBadRequestError(message string) requestError {
+	return requestError{statusCode: http.StatusBadRequest, message: message}
+}
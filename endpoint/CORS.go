@@ -0,0 +1,87 @@
+package endpoint
+
+import (
+	"net/http"
+	"sync"
+)
+
+// anyOrigin allows the API to be called from any origin.
+const anyOrigin = "*"
+
+const (
+	// allowedMethods are the methods the browsers are allowed to send to the API.
+	allowedMethods = "GET, POST, OPTIONS"
+
+	// allowedHeaders are the request headers the browsers are allowed to send to the API.
+	allowedHeaders = "Origin, Content-Type, Idempotency-Key, X-Request-ID"
+
+	// exposedHeaders are the response headers the browsers let the scripts read.
+	exposedHeaders = "X-Request-ID, RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset, Retry-After"
+)
+
+// corsPolicy holds the origins allowed to call the API from a browser. The origins can be changed while the requests
+// are served, e.g. when the configuration is reloaded.
+type corsPolicy struct {
+	mutex          sync.RWMutex
+	allowedOrigins []string
+}
+
+func newCORSPolicy(allowedOrigins []string) *corsPolicy {
+	return &corsPolicy{allowedOrigins: allowedOrigins}
+}
+
+// setAllowedOrigins changes the origins allowed from then on.
+func (policy *corsPolicy) setAllowedOrigins(allowedOrigins []string) {
+	policy.mutex.Lock()
+	defer policy.mutex.Unlock()
+
+	policy.allowedOrigins = allowedOrigins
+}
+
+// allowOrigin returns the value of the Access-Control-Allow-Origin header answering a request sent from the provided
+// origin, either * or the origin itself, and false if the origin is not allowed.
+func (policy *corsPolicy) allowOrigin(origin string) (string, bool) {
+	policy.mutex.RLock()
+	defer policy.mutex.RUnlock()
+
+	for _, allowedOrigin := range policy.allowedOrigins {
+		if allowedOrigin == anyOrigin {
+			return anyOrigin, true
+		}
+
+		if len(origin) != 0 && allowedOrigin == origin {
+			return origin, true
+		}
+	}
+
+	return "", false
+}
+
+// withCORS returns an HTTP handler that adds the CORS headers to the responses of the provided handler when the origin of
+// the request is allowed by the provided policy. OPTIONS requests, e.g. the preflight requests sent by the browsers, are
+// answered with the CORS headers and no content, they are not passed to the provided handler.
+func withCORS(next http.Handler, policy *corsPolicy) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {
+		allowedOrigin, allowed := policy.allowOrigin(httpRequest.Header.Get("Origin"))
+
+		if allowedOrigin != anyOrigin {
+			writer.Header().Add("Vary", "Origin")
+		}
+
+		if allowed {
+			writer.Header().Set("Access-Control-Allow-Origin", allowedOrigin)
+			writer.Header().Set("Access-Control-Allow-Methods", allowedMethods)
+			writer.Header().Set("Access-Control-Allow-Headers", allowedHeaders)
+			writer.Header().Set("Access-Control-Expose-Headers", exposedHeaders)
+		}
+
+		if httpRequest.Method == http.MethodOptions {
+			writer.Header().Set("Allow", allowedMethods)
+			writer.WriteHeader(http.StatusNoContent)
+
+			return
+		}
+
+		next.ServeHTTP(writer, httpRequest)
+	})
+}
@@ -0,0 +1,129 @@
+package endpoint_test
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/TenantService/config"
+	"github.com/micro-business/TenantService/endpoint"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+// stubSource provides the settings held in the map to the reloader.
+type stubSource map[string]string
+
+func (source stubSource) Name() string {
+	return "consul"
+}
+
+func (source stubSource) Lookup(key string) (string, bool, error) {
+	value, ok := source[key]
+
+	return value, ok, nil
+}
+
+var _ = Describe("CORS behaviour", func() {
+	var (
+		mockCtrl *gomock.Controller
+		server   *endpoint.Endpoint
+		baseURL  string
+		values   stubSource
+		reloader *config.Reloader
+	)
+
+	send := func(method string, origin string) *http.Response {
+		httpRequest, _ := http.NewRequest(method, baseURL+"/Api", nil)
+		httpRequest.Header.Set("Origin", origin)
+
+		response, err := http.DefaultClient.Do(httpRequest)
+		Expect(err).To(BeNil())
+		response.Body.Close()
+
+		return response
+	}
+
+	requestFrom := func(origin string) http.Header {
+		return send(http.MethodGet, origin).Header
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantService := NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).To(BeNil())
+		listeningPort := listener.Addr().(*net.TCPAddr).Port
+		listener.Close()
+
+		values = stubSource{"endpoint/cors/allowed-origins": "https://app.example"}
+		reloader, err = config.NewReloader(config.LayeredConfigurationReader{Sources: []config.Source{values}}, log.NewNopLogger())
+		Expect(err).To(BeNil())
+
+		baseURL = "http://127.0.0.1:" + strconv.Itoa(listeningPort)
+		server = &endpoint.Endpoint{
+			ConfigurationReader: stubConfigurationReader{listeningPort: listeningPort, shutdownTimeout: 30, corsAllowedOrigins: []string{"https://app.example"}},
+			TenantService:       mockTenantService,
+			Logger:              log.NewNopLogger(),
+			Reloader:            reloader,
+		}
+
+		Expect(server.Start()).To(BeNil())
+	})
+
+	AfterEach(func() {
+		server.Shutdown(context.Background())
+		mockCtrl.Finish()
+	})
+
+	It("should allow the configured origin", func() {
+		header := requestFrom("https://app.example")
+
+		Expect(header.Get("Access-Control-Allow-Origin")).To(Equal("https://app.example"))
+		Expect(header.Get("Vary")).To(Equal("Origin"))
+	})
+
+	It("should allow the request headers and expose the response headers the API uses", func() {
+		header := requestFrom("https://app.example")
+
+		Expect(header.Get("Access-Control-Allow-Methods")).To(Equal("GET, POST, OPTIONS"))
+		Expect(header.Get("Access-Control-Allow-Headers")).To(ContainSubstring("Idempotency-Key"))
+		Expect(header.Get("Access-Control-Allow-Headers")).To(ContainSubstring("X-Request-ID"))
+		Expect(header.Get("Access-Control-Expose-Headers")).To(ContainSubstring("X-Request-ID"))
+		Expect(header.Get("Access-Control-Expose-Headers")).To(ContainSubstring("RateLimit-Remaining"))
+	})
+
+	It("should not allow the other origins", func() {
+		header := requestFrom("https://other.example")
+
+		Expect(header.Get("Access-Control-Allow-Origin")).To(BeEmpty())
+	})
+
+	It("should answer the preflight requests with no content", func() {
+		response := send(http.MethodOptions, "https://app.example")
+
+		Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+		Expect(response.Header.Get("Access-Control-Allow-Origin")).To(Equal("https://app.example"))
+		Expect(response.Header.Get("Allow")).To(Equal("GET, POST, OPTIONS"))
+	})
+
+	It("should allow the origins added when the configuration is reloaded", func() {
+		values["endpoint/cors/allowed-origins"] = "https://app.example, https://other.example"
+		Expect(reloader.Reload()).To(Succeed())
+
+		header := requestFrom("https://other.example")
+
+		Expect(header.Get("Access-Control-Allow-Origin")).To(Equal("https://other.example"))
+	})
+})
+
+func TestCORS(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CORS behaviour")
+}
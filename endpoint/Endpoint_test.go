@@ -0,0 +1,133 @@
+package endpoint_test
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/endpoint"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("Server lifecycle behaviour", func() {
+	var (
+		mockCtrl          *gomock.Controller
+		mockTenantService *MockTenantService
+		server            *endpoint.Endpoint
+		baseURL           string
+	)
+
+	freePort := func() int {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).To(BeNil())
+		defer listener.Close()
+
+		return listener.Addr().(*net.TCPAddr).Port
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+
+		listeningPort := freePort()
+		baseURL = "http://127.0.0.1:" + strconv.Itoa(listeningPort)
+		server = &endpoint.Endpoint{
+			ConfigurationReader: stubConfigurationReader{listeningPort: listeningPort, shutdownTimeout: 30},
+			TenantService:       mockTenantService,
+			Logger:              log.NewNopLogger(),
+		}
+
+		Expect(server.Start()).To(BeNil())
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should serve the requests until it is shut down", func() {
+		response, err := http.Get(baseURL + "/healthz")
+		Expect(err).To(BeNil())
+		response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+		Expect(server.Shutdown(context.Background())).To(BeNil())
+
+		_, err = http.Get(baseURL + "/healthz")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should let the in-flight requests complete before shutting down", func() {
+		started := make(chan struct{})
+		release := make(chan struct{})
+
+		mockTenantService.EXPECT().ReadAllTenants().DoAndReturn(func() (map[system.UUID]domain.Tenant, error) {
+			close(started)
+			<-release
+
+			return map[system.UUID]domain.Tenant{}, nil
+		})
+
+		statusCodes := make(chan int, 1)
+
+		go func() {
+			defer GinkgoRecover()
+
+			response, err := http.Get(baseURL + "/tenants")
+			Expect(err).To(BeNil())
+			response.Body.Close()
+
+			statusCodes <- response.StatusCode
+		}()
+
+		<-started
+
+		shutdownErrors := make(chan error, 1)
+
+		go func() {
+			shutdownErrors <- server.Shutdown(context.Background())
+		}()
+
+		Consistently(shutdownErrors, 100*time.Millisecond).ShouldNot(Receive())
+
+		close(release)
+
+		Eventually(statusCodes).Should(Receive(Equal(http.StatusOK)))
+		Eventually(shutdownErrors).Should(Receive(BeNil()))
+	})
+
+	It("should abort the in-flight requests that do not complete before the deadline", func() {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		defer close(release)
+
+		mockTenantService.EXPECT().ReadAllTenants().DoAndReturn(func() (map[system.UUID]domain.Tenant, error) {
+			close(started)
+			<-release
+
+			return map[system.UUID]domain.Tenant{}, nil
+		})
+
+		go http.Get(baseURL + "/tenants")
+
+		<-started
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		Expect(server.Shutdown(ctx)).To(Equal(context.DeadlineExceeded))
+	})
+})
+
+func TestEndpoint(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Server lifecycle behaviour")
+}
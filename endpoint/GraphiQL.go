@@ -0,0 +1,153 @@
+package endpoint
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// graphiQLAssetsPath is the path the GraphiQL assets are served on.
+const graphiQLAssetsPath = "/graphiql/"
+
+// graphiQLContentSecurityPolicy only lets the explorer page run the scripts and styles served by the endpoint itself and
+// send its requests to the endpoint. The assets are vendored rather than loaded from a CDN, so a compromised third party
+// cannot run code with the credentials of the browser.
+const graphiQLContentSecurityPolicy = "default-src 'none'; script-src 'self'; style-src 'self'; img-src 'self' data:; font-src 'self' data:; connect-src 'self'; base-uri 'none'; form-action 'none'; frame-ancestors 'none'"
+
+// graphiQLAssetFiles maps the files expected in the GraphiQL assets directory to the pinned package file each must be
+// vendored from.
+var graphiQLAssetFiles = map[string]string{
+	"graphiql.min.css":            "graphiql@3.0.6/graphiql.min.css",
+	"react.production.min.js":     "react@18.2.0/umd/react.production.min.js",
+	"react-dom.production.min.js": "react-dom@18.2.0/umd/react-dom.production.min.js",
+	"graphql-ws.min.js":           "graphql-ws@5.14.0/umd/graphql-ws.min.js",
+	"graphiql.min.js":             "graphiql@3.0.6/graphiql.min.js",
+}
+
+// graphiQLPage is the GraphiQL explorer page. The page and its assets are served by the endpoint, the page holds no
+// inline script or style so the content security policy does not have to allow any. The explorer sends its requests,
+// the introspection query included, to the URL the page is served on, so it always reflects the live schema.
+// Subscriptions are sent over WebSocket to the same URL.
+const graphiQLPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8" />
+  <title>Tenant Service GraphiQL</title>
+  <link rel="stylesheet" href="/graphiql/graphiql.min.css" />
+  <link rel="stylesheet" href="/graphiql/explorer.css" />
+  <script src="/graphiql/react.production.min.js"></script>
+  <script src="/graphiql/react-dom.production.min.js"></script>
+  <script src="/graphiql/graphql-ws.min.js"></script>
+  <script src="/graphiql/graphiql.min.js"></script>
+</head>
+<body>
+  <div id="graphiql">Loading...</div>
+  <script src="/graphiql/explorer.js"></script>
+</body>
+</html>
+`
+
+// graphiQLExplorerStyle is the style of the explorer page.
+const graphiQLExplorerStyle = `body { height: 100%; margin: 0; width: 100%; overflow: hidden; }
+#graphiql { height: 100vh; }
+`
+
+// graphiQLExplorerScript renders the explorer on the page.
+const graphiQLExplorerScript = `var url = window.location.origin + window.location.pathname;
+var parameters = new URLSearchParams(window.location.search);
+var fetcher = GraphiQL.createFetcher({
+  url: url,
+  wsClient: graphqlWs.createClient({ url: url.replace(/^http/, "ws") })
+});
+
+ReactDOM.createRoot(document.getElementById("graphiql")).render(
+  React.createElement(GraphiQL, {
+    fetcher: fetcher,
+    query: parameters.get("query") || undefined,
+    variables: parameters.get("variables") || undefined,
+    operationName: parameters.get("operationName") || undefined
+  })
+);
+`
+
+// withGraphiQL serves the GraphiQL explorer page to the browsers requesting the API using GET method with text/html in
+// the Accept header. The other requests are served by the provided handler.
+func withGraphiQL(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {
+		if httpRequest.Method != http.MethodGet || !strings.Contains(httpRequest.Header.Get("Accept"), "text/html") || websocket.IsWebSocketUpgrade(httpRequest) {
+			next.ServeHTTP(writer, httpRequest)
+
+			return
+		}
+
+		writeGraphiQLHeaders(writer, "text/html; charset=utf-8")
+
+		io.WriteString(writer, graphiQLPage)
+	})
+}
+
+// newGraphiQLAssetHandler creates the handler serving the GraphiQL assets on graphiQLAssetsPath. The assets are read
+// once from the provided directory, so a missing asset stops the endpoint from starting rather than breaking the page.
+// assetsDirectory: Mandatory. The directory holding the files of graphiQLAssetFiles.
+// Returns either the handler or error if the directory is not provided or an asset cannot be read.
+func newGraphiQLAssetHandler(assetsDirectory string) (http.Handler, error) {
+	if assetsDirectory == "" {
+		return nil, errors.New("GraphiQL assets directory must be provided when GraphiQL is enabled.")
+	}
+
+	assets := map[string][]byte{
+		"explorer.css": []byte(graphiQLExplorerStyle),
+		"explorer.js":  []byte(graphiQLExplorerScript),
+	}
+
+	for name, packageFile := range graphiQLAssetFiles {
+		content, err := ioutil.ReadFile(filepath.Join(assetsDirectory, name))
+
+		if err != nil {
+			return nil, fmt.Errorf("GraphiQL asset could not be read, it must be vendored from %s. File: %s", packageFile, name)
+		}
+
+		assets[name] = content
+	}
+
+	startTime := time.Now()
+
+	return http.StripPrefix(graphiQLAssetsPath, http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {
+		content, ok := assets[httpRequest.URL.Path]
+
+		if !ok {
+			http.NotFound(writer, httpRequest)
+
+			return
+		}
+
+		contentType := "application/javascript; charset=utf-8"
+
+		if strings.HasSuffix(httpRequest.URL.Path, ".css") {
+			contentType = "text/css; charset=utf-8"
+		}
+
+		writeGraphiQLHeaders(writer, contentType)
+
+		http.ServeContent(writer, httpRequest, httpRequest.URL.Path, startTime, bytes.NewReader(content))
+	})), nil
+}
+
+// writeGraphiQLHeaders sets the headers of the responses serving the explorer page and its assets.
+// writer: Mandatory. The response writer the headers are set on.
+// contentType: Mandatory. The content type of the response.
+func writeGraphiQLHeaders(writer http.ResponseWriter, contentType string) {
+	writer.Header().Set("Content-Type", contentType)
+	writer.Header().Set("Cache-Control", "no-cache")
+	writer.Header().Set("Content-Security-Policy", graphiQLContentSecurityPolicy)
+	writer.Header().Set("X-Content-Type-Options", "nosniff")
+	writer.Header().Set("X-Frame-Options", "DENY")
+}
@@ -0,0 +1,205 @@
+package endpoint_test
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/websocket"
+	"github.com/micro-business/TenantService/business/event"
+	"github.com/micro-business/TenantService/config"
+	"github.com/micro-business/TenantService/endpoint"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("GraphiQL behaviour", func() {
+	var (
+		mockCtrl        *gomock.Controller
+		server          *endpoint.Endpoint
+		listeningPort   int
+		values          stubSource
+		assetsDirectory string
+	)
+
+	start := func() error {
+		mockTenantService := NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+
+		server = &endpoint.Endpoint{
+			ConfigurationReader: config.LayeredConfigurationReader{Sources: []config.Source{values}},
+			TenantService:       mockTenantService,
+			Logger:              log.NewNopLogger(),
+			EventBus:            event.NewBus(),
+		}
+
+		err := server.Start()
+
+		if err != nil {
+			server = nil
+		}
+
+		return err
+	}
+
+	// request sends a request of the provided method and Accept header to the provided path.
+	// Returns the response along with its body.
+	request := func(method, path, accept string) (*http.Response, string) {
+		httpRequest, err := http.NewRequest(method, "http://127.0.0.1:"+strconv.Itoa(listeningPort)+path, strings.NewReader(`{"query":"{ __typename }"}`))
+		Expect(err).To(BeNil())
+		httpRequest.Header.Set("Accept", accept)
+		httpRequest.Header.Set("Content-Type", "application/json")
+
+		response, err := http.DefaultClient.Do(httpRequest)
+		Expect(err).To(BeNil())
+
+		defer response.Body.Close()
+		body, _ := ioutil.ReadAll(response.Body)
+
+		return response, string(body)
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).To(BeNil())
+		listeningPort = listener.Addr().(*net.TCPAddr).Port
+		listener.Close()
+
+		assetsDirectory, err = ioutil.TempDir("", "graphiql")
+		Expect(err).To(BeNil())
+
+		for _, name := range []string{"graphiql.min.css", "react.production.min.js", "react-dom.production.min.js", "graphql-ws.min.js", "graphiql.min.js"} {
+			Expect(ioutil.WriteFile(filepath.Join(assetsDirectory, name), []byte("/* "+name+" */"), 0600)).To(BeNil())
+		}
+
+		server = nil
+		values = stubSource{
+			"endpoint/listening-port":                    strconv.Itoa(listeningPort),
+			"endpoint/graphql/graphiql-enabled":          "true",
+			"endpoint/graphql/graphiql-assets-directory": assetsDirectory,
+		}
+	})
+
+	AfterEach(func() {
+		if server != nil {
+			server.Shutdown(context.Background())
+		}
+
+		os.RemoveAll(assetsDirectory)
+		mockCtrl.Finish()
+	})
+
+	Context("when GraphiQL is not configured", func() {
+		It("should not serve the explorer page nor its assets", func() {
+			delete(values, "endpoint/graphql/graphiql-enabled")
+			Expect(start()).To(BeNil())
+
+			response, body := request(http.MethodGet, "/Api", "text/html")
+			Expect(response.Header.Get("Content-Type")).NotTo(HavePrefix("text/html"))
+			Expect(body).NotTo(ContainSubstring("<!DOCTYPE html>"))
+
+			response, _ = request(http.MethodGet, "/graphiql/graphiql.min.js", "*/*")
+			Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Context("when GraphiQL is enabled", func() {
+		It("should fail to start if the assets directory is not provided", func() {
+			delete(values, "endpoint/graphql/graphiql-assets-directory")
+
+			Expect(start()).To(MatchError("GraphiQL assets directory must be provided when GraphiQL is enabled."))
+		})
+
+		It("should fail to start if an asset is missing from the assets directory", func() {
+			Expect(os.Remove(filepath.Join(assetsDirectory, "graphiql.min.js"))).To(BeNil())
+
+			err := start()
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("graphiql@3.0.6/graphiql.min.js"))
+		})
+
+		It("should serve the explorer page to the browsers with a content security policy only allowing the endpoint", func() {
+			Expect(start()).To(BeNil())
+
+			response, body := request(http.MethodGet, "/Api", "text/html,application/xhtml+xml")
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(response.Header.Get("Content-Type")).To(Equal("text/html; charset=utf-8"))
+			Expect(response.Header.Get("Content-Security-Policy")).To(ContainSubstring("script-src 'self';"))
+			Expect(response.Header.Get("Content-Security-Policy")).To(ContainSubstring("frame-ancestors 'none'"))
+			Expect(response.Header.Get("X-Frame-Options")).To(Equal("DENY"))
+			Expect(body).To(ContainSubstring(`<script src="/graphiql/graphiql.min.js"></script>`))
+			Expect(body).NotTo(ContainSubstring("https://"))
+			Expect(body).NotTo(ContainSubstring("<script>"))
+			Expect(body).NotTo(ContainSubstring("<style>"))
+		})
+
+		It("should serve the vendored assets and the explorer script", func() {
+			Expect(start()).To(BeNil())
+
+			response, body := request(http.MethodGet, "/graphiql/react.production.min.js", "*/*")
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(response.Header.Get("Content-Type")).To(Equal("application/javascript; charset=utf-8"))
+			Expect(response.Header.Get("X-Content-Type-Options")).To(Equal("nosniff"))
+			Expect(body).To(Equal("/* react.production.min.js */"))
+
+			response, _ = request(http.MethodGet, "/graphiql/graphiql.min.css", "*/*")
+			Expect(response.Header.Get("Content-Type")).To(Equal("text/css; charset=utf-8"))
+
+			_, body = request(http.MethodGet, "/graphiql/explorer.js", "*/*")
+			Expect(body).To(ContainSubstring("GraphiQL.createFetcher"))
+		})
+
+		It("should not serve the other files of the assets directory", func() {
+			Expect(ioutil.WriteFile(filepath.Join(assetsDirectory, "secret.txt"), []byte("secret"), 0600)).To(BeNil())
+			Expect(start()).To(BeNil())
+
+			response, _ := request(http.MethodGet, "/graphiql/secret.txt", "*/*")
+			Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+
+			response, _ = request(http.MethodGet, "/graphiql/../graphiql/secret.txt", "*/*")
+			Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should pass the POST requests through to the API", func() {
+			Expect(start()).To(BeNil())
+
+			response, body := request(http.MethodPost, "/Api", "text/html")
+			Expect(response.Header.Get("Content-Type")).NotTo(HavePrefix("text/html"))
+			Expect(body).To(ContainSubstring("__typename"))
+		})
+
+		It("should pass the GET requests not accepting HTML through to the API", func() {
+			Expect(start()).To(BeNil())
+
+			response, body := request(http.MethodGet, "/Api?query={__typename}", "application/json")
+			Expect(response.Header.Get("Content-Type")).NotTo(HavePrefix("text/html"))
+			Expect(body).To(ContainSubstring("__typename"))
+		})
+
+		It("should pass the WebSocket upgrades through to the subscriptions", func() {
+			Expect(start()).To(BeNil())
+
+			dialer := websocket.Dialer{Subprotocols: []string{"graphql-transport-ws"}}
+			conn, response, err := dialer.Dial("ws://127.0.0.1:"+strconv.Itoa(listeningPort)+"/Api", http.Header{"Accept": []string{"text/html"}})
+			Expect(err).To(BeNil())
+			defer conn.Close()
+
+			Expect(response.StatusCode).To(Equal(http.StatusSwitchingProtocols))
+		})
+	})
+})
+
+func TestGraphiQL(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "GraphiQL behaviour")
+}
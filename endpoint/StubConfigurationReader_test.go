@@ -0,0 +1,158 @@
+package endpoint_test
+
+import (
+	"net"
+
+	"github.com/micro-business/TenantService/config"
+	"golang.org/x/net/context"
+)
+
+// stubConfigurationReader returns the default value of every setting, only the listening port, the shutdown timeout and
+// the CORS allowed origins are provided by the test. Any origin is allowed if the test provides none.
+type stubConfigurationReader struct {
+	listeningPort      int
+	shutdownTimeout    int
+	corsAllowedOrigins []string
+}
+
+func (reader stubConfigurationReader) GetListeningPort() (int, error) {
+	return reader.listeningPort, nil
+}
+
+func (reader stubConfigurationReader) GetGRPCListeningPort() (int, error) {
+	return 0, nil
+}
+
+func (reader stubConfigurationReader) GetCassandraHosts() ([]string, error) {
+	return []string{"127.0.0.1"}, nil
+}
+
+func (reader stubConfigurationReader) GetCassandraKeyspace() (string, error) {
+	return "tenant", nil
+}
+
+func (reader stubConfigurationReader) GetCassandraProtocolVersion() (int, error) {
+	return 4, nil
+}
+
+func (reader stubConfigurationReader) GetCassandraConnection() (config.CassandraConnection, error) {
+	return config.CassandraConnection{
+		TLSHostVerificationEnabled: true,
+		ReadConsistency:            "quorum",
+		WriteConsistency:           "quorum",
+		ConnectTimeout:             11000,
+		QueryTimeout:               11000,
+		RetryPolicy:                "none",
+		RetryAttempts:              3,
+		TokenAwareRouting:          true,
+		ConnectionsPerHost:         2,
+	}, nil
+}
+
+func (reader stubConfigurationReader) GetTLS() (config.TLS, error) {
+	return config.TLS{MinimumVersion: "1.2"}, nil
+}
+
+func (reader stubConfigurationReader) GetDefaultRateLimit() (config.RateLimit, error) {
+	return config.RateLimit{}, nil
+}
+
+func (reader stubConfigurationReader) GetTenantRateLimits() (map[string]config.RateLimit, error) {
+	return map[string]config.RateLimit{}, nil
+}
+
+func (reader stubConfigurationReader) GetRateLimitTrustedProxies() ([]*net.IPNet, error) {
+	return []*net.IPNet{}, nil
+}
+
+func (reader stubConfigurationReader) GetPrincipalPermissions() (map[string][]string, error) {
+	return map[string][]string{}, nil
+}
+
+func (reader stubConfigurationReader) GetPrincipalTenants() (map[string]config.PrincipalTenant, error) {
+	return map[string]config.PrincipalTenant{}, nil
+}
+
+func (reader stubConfigurationReader) GetSensitiveFieldPolicy() (string, error) {
+	return "mask", nil
+}
+
+func (reader stubConfigurationReader) GetCORSAllowedOrigins() ([]string, error) {
+	if len(reader.corsAllowedOrigins) == 0 {
+		return []string{"*"}, nil
+	}
+
+	return reader.corsAllowedOrigins, nil
+}
+
+func (reader stubConfigurationReader) GetMaxRequestBodySize() (int, error) {
+	return 1024 * 1024, nil
+}
+
+func (reader stubConfigurationReader) GetMaxQueryDepth() (int, error) {
+	return 10, nil
+}
+
+func (reader stubConfigurationReader) GetMaxQueryComplexity() (int, error) {
+	return 1000, nil
+}
+
+func (reader stubConfigurationReader) GetSubscriptionKeepAliveInterval() (int, error) {
+	return 15, nil
+}
+
+func (reader stubConfigurationReader) GetSubscriptionConnectionInitTimeout() (int, error) {
+	return 10, nil
+}
+
+func (reader stubConfigurationReader) GetSubscriptionBufferSize() (int, error) {
+	return 64, nil
+}
+
+func (reader stubConfigurationReader) GetSubscriptionAnonymousAccessAllowed() (bool, error) {
+	return false, nil
+}
+
+func (reader stubConfigurationReader) GetIntrospectionEnabled() (bool, error) {
+	return true, nil
+}
+
+func (reader stubConfigurationReader) GetGraphiQLEnabled() (bool, error) {
+	return false, nil
+}
+
+func (reader stubConfigurationReader) GetGraphiQLAssetsDirectory() (string, error) {
+	return "", nil
+}
+
+func (reader stubConfigurationReader) GetPersistedQueries() (config.PersistedQueries, error) {
+	return config.PersistedQueries{Mode: "automatic", CacheSize: 1000}, nil
+}
+
+func (reader stubConfigurationReader) GetMetricsEnabled() (bool, error) {
+	return false, nil
+}
+
+func (reader stubConfigurationReader) GetLogFormat() (string, error) {
+	return "logfmt", nil
+}
+
+func (reader stubConfigurationReader) GetLogLevel() (string, error) {
+	return "info", nil
+}
+
+func (reader stubConfigurationReader) GetServerTimeouts() (config.ServerTimeouts, error) {
+	return config.ServerTimeouts{ReadTimeout: 30, WriteTimeout: 60, IdleTimeout: 120, ShutdownTimeout: reader.shutdownTimeout}, nil
+}
+
+func (reader stubConfigurationReader) GetTracing() (config.Tracing, error) {
+	return config.Tracing{Exporter: "none", SampleRatio: 1}, nil
+}
+
+func (reader stubConfigurationReader) GetIdempotencyKeyTTL() (int, error) {
+	return 24 * 60 * 60, nil
+}
+
+func (reader stubConfigurationReader) CheckHealth(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,438 @@
+package endpoint
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/TenantService/business/contract"
+	"github.com/micro-business/TenantService/business/event"
+	"github.com/micro-business/TenantService/endpoint/graphqlendpoint"
+	"github.com/micro-business/TenantService/endpoint/security"
+	"golang.org/x/net/context"
+)
+
+// graphqlTransportWSProtocol is the WebSocket sub-protocol used to carry the GraphQL subscriptions.
+const graphqlTransportWSProtocol = "graphql-transport-ws"
+
+// writeTimeout is how long a message can take to be written to a WebSocket connection before the connection is closed.
+const writeTimeout = 10 * time.Second
+
+// The message types defined by the graphql-transport-ws protocol
+const (
+	connectionInitMessage = "connection_init"
+	connectionAckMessage  = "connection_ack"
+	pingMessage           = "ping"
+	pongMessage           = "pong"
+	subscribeMessage      = "subscribe"
+	nextMessage           = "next"
+	errorMessage          = "error"
+	completeMessage       = "complete"
+)
+
+// The close codes defined by the graphql-transport-ws protocol
+const (
+	badRequestCloseCode                = 4400
+	unauthorizedCloseCode              = 4401
+	forbiddenCloseCode                 = 4403
+	connectionInitTimeoutCloseCode     = 4408
+	subscriberAlreadyExistsCloseCode   = 4409
+	tooManyInitialisationRequestsClose = 4429
+)
+
+// operationMessage defines a message of the graphql-transport-ws protocol
+type operationMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// subscriptionHandler serves the GraphQL subscriptions over WebSocket using the graphql-transport-ws protocol.
+type subscriptionHandler struct {
+	tenantService          contract.TenantService
+	eventBus               *event.Bus
+	queryOptions           graphqlendpoint.Options
+	maxMessageSize         int64
+	keepAliveInterval      time.Duration
+	connectionInitTimeout  time.Duration
+	anonymousAccessAllowed bool
+	upgrader               websocket.Upgrader
+
+	// stopping is closed when the server shuts down, the connections are closed then.
+	stopping <-chan struct{}
+}
+
+// subscriptionConnection defines the state of a single WebSocket connection
+type subscriptionConnection struct {
+	handler  subscriptionHandler
+	conn     *websocket.Conn
+	ctx      context.Context
+	cancel   context.CancelFunc
+	outgoing chan operationMessage
+	closing  chan closeRequest
+
+	mutex         sync.Mutex
+	initialised   bool
+	acknowledged  bool
+	operationCtx  context.Context
+	subscriptions map[string]context.CancelFunc
+}
+
+// closeRequest defines the close frame sent before the connection is closed
+type closeRequest struct {
+	code   int
+	reason string
+}
+
+// withSubscriptions returns an HTTP handler that serves the WebSocket upgrade requests as GraphQL subscriptions and passes
+// all the other requests to the provided handler.
+func withSubscriptions(next http.Handler, handler subscriptionHandler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {
+		if !websocket.IsWebSocketUpgrade(httpRequest) {
+			next.ServeHTTP(writer, httpRequest)
+
+			return
+		}
+
+		handler.ServeHTTP(writer, httpRequest)
+	})
+}
+
+// ServeHTTP upgrades the request to a WebSocket connection and serves the connection until it is closed.
+func (handler subscriptionHandler) ServeHTTP(writer http.ResponseWriter, httpRequest *http.Request) {
+	handler.upgrader.Subprotocols = []string{graphqlTransportWSProtocol}
+
+	conn, err := handler.upgrader.Upgrade(writer, httpRequest, nil)
+
+	if err != nil {
+		// The upgrader already replied with the error
+		return
+	}
+
+	ctx, cancel := context.WithCancel(httpRequest.Context())
+
+	connection := &subscriptionConnection{
+		handler:       handler,
+		conn:          conn,
+		ctx:           ctx,
+		cancel:        cancel,
+		outgoing:      make(chan operationMessage, handler.queryOptions.SubscriptionBufferSize),
+		closing:       make(chan closeRequest, 1),
+		subscriptions: make(map[string]context.CancelFunc),
+	}
+
+	if conn.Subprotocol() != graphqlTransportWSProtocol {
+		connection.close(badRequestCloseCode, "Unsupported sub-protocol, "+graphqlTransportWSProtocol+" is required.")
+	}
+
+	go connection.write()
+
+	connection.read()
+}
+
+// read reads and handles the messages sent by the client until the connection is closed.
+func (connection *subscriptionConnection) read() {
+	defer connection.cancel()
+
+	connection.conn.SetReadLimit(connection.handler.maxMessageSize)
+	connection.conn.SetReadDeadline(time.Now().Add(connection.handler.connectionInitTimeout))
+
+	for {
+		var message operationMessage
+
+		if err := connection.conn.ReadJSON(&message); err != nil {
+			if netErr, ok := err.(interface{ Timeout() bool }); ok && netErr.Timeout() && !connection.isAcknowledged() {
+				connection.close(connectionInitTimeoutCloseCode, "Connection initialisation timeout.")
+			} else if isJSONError(err) {
+				connection.close(badRequestCloseCode, "Invalid message received.")
+			} else {
+				connection.close(websocket.CloseNormalClosure, "")
+			}
+
+			return
+		}
+
+		if connection.isAcknowledged() {
+			// Clients answer the keep-alive pings, a silent connection is considered broken
+			connection.conn.SetReadDeadline(time.Now().Add(2 * connection.handler.keepAliveInterval))
+		}
+
+		if !connection.handle(message) {
+			return
+		}
+	}
+}
+
+// handle handles a single message sent by the client.
+// Returns false if the connection is closed as a result of the message.
+func (connection *subscriptionConnection) handle(message operationMessage) bool {
+	switch message.Type {
+	case connectionInitMessage:
+		return connection.initialise(message)
+
+	case pingMessage:
+		connection.send(operationMessage{Type: pongMessage})
+
+	case pongMessage:
+
+	case subscribeMessage:
+		return connection.subscribe(message)
+
+	case completeMessage:
+		connection.unsubscribe(message.ID)
+
+	default:
+		connection.close(badRequestCloseCode, fmt.Sprintf("Invalid message type. Type: %s", message.Type))
+
+		return false
+	}
+
+	return true
+}
+
+// initialise authenticates the client using the connection init message and acknowledges the connection.
+// Returns false if the connection is closed.
+func (connection *subscriptionConnection) initialise(message operationMessage) bool {
+	connection.mutex.Lock()
+	alreadyInitialised := connection.initialised
+	connection.initialised = true
+	connection.mutex.Unlock()
+
+	if alreadyInitialised {
+		connection.close(tooManyInitialisationRequestsClose, "Too many initialisation requests.")
+
+		return false
+	}
+
+	payload := map[string]interface{}{}
+
+	if len(message.Payload) != 0 && string(message.Payload) != "null" {
+		if err := json.Unmarshal(message.Payload, &payload); err != nil {
+			connection.close(badRequestCloseCode, "Connection init payload must be a JSON object.")
+
+			return false
+		}
+	}
+
+	operationCtx, err := connection.handler.authenticate(connection.ctx)
+
+	if err != nil {
+		connection.close(forbiddenCloseCode, err.Error())
+
+		return false
+	}
+
+	connection.mutex.Lock()
+	connection.acknowledged = true
+	connection.operationCtx = operationCtx
+	connection.mutex.Unlock()
+
+	connection.conn.SetReadDeadline(time.Now().Add(2 * connection.handler.keepAliveInterval))
+	connection.send(operationMessage{Type: connectionAckMessage})
+
+	return true
+}
+
+// subscribe starts the operation of the provided subscribe message and delivers its responses to the client.
+// Returns false if the connection is closed.
+func (connection *subscriptionConnection) subscribe(message operationMessage) bool {
+	if !connection.isAcknowledged() {
+		connection.close(unauthorizedCloseCode, "Unauthorized.")
+
+		return false
+	}
+
+	var request graphqlendpoint.Request
+
+	if len(message.ID) == 0 || json.Unmarshal(message.Payload, &request) != nil {
+		connection.close(badRequestCloseCode, "Invalid subscribe message.")
+
+		return false
+	}
+
+	connection.mutex.Lock()
+
+	if _, exists := connection.subscriptions[message.ID]; exists {
+		connection.mutex.Unlock()
+		connection.close(subscriberAlreadyExistsCloseCode, fmt.Sprintf("Subscriber for %s already exists.", message.ID))
+
+		return false
+	}
+
+	subscriptionCtx, cancel := context.WithCancel(connection.operationCtx)
+	connection.subscriptions[message.ID] = cancel
+	connection.mutex.Unlock()
+
+	results := graphqlendpoint.Subscribe(subscriptionCtx, request, connection.handler.tenantService, connection.handler.eventBus, connection.handler.queryOptions)
+
+	go connection.deliver(message.ID, subscriptionCtx, results)
+
+	return true
+}
+
+// deliver sends the responses of a subscription to the client until the subscription ends. The responses are read until
+// the channel is closed, also after the subscription is cancelled, so the execution of the subscription can finish.
+func (connection *subscriptionConnection) deliver(id string, subscriptionCtx context.Context, results <-chan *graphql.Result) {
+	failed := false
+
+	for result := range results {
+		if subscriptionCtx.Err() != nil || failed {
+			continue
+		}
+
+		if graphqlendpoint.IsRequestError(result) {
+			failed = true
+			connection.send(operationMessage{ID: id, Type: errorMessage, Payload: marshalPayload(result.Errors)})
+
+			continue
+		}
+
+		connection.send(operationMessage{ID: id, Type: nextMessage, Payload: marshalPayload(result)})
+	}
+
+	connection.mutex.Lock()
+	cancel, active := connection.subscriptions[id]
+	delete(connection.subscriptions, id)
+	connection.mutex.Unlock()
+
+	if !active {
+		// The client completed the subscription, it must not be notified
+		return
+	}
+
+	cancel()
+
+	if !failed {
+		connection.send(operationMessage{ID: id, Type: completeMessage})
+	}
+}
+
+// unsubscribe ends the subscription with the provided identifier on the client request.
+func (connection *subscriptionConnection) unsubscribe(id string) {
+	connection.mutex.Lock()
+	defer connection.mutex.Unlock()
+
+	if cancel, exists := connection.subscriptions[id]; exists {
+		delete(connection.subscriptions, id)
+		cancel()
+	}
+}
+
+// write writes the outgoing messages to the client and sends a ping at every keep-alive interval until the connection is
+// closed or the server shuts down.
+func (connection *subscriptionConnection) write() {
+	ticker := time.NewTicker(connection.handler.keepAliveInterval)
+
+	defer func() {
+		ticker.Stop()
+		connection.cancel()
+		connection.conn.Close()
+	}()
+
+	for {
+		select {
+		case message := <-connection.outgoing:
+			connection.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+
+			if err := connection.conn.WriteJSON(message); err != nil {
+				return
+			}
+
+		case <-ticker.C:
+			if !connection.isAcknowledged() {
+				continue
+			}
+
+			connection.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+
+			if err := connection.conn.WriteJSON(operationMessage{Type: pingMessage}); err != nil {
+				return
+			}
+
+		case request := <-connection.closing:
+			connection.conn.WriteControl(
+				websocket.CloseMessage,
+				websocket.FormatCloseMessage(request.code, request.reason),
+				time.Now().Add(writeTimeout))
+
+			return
+
+		case <-connection.handler.stopping:
+			connection.conn.WriteControl(
+				websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseGoingAway, "Server is shutting down."),
+				time.Now().Add(writeTimeout))
+
+			return
+
+		case <-connection.ctx.Done():
+			select {
+			case request := <-connection.closing:
+				connection.conn.WriteControl(
+					websocket.CloseMessage,
+					websocket.FormatCloseMessage(request.code, request.reason),
+					time.Now().Add(writeTimeout))
+			default:
+			}
+
+			return
+		}
+	}
+}
+
+// send queues the provided message to be written to the client. Send blocks while the outgoing queue is full, which holds
+// back the subscriptions of a slow client until their event buffers overflow.
+func (connection *subscriptionConnection) send(message operationMessage) {
+	select {
+	case connection.outgoing <- message:
+	case <-connection.ctx.Done():
+	}
+}
+
+// close closes the connection with the provided close code and reason. Only the first close request is sent to the client.
+func (connection *subscriptionConnection) close(code int, reason string) {
+	select {
+	case connection.closing <- closeRequest{code: code, reason: reason}:
+	default:
+	}
+}
+
+func (connection *subscriptionConnection) isAcknowledged() bool {
+	connection.mutex.Lock()
+	defer connection.mutex.Unlock()
+
+	return connection.acknowledged
+}
+
+// authenticate returns the context the operations of a connection are executed with. The caller is authenticated by the
+// client certificate presented when the connection was opened, and acts on behalf of the tenant bound to the certificate.
+// Nothing the client sends, including the connection init payload, changes the tenant the caller acts on behalf of.
+// ctx: Mandatory. The context of the upgrade request.
+// Returns either the context carrying the authenticated principal or error if the caller is not allowed to subscribe.
+func (handler subscriptionHandler) authenticate(ctx context.Context) (context.Context, error) {
+	if _, authenticated := security.PrincipalFromContext(ctx); !authenticated && !handler.anonymousAccessAllowed {
+		return nil, errors.New("Forbidden.")
+	}
+
+	return ctx, nil
+}
+
+func isJSONError(err error) bool {
+	switch err.(type) {
+	case *json.SyntaxError, *json.UnmarshalTypeError:
+		return true
+	default:
+		return false
+	}
+}
+
+func marshalPayload(value interface{}) json.RawMessage {
+	payload, _ := json.Marshal(value)
+
+	return payload
+}
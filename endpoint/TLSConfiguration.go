@@ -0,0 +1,236 @@
+package endpoint
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/micro-business/TenantService/config"
+	"github.com/micro-business/TenantService/endpoint/security"
+)
+
+// certificateReloadInterval is how often the certificate files are checked for changes.
+const certificateReloadInterval = 10 * time.Second
+
+// tlsVersions are the TLS versions the service can be limited to. TLS 1.0 and 1.1 are deprecated by RFC 8996.
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// certificateReloader keeps the service certificate and the client CA bundle in memory and reloads them when the files change on disk.
+type certificateReloader struct {
+	certificateFilePath string
+	keyFilePath         string
+	clientCAFilePath    string
+
+	mutex             sync.RWMutex
+	certificate       *tls.Certificate
+	clientCAs         *x509.CertPool
+	modificationTimes map[string]time.Time
+}
+
+// createTLSConfig creates the TLS configuration of the server using the provided configuration reader.
+// configurationReader: Mandatory. The reference to the configuration reader.
+// logger: Mandatory. The logger the certificate reloads are logged to.
+// stop: Mandatory. Closing it stops watching the certificate files.
+// Returns either the TLS configuration, nil if TLS is not configured, or error if something goes wrong.
+func createTLSConfig(configurationReader config.ConfigurationReader, logger log.Logger, stop <-chan struct{}) (*tls.Config, error) {
+	tlsSettings, err := configurationReader.GetTLS()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tlsSettings.CertificateFilePath) == 0 {
+		return nil, nil
+	}
+
+	minimumVersion, ok := tlsVersions[tlsSettings.MinimumVersion]
+
+	if !ok {
+		return nil, fmt.Errorf("TLS minimum version is not supported. Version: %s", tlsSettings.MinimumVersion)
+	}
+
+	reloader, err := newCertificateReloader(tlsSettings.CertificateFilePath, tlsSettings.KeyFilePath, tlsSettings.ClientCAFilePath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	go reloader.watch(certificateReloadInterval, logger, stop)
+
+	tlsConfig := &tls.Config{
+		MinVersion:     minimumVersion,
+		GetCertificate: reloader.getCertificate,
+	}
+
+	if len(tlsSettings.ClientCAFilePath) == 0 {
+		return tlsConfig, nil
+	}
+
+	if tlsSettings.ClientCertificateRequired {
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	} else {
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	tlsConfig.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		clientConfig := tlsConfig.Clone()
+		clientConfig.ClientCAs = reloader.getClientCAs()
+
+		return clientConfig, nil
+	}
+
+	return tlsConfig, nil
+}
+
+// withClientCertificatePrincipal returns an HTTP handler that adds the subject of the verified client certificate to the
+// request context as the authenticated principal before passing the request to the provided handler. The tenant and
+// application the caller acts on behalf of are bound to the certificate by the configuration, never read from the request.
+// principalPermissions: Mandatory. The permissions explicitly granted to the principals, keyed by certificate common name.
+// principalTenants: Mandatory. The tenant and application the principals are bound to, keyed by certificate common name.
+func withClientCertificatePrincipal(next http.Handler, principalPermissions map[string][]string, principalTenants map[string]security.TenantBinding) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {
+		if httpRequest.TLS == nil || len(httpRequest.TLS.VerifiedChains) == 0 || len(httpRequest.TLS.VerifiedChains[0]) == 0 {
+			next.ServeHTTP(writer, httpRequest)
+
+			return
+		}
+
+		principal := security.NewCertificatePrincipal(httpRequest.TLS.VerifiedChains[0][0], principalPermissions, principalTenants)
+
+		next.ServeHTTP(writer, httpRequest.WithContext(security.WithPrincipal(httpRequest.Context(), principal)))
+	})
+}
+
+// newCertificateReloader creates a new certificate reloader and loads the certificate files for the first time.
+func newCertificateReloader(certificateFilePath, keyFilePath, clientCAFilePath string) (*certificateReloader, error) {
+	reloader := &certificateReloader{
+		certificateFilePath: certificateFilePath,
+		keyFilePath:         keyFilePath,
+		clientCAFilePath:    clientCAFilePath,
+	}
+
+	if _, err := reloader.reloadIfChanged(); err != nil {
+		return nil, err
+	}
+
+	return reloader, nil
+}
+
+// watch checks the certificate files periodically and reloads them when they change, until the provided channel is closed.
+func (reloader *certificateReloader) watch(interval time.Duration, logger log.Logger, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if reloaded, err := reloader.reloadIfChanged(); err != nil {
+				level.Error(logger).Log("msg", "Failed to reload TLS certificates, keeping the previous ones.", "err", err)
+			} else if reloaded {
+				level.Info(logger).Log("msg", "TLS certificates reloaded.")
+			}
+
+		case <-stop:
+			return
+		}
+	}
+}
+
+// reloadIfChanged reloads the certificate files if any of them changed since the last load.
+// Returns true if the certificates are reloaded or error if something goes wrong.
+func (reloader *certificateReloader) reloadIfChanged() (bool, error) {
+	modificationTimes := make(map[string]time.Time)
+
+	for _, filePath := range []string{reloader.certificateFilePath, reloader.keyFilePath, reloader.clientCAFilePath} {
+		if len(filePath) == 0 {
+			continue
+		}
+
+		fileInfo, err := os.Stat(filePath)
+
+		if err != nil {
+			return false, err
+		}
+
+		modificationTimes[filePath] = fileInfo.ModTime()
+	}
+
+	if !reloader.hasChanged(modificationTimes) {
+		return false, nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(reloader.certificateFilePath, reloader.keyFilePath)
+
+	if err != nil {
+		return false, err
+	}
+
+	var clientCAs *x509.CertPool
+
+	if len(reloader.clientCAFilePath) != 0 {
+		clientCABundle, err := ioutil.ReadFile(reloader.clientCAFilePath)
+
+		if err != nil {
+			return false, err
+		}
+
+		clientCAs = x509.NewCertPool()
+
+		if !clientCAs.AppendCertsFromPEM(clientCABundle) {
+			return false, fmt.Errorf("No certificate found in TLS client CA file. File: %s", reloader.clientCAFilePath)
+		}
+	}
+
+	reloader.mutex.Lock()
+	defer reloader.mutex.Unlock()
+
+	reloader.certificate = &certificate
+	reloader.clientCAs = clientCAs
+	reloader.modificationTimes = modificationTimes
+
+	return true, nil
+}
+
+// hasChanged checks whether the provided file modification times differ from the ones recorded at the last load.
+func (reloader *certificateReloader) hasChanged(modificationTimes map[string]time.Time) bool {
+	reloader.mutex.RLock()
+	defer reloader.mutex.RUnlock()
+
+	if len(reloader.modificationTimes) != len(modificationTimes) {
+		return true
+	}
+
+	for filePath, modificationTime := range modificationTimes {
+		if !reloader.modificationTimes[filePath].Equal(modificationTime) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getCertificate returns the currently loaded service certificate.
+func (reloader *certificateReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	reloader.mutex.RLock()
+	defer reloader.mutex.RUnlock()
+
+	return reloader.certificate, nil
+}
+
+// getClientCAs returns the currently loaded client CA bundle.
+func (reloader *certificateReloader) getClientCAs() *x509.CertPool {
+	reloader.mutex.RLock()
+	defer reloader.mutex.RUnlock()
+
+	return reloader.clientCAs
+}
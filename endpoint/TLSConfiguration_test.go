@@ -0,0 +1,466 @@
+package endpoint_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/websocket"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/business/event"
+	"github.com/micro-business/TenantService/config"
+	"github.com/micro-business/TenantService/endpoint"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+// testCertificateAuthority issues the certificates of the TLS tests.
+type testCertificateAuthority struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	pem         []byte
+}
+
+func newTestCertificateAuthority(commonName string) testCertificateAuthority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).To(BeNil())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).To(BeNil())
+
+	certificate, err := x509.ParseCertificate(der)
+	Expect(err).To(BeNil())
+
+	return testCertificateAuthority{certificate: certificate, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue issues a certificate of the provided common name, valid for the loopback address, and returns its PEM encoded
+// certificate and key.
+func (authority testCertificateAuthority) issue(commonName string, extendedKeyUsage x509.ExtKeyUsage) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).To(BeNil())
+
+	serialNumber, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	Expect(err).To(BeNil())
+
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{extendedKeyUsage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, authority.certificate, &key.PublicKey, authority.key)
+	Expect(err).To(BeNil())
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	Expect(err).To(BeNil())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// clientCertificate issues a client certificate of the provided common name.
+func (authority testCertificateAuthority) clientCertificate(commonName string) tls.Certificate {
+	certificatePEM, keyPEM := authority.issue(commonName, x509.ExtKeyUsageClientAuth)
+	certificate, err := tls.X509KeyPair(certificatePEM, keyPEM)
+	Expect(err).To(BeNil())
+
+	return certificate
+}
+
+var _ = Describe("TLS behaviour", func() {
+	var (
+		mockCtrl          *gomock.Controller
+		mockTenantService *MockTenantService
+		server            *endpoint.Endpoint
+		directory         string
+		serverCA          testCertificateAuthority
+		clientCA          testCertificateAuthority
+		listeningPort     int
+		values            stubSource
+	)
+
+	writeFile := func(name string, content []byte) string {
+		filePath := filepath.Join(directory, name)
+		Expect(ioutil.WriteFile(filePath, content, 0600)).To(Succeed())
+
+		return filePath
+	}
+
+	start := func() error {
+		server = &endpoint.Endpoint{
+			ConfigurationReader: config.LayeredConfigurationReader{Sources: []config.Source{values}},
+			TenantService:       mockTenantService,
+			Logger:              log.NewNopLogger(),
+			EventBus:            event.NewBus(),
+		}
+
+		err := server.Start()
+
+		if err != nil {
+			server = nil
+		}
+
+		return err
+	}
+
+	// get sends a GET request to the provided path using the provided client.
+	// Returns the status code and the body of the response.
+	get := func(client *http.Client, path string) (int, string, error) {
+		response, err := client.Get("https://127.0.0.1:" + strconv.Itoa(listeningPort) + path)
+
+		if err != nil {
+			return 0, "", err
+		}
+
+		defer response.Body.Close()
+		body, _ := ioutil.ReadAll(response.Body)
+
+		return response.StatusCode, string(body), nil
+	}
+
+	// servedCommonName connects to the server and returns the common name of the certificate it presents.
+	servedCommonName := func() string {
+		conn, err := tls.Dial("tcp", "127.0.0.1:"+strconv.Itoa(listeningPort), &tls.Config{InsecureSkipVerify: true})
+
+		if err != nil {
+			return ""
+		}
+
+		defer conn.Close()
+
+		return conn.ConnectionState().PeerCertificates[0].Subject.CommonName
+	}
+
+	newClient := func(clientCertificates ...tls.Certificate) *http.Client {
+		rootCAs := x509.NewCertPool()
+		rootCAs.AddCert(serverCA.certificate)
+
+		return &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: rootCAs, Certificates: clientCertificates}}}
+	}
+
+	post := func(client *http.Client, header map[string]string) (int, error) {
+		httpRequest, _ := http.NewRequest(http.MethodPost, "https://127.0.0.1:"+strconv.Itoa(listeningPort)+"/Api", nil)
+
+		for name, value := range header {
+			httpRequest.Header.Set(name, value)
+		}
+
+		response, err := client.Do(httpRequest)
+
+		if err != nil {
+			return 0, err
+		}
+
+		response.Body.Close()
+
+		return response.StatusCode, nil
+	}
+
+	// subscribe opens a WebSocket connection with the provided client certificate, initialises it with the provided
+	// payload and subscribes to the changes of the provided tenant.
+	// Returns the first message answering the subscription.
+	subscribe := func(clientCertificate tls.Certificate, initPayload map[string]interface{}, tenantID string) string {
+		rootCAs := x509.NewCertPool()
+		rootCAs.AddCert(serverCA.certificate)
+		dialer := websocket.Dialer{
+			TLSClientConfig: &tls.Config{RootCAs: rootCAs, Certificates: []tls.Certificate{clientCertificate}},
+			Subprotocols:    []string{"graphql-transport-ws"},
+		}
+
+		conn, _, err := dialer.Dial("wss://127.0.0.1:"+strconv.Itoa(listeningPort)+"/Api", nil)
+		Expect(err).To(BeNil())
+		defer conn.Close()
+
+		Expect(conn.WriteJSON(map[string]interface{}{"type": "connection_init", "payload": initPayload})).To(Succeed())
+
+		var message map[string]interface{}
+		Expect(conn.ReadJSON(&message)).To(Succeed())
+		Expect(message["type"]).To(Equal("connection_ack"))
+
+		Expect(conn.WriteJSON(map[string]interface{}{
+			"id":      "1",
+			"type":    "subscribe",
+			"payload": map[string]interface{}{"query": "subscription {tenantChanged(tenantID:\"" + tenantID + "\"){type}}"},
+		})).To(Succeed())
+
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		_, answer, err := conn.ReadMessage()
+
+		if err != nil {
+			return ""
+		}
+
+		return string(answer)
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+
+		var err error
+		directory, err = ioutil.TempDir("", "tls")
+		Expect(err).To(BeNil())
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).To(BeNil())
+		listeningPort = listener.Addr().(*net.TCPAddr).Port
+		listener.Close()
+
+		serverCA = newTestCertificateAuthority("Server CA")
+		clientCA = newTestCertificateAuthority("Client CA")
+		certificatePEM, keyPEM := serverCA.issue("tenant-service", x509.ExtKeyUsageServerAuth)
+
+		values = stubSource{
+			"endpoint/listening-port":                 strconv.Itoa(listeningPort),
+			"endpoint/tls/certificate-file-path":      writeFile("server.pem", certificatePEM),
+			"endpoint/tls/key-file-path":              writeFile("server-key.pem", keyPEM),
+			"endpoint/tls/client-ca-file-path":        writeFile("client-ca.pem", clientCA.pem),
+			"endpoint/rate-limit/requests-per-second": "0.001",
+			"endpoint/rate-limit/burst":               "1",
+		}
+	})
+
+	AfterEach(func() {
+		if server != nil {
+			server.Shutdown(context.Background())
+			server = nil
+		}
+
+		mockCtrl.Finish()
+		os.RemoveAll(directory)
+	})
+
+	Context("when the TLS configuration is read", func() {
+		It("should serve TLS using the configured certificate", func() {
+			Expect(start()).To(Succeed())
+
+			Expect(servedCommonName()).To(Equal("tenant-service"))
+		})
+
+		It("should return error if the key is not provided along with the certificate", func() {
+			delete(values, "endpoint/tls/key-file-path")
+
+			Expect(start()).NotTo(Succeed())
+		})
+
+		It("should return error if the key does not match the certificate", func() {
+			_, otherKeyPEM := serverCA.issue("other", x509.ExtKeyUsageServerAuth)
+			values["endpoint/tls/key-file-path"] = writeFile("other-key.pem", otherKeyPEM)
+
+			Expect(start()).NotTo(Succeed())
+		})
+
+		It("should return error if a client certificate is required but no client CA bundle is provided", func() {
+			delete(values, "endpoint/tls/client-ca-file-path")
+			values["endpoint/tls/client-certificate-required"] = "true"
+
+			Expect(start()).NotTo(Succeed())
+		})
+
+		It("should return error if the client CA bundle holds no certificate", func() {
+			values["endpoint/tls/client-ca-file-path"] = writeFile("empty-ca.pem", []byte("not a certificate"))
+
+			Expect(start()).NotTo(Succeed())
+		})
+
+		It("should return error if the client CA bundle cannot be read", func() {
+			values["endpoint/tls/client-ca-file-path"] = filepath.Join(directory, "missing.pem")
+
+			Expect(start()).NotTo(Succeed())
+		})
+
+		It("should return error if the minimum version is not supported", func() {
+			values["endpoint/tls/minimum-version"] = "1.4"
+
+			Expect(start()).NotTo(Succeed())
+		})
+
+		It("should return error if the minimum version is deprecated", func() {
+			for _, minimumVersion := range []string{"1.0", "1.1"} {
+				values["endpoint/tls/minimum-version"] = minimumVersion
+
+				Expect(start()).NotTo(Succeed(), minimumVersion)
+			}
+		})
+
+		It("should refuse the clients below the minimum version", func() {
+			values["endpoint/tls/minimum-version"] = "1.3"
+			Expect(start()).To(Succeed())
+
+			client := newClient()
+			client.Transport.(*http.Transport).TLSClientConfig.MaxVersion = tls.VersionTLS12
+
+			_, _, err := get(client, "/healthz")
+			Expect(err).NotTo(BeNil())
+
+			_, _, err = get(newClient(), "/healthz")
+			Expect(err).To(BeNil())
+		})
+
+		It("should refuse the clients without a certificate when a client certificate is required", func() {
+			values["endpoint/tls/client-certificate-required"] = "true"
+			Expect(start()).To(Succeed())
+
+			_, _, err := get(newClient(), "/healthz")
+			Expect(err).NotTo(BeNil())
+
+			_, _, err = get(newClient(clientCA.clientCertificate("billing")), "/healthz")
+			Expect(err).To(BeNil())
+		})
+
+		It("should refuse the client certificates not signed by the client CA bundle", func() {
+			values["endpoint/tls/client-certificate-required"] = "true"
+			Expect(start()).To(Succeed())
+
+			_, _, err := get(newClient(newTestCertificateAuthority("Other CA").clientCertificate("billing")), "/healthz")
+
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("when the certificate files change", func() {
+		It("should serve the new certificate without restarting", func() {
+			Expect(start()).To(Succeed())
+			Expect(servedCommonName()).To(Equal("tenant-service"))
+
+			certificatePEM, keyPEM := serverCA.issue("renewed-tenant-service", x509.ExtKeyUsageServerAuth)
+			later := time.Now().Add(time.Minute)
+			writeFile("server.pem", certificatePEM)
+			writeFile("server-key.pem", keyPEM)
+			os.Chtimes(values["endpoint/tls/certificate-file-path"], later, later)
+			os.Chtimes(values["endpoint/tls/key-file-path"], later, later)
+
+			Eventually(servedCommonName, 15*time.Second, 250*time.Millisecond).Should(Equal("renewed-tenant-service"))
+		})
+
+		It("should keep the previous certificate if the new files cannot be loaded", func() {
+			Expect(start()).To(Succeed())
+
+			later := time.Now().Add(time.Minute)
+			writeFile("server.pem", []byte("not a certificate"))
+			os.Chtimes(values["endpoint/tls/certificate-file-path"], later, later)
+
+			Consistently(servedCommonName, 11*time.Second, time.Second).Should(Equal("tenant-service"))
+		})
+	})
+
+	Context("when the caller does not present a client certificate", func() {
+		It("should serve the caller as anonymous, identified by its IP address", func() {
+			Expect(start()).To(Succeed())
+
+			Expect(post(newClient(), nil)).NotTo(Equal(http.StatusTooManyRequests))
+			Expect(post(newClient(), map[string]string{"X-Tenant-ID": "tenant1"})).To(Equal(http.StatusTooManyRequests))
+		})
+	})
+
+	Context("when the caller presents a verified client certificate", func() {
+		It("should identify the caller by the subject of its certificate", func() {
+			Expect(start()).To(Succeed())
+
+			Expect(post(newClient(clientCA.clientCertificate("billing")), nil)).NotTo(Equal(http.StatusTooManyRequests))
+			Expect(post(newClient(clientCA.clientCertificate("portal")), nil)).NotTo(Equal(http.StatusTooManyRequests))
+			Expect(post(newClient(clientCA.clientCertificate("billing")), nil)).To(Equal(http.StatusTooManyRequests))
+		})
+
+		It("should grant the caller the permissions configured for the common name of its certificate", func() {
+			tenantID, _ := system.RandomUUID()
+			delete(values, "endpoint/rate-limit/requests-per-second")
+			values["endpoint/security/principal-permissions"] = "billing=tenant:secret-key:read"
+			mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{SecretKey: "Secret Key"}, nil).Times(2)
+			Expect(start()).To(Succeed())
+
+			_, body, err := get(newClient(clientCA.clientCertificate("billing")), "/tenants/"+tenantID.String())
+			Expect(err).To(BeNil())
+			Expect(body).To(ContainSubstring("Secret Key"))
+
+			_, body, err = get(newClient(clientCA.clientCertificate("portal")), "/tenants/"+tenantID.String())
+			Expect(err).To(BeNil())
+			Expect(body).NotTo(ContainSubstring("Secret Key"))
+		})
+
+		It("should not let the caller pick a fresh rate limit bucket using the tenant and application headers", func() {
+			Expect(start()).To(Succeed())
+			client := newClient(clientCA.clientCertificate("billing"))
+
+			Expect(post(client, map[string]string{"X-Tenant-ID": "tenant1", "X-Application-ID": "application1"})).NotTo(Equal(http.StatusTooManyRequests))
+			Expect(post(client, map[string]string{"X-Tenant-ID": "tenant1", "X-Application-ID": "application2"})).To(Equal(http.StatusTooManyRequests))
+			Expect(post(client, map[string]string{"X-Tenant-ID": "tenant2"})).To(Equal(http.StatusTooManyRequests))
+		})
+
+		It("should apply the rate limit of the tenant bound to the certificate, not the one sent in the headers", func() {
+			values["endpoint/security/principal-tenants"] = "billing=tenant1"
+			values["endpoint/rate-limit/tenants"] = "tenant1=0.001:2, tenant2=0.001:100"
+			Expect(start()).To(Succeed())
+			client := newClient(clientCA.clientCertificate("billing"))
+
+			Expect(post(client, map[string]string{"X-Tenant-ID": "tenant2"})).NotTo(Equal(http.StatusTooManyRequests))
+			Expect(post(client, map[string]string{"X-Tenant-ID": "tenant2"})).NotTo(Equal(http.StatusTooManyRequests))
+			Expect(post(client, map[string]string{"X-Tenant-ID": "tenant2"})).To(Equal(http.StatusTooManyRequests))
+		})
+
+		It("should not drain the bucket of the tenant named in the headers", func() {
+			values["endpoint/security/principal-tenants"] = "billing=tenant1, portal=tenant2"
+			Expect(start()).To(Succeed())
+
+			Expect(post(newClient(clientCA.clientCertificate("billing")), map[string]string{"X-Tenant-ID": "tenant2"})).NotTo(Equal(http.StatusTooManyRequests))
+			Expect(post(newClient(clientCA.clientCertificate("portal")), nil)).NotTo(Equal(http.StatusTooManyRequests))
+		})
+
+		It("should not let the caller subscribe to another tenant by naming it in the connection init payload", func() {
+			ownTenantID, _ := system.RandomUUID()
+			otherTenantID, _ := system.RandomUUID()
+			values["endpoint/security/principal-tenants"] = "billing=" + ownTenantID.String()
+			Expect(start()).To(Succeed())
+
+			answer := subscribe(clientCA.clientCertificate("billing"), map[string]interface{}{"tenantID": otherTenantID.String()}, otherTenantID.String())
+
+			Expect(answer).To(ContainSubstring("Not authorized"))
+		})
+
+		It("should not let a caller bound to no tenant subscribe", func() {
+			tenantID, _ := system.RandomUUID()
+			Expect(start()).To(Succeed())
+
+			answer := subscribe(clientCA.clientCertificate("billing"), map[string]interface{}{"tenantID": tenantID.String()}, tenantID.String())
+
+			Expect(answer).To(ContainSubstring("Not authorized"))
+		})
+	})
+})
+
+func TestTLSConfiguration(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "TLS behaviour")
+}
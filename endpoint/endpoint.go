@@ -2,71 +2,576 @@ package endpoint
 
 import (
 	"encoding/json"
-	"io/ioutil"
-	"log"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
 	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
 	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/graphql-go/graphql"
 	"github.com/micro-business/Micro-Business-Core/common/diagnostics"
 	"github.com/micro-business/TenantService/business/contract"
+	"github.com/micro-business/TenantService/business/event"
 	"github.com/micro-business/TenantService/config"
 	"github.com/micro-business/TenantService/endpoint/graphqlendpoint"
+	"github.com/micro-business/TenantService/endpoint/grpcendpoint"
+	"github.com/micro-business/TenantService/endpoint/health"
+	"github.com/micro-business/TenantService/endpoint/ratelimit"
+	"github.com/micro-business/TenantService/endpoint/restendpoint"
+	"github.com/micro-business/TenantService/endpoint/security"
+	"github.com/micro-business/TenantService/idempotency"
+	"github.com/micro-business/TenantService/logging"
+	"github.com/micro-business/TenantService/metrics"
+	"github.com/micro-business/TenantService/tracing"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 )
 
+const graphqlResponseMediaType = "application/graphql-response+json"
+
+// countRefreshInterval is the interval between two counts of the tenants and applications reported in the metrics.
+const countRefreshInterval = time.Minute
+
+// readinessCheckTimeout is how long a dependency check of the readiness probe can run before the dependency is reported down.
+const readinessCheckTimeout = 5 * time.Second
+
 // Endpoint implements method to start the service. The structure contains all the dependencies required by the Endpoint service.
 type Endpoint struct {
 	ConfigurationReader config.ConfigurationReader
 	TenantService       contract.TenantService
+
+	// Logger is the logger of the service. Every API request is assigned a correlation identifier written in every log
+	// line of the request, see logging.Middleware.
+	Logger log.Logger
+
+	// EventBus is optional. When provided, the GraphQL subscriptions are served over WebSocket on the API path.
+	EventBus *event.Bus
+
+	// Metrics is optional. When provided, the requests are measured and the metrics are exposed on the /metrics path.
+	Metrics *metrics.Metrics
+
+	// Reloader is optional. When provided, the rate limits and the CORS allowed origins are updated every time the
+	// configuration is reloaded.
+	Reloader *config.Reloader
+
+	server          *http.Server
+	grpcServer      *grpc.Server
+	stopping        chan struct{}
+	serveErrors     chan error
+	shutdownTimeout time.Duration
 }
 
-// StartServer creates all the endpoints and starts the server.
-func (endpoint Endpoint) StartServer() {
+// Start creates all the endpoints and starts serving them in the background, until Shutdown is called.
+// Returns error if the endpoints cannot be created or the listening ports cannot be bound.
+func (endpoint *Endpoint) Start() error {
 	diagnostics.IsNotNil(endpoint.TenantService, "endpoint.TenantService", "TenantService must be provided.")
 	diagnostics.IsNotNil(endpoint.ConfigurationReader, "endpoint.ConfigurationReader", "ConfigurationReader must be provided.")
+	diagnostics.IsNotNil(endpoint.Logger, "endpoint.Logger", "Logger must be provided.")
+
+	endpoint.stopping = make(chan struct{})
+	endpoint.serveErrors = make(chan error, 2)
+
+	rateLimiter, err := createRateLimiter(endpoint.ConfigurationReader)
+
+	if err != nil {
+		return err
+	}
+
+	corsAllowedOrigins, err := endpoint.ConfigurationReader.GetCORSAllowedOrigins()
+
+	if err != nil {
+		return err
+	}
+
+	cors := newCORSPolicy(corsAllowedOrigins)
+
+	if endpoint.Reloader != nil {
+		endpoint.Reloader.OnReload(func(settings config.LiveSettings) {
+			rateLimiter.SetLimits(ratelimit.Limit(settings.DefaultRateLimit), toTenantLimits(settings.TenantRateLimits))
+			cors.setAllowedOrigins(settings.CORSAllowedOrigins)
+		})
+	}
+
+	principalPermissions, err := endpoint.ConfigurationReader.GetPrincipalPermissions()
+
+	if err != nil {
+		return err
+	}
+
+	principalTenants, err := endpoint.ConfigurationReader.GetPrincipalTenants()
+
+	if err != nil {
+		return err
+	}
 
-	http.Handle("/Api", httptransport.NewServer(
-		createAPIEndpoint(endpoint.TenantService),
-		decodeAPIRequest,
-		encodeAPIResponse))
+	tenantBindings := toTenantBindings(principalTenants)
 
-	if listeningPort, err := endpoint.ConfigurationReader.GetListeningPort(); err != nil {
-		log.Fatal(err.Error())
+	queryOptions, err := createQueryOptions(endpoint.ConfigurationReader)
+
+	if err != nil {
+		return err
+	}
+
+	if endpoint.Metrics != nil {
+		queryOptions.Observer = endpoint.Metrics
+	}
+
+	maxRequestBodySize, err := endpoint.ConfigurationReader.GetMaxRequestBodySize()
+
+	if err != nil {
+		return err
+	}
+
+	var apiHandler http.Handler = httptransport.NewServer(
+		createAPIEndpoint(endpoint.TenantService, queryOptions),
+		createAPIRequestDecoder(int64(maxRequestBodySize)),
+		encodeAPIResponse,
+		httptransport.ServerBefore(httptransport.PopulateRequestContext, idempotency.PopulateRequestContext),
+		httptransport.ServerErrorEncoder(encodeAPIError))
+
+	if endpoint.EventBus != nil {
+		subscriptionHandler, err := endpoint.createSubscriptionHandler(queryOptions, int64(maxRequestBodySize))
+
+		if err != nil {
+			return err
+		}
+
+		apiHandler = withSubscriptions(apiHandler, subscriptionHandler)
+	}
+
+	graphiQLEnabled, err := endpoint.ConfigurationReader.GetGraphiQLEnabled()
+
+	if err != nil {
+		return err
+	}
+
+	var graphiQLAssetHandler http.Handler
+
+	if graphiQLEnabled {
+		graphiQLAssetsDirectory, err := endpoint.ConfigurationReader.GetGraphiQLAssetsDirectory()
+
+		if err != nil {
+			return err
+		}
+
+		if graphiQLAssetHandler, err = newGraphiQLAssetHandler(graphiQLAssetsDirectory); err != nil {
+			return err
+		}
+
+		apiHandler = withGraphiQL(apiHandler)
+	}
+
+	mux := http.NewServeMux()
+
+	if graphiQLAssetHandler != nil {
+		mux.Handle(graphiQLAssetsPath, graphiQLAssetHandler)
+	}
+
+	mux.Handle("/Api", tracing.Middleware("/Api", logging.Middleware(endpoint.Logger, endpoint.withMetrics("graphql", withCORS(withClientCertificatePrincipal(rateLimiter.Middleware(apiHandler), principalPermissions, tenantBindings), cors)))))
+
+	restHandler := tracing.Middleware("/tenants", logging.Middleware(endpoint.Logger, endpoint.withMetrics("rest", withClientCertificatePrincipal(
+		rateLimiter.Middleware(restendpoint.NewHandler(endpoint.TenantService, restendpoint.Options{MaxRequestBodySize: int64(maxRequestBodySize)})),
+		principalPermissions,
+		tenantBindings))))
+
+	mux.Handle("/tenants", restHandler)
+	mux.Handle("/tenants/", restHandler)
+	mux.Handle(restendpoint.OpenAPIDocumentPath, restHandler)
+
+	mux.Handle("/healthz", health.NewLivenessHandler())
+	mux.Handle("/readyz", health.NewReadinessHandler([]health.Check{
+		{Name: "cassandra", Check: func(ctx context.Context) error { return endpoint.TenantService.WithContext(ctx).CheckHealth() }},
+		{Name: "consul", Check: endpoint.ConfigurationReader.CheckHealth},
+	}, readinessCheckTimeout, endpoint.Logger))
+
+	if endpoint.Metrics != nil {
+		go endpoint.Metrics.RefreshCounts(endpoint.TenantService.CountTenantsAndApplications, countRefreshInterval, endpoint.Logger, endpoint.stopping)
+		mux.Handle("/metrics", endpoint.Metrics.Handler())
+	}
+
+	listeningPort, err := endpoint.ConfigurationReader.GetListeningPort()
+
+	if err != nil {
+		return err
+	}
+
+	grpcListeningPort, err := endpoint.ConfigurationReader.GetGRPCListeningPort()
+
+	if err != nil {
+		return err
+	}
+
+	timeouts, err := endpoint.ConfigurationReader.GetServerTimeouts()
+
+	if err != nil {
+		return err
+	}
+
+	endpoint.shutdownTimeout = time.Duration(timeouts.ShutdownTimeout) * time.Second
+
+	tlsConfig, err := createTLSConfig(endpoint.ConfigurationReader, endpoint.Logger, endpoint.stopping)
+
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", ":"+strconv.Itoa(listeningPort))
+
+	if err != nil {
+		close(endpoint.stopping)
+
+		return err
+	}
+
+	endpoint.server = &http.Server{
+		Handler:      mux,
+		TLSConfig:    tlsConfig,
+		ReadTimeout:  time.Duration(timeouts.ReadTimeout) * time.Second,
+		WriteTimeout: time.Duration(timeouts.WriteTimeout) * time.Second,
+		IdleTimeout:  time.Duration(timeouts.IdleTimeout) * time.Second,
+	}
+
+	if grpcListeningPort != 0 {
+		grpcListener, err := net.Listen("tcp", ":"+strconv.Itoa(grpcListeningPort))
+
+		if err != nil {
+			listener.Close()
+			close(endpoint.stopping)
+
+			return err
+		}
+
+		endpoint.grpcServer = grpcendpoint.NewServer(endpoint.TenantService, grpcendpoint.Options{
+			TLSConfig:            tlsConfig,
+			PrincipalPermissions: principalPermissions,
+			PrincipalTenants:     tenantBindings,
+			RateLimiter:          rateLimiter,
+			Logger:               endpoint.Logger,
+		})
+
+		go endpoint.serve(func() error { return endpoint.grpcServer.Serve(grpcListener) })
+	}
+
+	if tlsConfig == nil {
+		go endpoint.serve(func() error { return endpoint.server.Serve(listener) })
 	} else {
-		log.Fatal(http.ListenAndServe(":"+strconv.Itoa(listeningPort), nil))
+		go endpoint.serve(func() error { return endpoint.server.ServeTLS(listener, "", "") })
 	}
+
+	level.Info(endpoint.Logger).Log("msg", "Server started.", "port", listeningPort, "grpc_port", grpcListeningPort)
+
+	return nil
 }
 
-func createAPIEndpoint(tenantService contract.TenantService) endpoint.Endpoint {
-	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		return graphqlendpoint.ExecuteQuery(request.(string), tenantService)
+// Shutdown stops the server gracefully. The listening ports are closed and the in-flight HTTP and gRPC requests are
+// given until the provided context is done to complete. The GraphQL subscriptions are ended with a going away close
+// frame and the certificate files are no longer watched.
+// ctx: Mandatory. Bounds how long the in-flight requests can take to complete.
+// Must be called once, after Start succeeded.
+// Returns error if the in-flight requests did not complete before the context is done, they are aborted then.
+func (endpoint *Endpoint) Shutdown(ctx context.Context) error {
+	close(endpoint.stopping)
+
+	grpcStopped := make(chan struct{})
+
+	go func() {
+		if endpoint.grpcServer != nil {
+			endpoint.grpcServer.GracefulStop()
+		}
+
+		close(grpcStopped)
+	}()
+
+	err := endpoint.server.Shutdown(ctx)
+
+	if err != nil {
+		endpoint.server.Close()
+	}
+
+	if endpoint.grpcServer == nil {
+		return err
 	}
+
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		endpoint.grpcServer.Stop()
+		<-grpcStopped
+
+		err = ctx.Err()
+	}
+
+	return err
 }
 
-/// decodeAPIRequest decodes the request message sent by the client. The request message can be sent using GET method as part of
-// URL or can be the payload of a POST HTTP message.
-func decodeAPIRequest(context context.Context, httpRequest *http.Request) (interface{}, error) {
-	if httpRequest.Method == "GET" {
-		return httpRequest.URL.Query()["query"][0], nil
+// StartServer starts the server, see Start, and serves the requests until the process receives SIGTERM or SIGINT. The
+// server is then shut down, see Shutdown, giving the in-flight requests the configured shutdown timeout to complete.
+// Exits if the server cannot be started or stops serving unexpectedly.
+func (endpoint *Endpoint) StartServer() {
+	if err := endpoint.Start(); err != nil {
+		endpoint.fatal(err)
 	}
 
-	query, err := ioutil.ReadAll(httpRequest.Body)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+	defer signal.Stop(signals)
+
+	select {
+	case err := <-endpoint.serveErrors:
+		endpoint.fatal(err)
+
+	case receivedSignal := <-signals:
+		level.Info(endpoint.Logger).Log("msg", "Shutting down.", "signal", receivedSignal.String(), "timeout", endpoint.shutdownTimeout.String())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), endpoint.shutdownTimeout)
+	defer cancel()
+
+	if err := endpoint.Shutdown(ctx); err != nil {
+		level.Warn(endpoint.Logger).Log("msg", "In-flight requests aborted, they did not complete before the shutdown timeout.", "err", err)
+	}
+
+	level.Info(endpoint.Logger).Log("msg", "Server stopped.")
+}
+
+// serve runs the provided serve function and reports the error it returns, unless the server is stopped by Shutdown.
+func (endpoint *Endpoint) serve(serve func() error) {
+	if err := serve(); err != nil && err != http.ErrServerClosed {
+		endpoint.serveErrors <- err
+	}
+}
+
+// fatal logs the provided error that prevents the server from serving and exits.
+func (endpoint *Endpoint) fatal(err error) {
+	level.Error(endpoint.Logger).Log("msg", "Server stopped.", "err", err)
+	os.Exit(1)
+}
+
+// withMetrics returns the provided handler measured under the provided handler name if metrics are provided, otherwise the provided handler.
+func (endpoint *Endpoint) withMetrics(handlerName string, next http.Handler) http.Handler {
+	if endpoint.Metrics == nil {
+		return next
+	}
+
+	return endpoint.Metrics.Middleware(handlerName, next)
+}
+
+// createRateLimiter creates the rate limiter of the API using the limits provided by the configuration reader.
+func createRateLimiter(configurationReader config.ConfigurationReader) (*ratelimit.Limiter, error) {
+	defaultRateLimit, err := configurationReader.GetDefaultRateLimit()
+
+	if err != nil {
+		return nil, err
+	}
+
+	tenantRateLimits, err := configurationReader.GetTenantRateLimits()
+
+	if err != nil {
+		return nil, err
+	}
+
+	trustedProxies, err := configurationReader.GetRateLimitTrustedProxies()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ratelimit.Limiter{
+		Store:          ratelimit.NewMemoryStore(),
+		DefaultLimit:   ratelimit.Limit(defaultRateLimit),
+		TenantLimits:   toTenantLimits(tenantRateLimits),
+		TrustedProxies: trustedProxies,
+	}, nil
+}
+
+// toTenantLimits converts the configured tenant rate limits to the limits of the rate limiter.
+func toTenantLimits(tenantRateLimits map[string]config.RateLimit) map[string]ratelimit.Limit {
+	tenantLimits := make(map[string]ratelimit.Limit)
+
+	for tenantID, tenantRateLimit := range tenantRateLimits {
+		tenantLimits[tenantID] = ratelimit.Limit(tenantRateLimit)
+	}
+
+	return tenantLimits
+}
+
+// toTenantBindings converts the configured principal tenants to the tenant bindings of the authenticated principals.
+func toTenantBindings(principalTenants map[string]config.PrincipalTenant) map[string]security.TenantBinding {
+	tenantBindings := make(map[string]security.TenantBinding)
+
+	for principalName, principalTenant := range principalTenants {
+		tenantBindings[principalName] = security.TenantBinding(principalTenant)
+	}
+
+	return tenantBindings
+}
+
+// createQueryOptions creates the options used to execute the GraphQL queries using the configuration reader.
+func createQueryOptions(configurationReader config.ConfigurationReader) (graphqlendpoint.Options, error) {
+	sensitiveFieldPolicy, err := configurationReader.GetSensitiveFieldPolicy()
+
+	if err != nil {
+		return graphqlendpoint.Options{}, err
+	}
+
+	maxQueryDepth, err := configurationReader.GetMaxQueryDepth()
+
+	if err != nil {
+		return graphqlendpoint.Options{}, err
+	}
+
+	maxQueryComplexity, err := configurationReader.GetMaxQueryComplexity()
+
+	if err != nil {
+		return graphqlendpoint.Options{}, err
+	}
+
+	subscriptionBufferSize, err := configurationReader.GetSubscriptionBufferSize()
+
+	if err != nil {
+		return graphqlendpoint.Options{}, err
+	}
+
+	introspectionEnabled, err := configurationReader.GetIntrospectionEnabled()
+
+	if err != nil {
+		return graphqlendpoint.Options{}, err
+	}
+
+	persistedQueries, err := createPersistedQueryStore(configurationReader)
 
 	if err != nil {
+		return graphqlendpoint.Options{}, err
+	}
+
+	options := graphqlendpoint.Options{
+		SensitiveFieldPolicy:   graphqlendpoint.MaskSensitiveField,
+		MaxQueryDepth:          maxQueryDepth,
+		MaxQueryComplexity:     maxQueryComplexity,
+		SubscriptionBufferSize: subscriptionBufferSize,
+		IntrospectionDisabled:  !introspectionEnabled,
+		PersistedQueries:       persistedQueries,
+	}
+
+	if sensitiveFieldPolicy == "error" {
+		options.SensitiveFieldPolicy = graphqlendpoint.RejectSensitiveField
+	}
+
+	return options, nil
+}
+
+// createPersistedQueryStore creates the store of the persisted queries using the configuration reader. Returns nil if
+// persisted queries are disabled.
+func createPersistedQueryStore(configurationReader config.ConfigurationReader) (*graphqlendpoint.PersistedQueryStore, error) {
+	persistedQueries, err := configurationReader.GetPersistedQueries()
+
+	if err != nil || persistedQueries.Mode == "disabled" {
 		return nil, err
 	}
 
-	return string(query), nil
+	return graphqlendpoint.NewPersistedQueryStore(persistedQueries.Manifest, persistedQueries.Mode == "strict", persistedQueries.CacheSize), nil
+}
+
+// createSubscriptionHandler creates the handler serving the GraphQL subscriptions over WebSocket using the configuration reader.
+func (endpoint *Endpoint) createSubscriptionHandler(queryOptions graphqlendpoint.Options, maxMessageSize int64) (subscriptionHandler, error) {
+	keepAliveInterval, err := endpoint.ConfigurationReader.GetSubscriptionKeepAliveInterval()
+
+	if err != nil {
+		return subscriptionHandler{}, err
+	}
+
+	connectionInitTimeout, err := endpoint.ConfigurationReader.GetSubscriptionConnectionInitTimeout()
+
+	if err != nil {
+		return subscriptionHandler{}, err
+	}
+
+	anonymousAccessAllowed, err := endpoint.ConfigurationReader.GetSubscriptionAnonymousAccessAllowed()
+
+	if err != nil {
+		return subscriptionHandler{}, err
+	}
+
+	return subscriptionHandler{
+		tenantService:          endpoint.TenantService,
+		eventBus:               endpoint.EventBus,
+		queryOptions:           queryOptions,
+		maxMessageSize:         maxMessageSize,
+		keepAliveInterval:      time.Duration(keepAliveInterval) * time.Second,
+		connectionInitTimeout:  time.Duration(connectionInitTimeout) * time.Second,
+		anonymousAccessAllowed: anonymousAccessAllowed,
+		stopping:               endpoint.stopping,
+	}, nil
+}
+
+func createAPIEndpoint(tenantService contract.TenantService, queryOptions graphqlendpoint.Options) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return graphqlendpoint.ExecuteRequest(ctx, request.(graphqlendpoint.Request), tenantService, queryOptions), nil
+	}
 }
 
-// encodeAPIResponse encodes the response message before sending back to the client
-func encodeAPIResponse(context context.Context, writer http.ResponseWriter, response interface{}) error {
-	writer.Header().Set("Content-Type", "application/json; charset=utf-8")
-	writer.Header().Set("Access-Control-Allow-Origin", "*")
-	writer.Header().Set("Access-Control-Allow-Methods", "POST")
-	writer.Header().Set("Access-Control-Allow-Headers", "Origin, Content-Type")
+// encodeAPIResponse encodes the GraphQL response before sending back to the client. The status code follows GraphQL over
+// HTTP conventions: clients accepting application/graphql-response+json get 400 Bad Request for the requests that failed
+// before the execution started, other clients get 200 OK for every well-formed request.
+func encodeAPIResponse(ctx context.Context, writer http.ResponseWriter, response interface{}) error {
+	result := response.(*graphql.Result)
+	mediaType := negotiateResponseMediaType(ctx)
+
+	setAPIResponseHeaders(writer, mediaType)
+
+	if !graphqlendpoint.IsRequestError(result) {
+		return json.NewEncoder(writer).Encode(result)
+	}
+
+	if graphqlendpoint.HasErrorCode(result, graphqlendpoint.MutationNotAllowedErrorCode) {
+		writer.Header().Set("Allow", http.MethodPost)
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+	} else if mediaType == graphqlResponseMediaType {
+		writer.WriteHeader(http.StatusBadRequest)
+	}
+
+	// The data entry must not be present in the response if the execution did not start.
+	return json.NewEncoder(writer).Encode(map[string]interface{}{
+		"errors":     result.Errors,
+		"extensions": result.Extensions,
+	})
+}
+
+// encodeAPIError encodes the errors that prevented the request from being executed, e.g. malformed requests, as a GraphQL
+// response carrying only the errors.
+func encodeAPIError(ctx context.Context, err error, writer http.ResponseWriter) {
+	statusCode := http.StatusInternalServerError
+
+	if statusCoder, ok := err.(httptransport.StatusCoder); ok {
+		statusCode = statusCoder.StatusCode()
+	}
+
+	setAPIResponseHeaders(writer, negotiateResponseMediaType(ctx))
+	writer.WriteHeader(statusCode)
+
+	json.NewEncoder(writer).Encode(map[string]interface{}{
+		"errors": []map[string]interface{}{{"message": err.Error()}},
+	})
+}
+
+// negotiateResponseMediaType returns application/graphql-response+json if the client accepts it, otherwise application/json.
+func negotiateResponseMediaType(ctx context.Context) string {
+	accept, _ := ctx.Value(httptransport.ContextKeyRequestAccept).(string)
+
+	if strings.Contains(accept, graphqlResponseMediaType) {
+		return graphqlResponseMediaType
+	}
+
+	return "application/json"
+}
 
-	return json.NewEncoder(writer).Encode(response)
+func setAPIResponseHeaders(writer http.ResponseWriter, mediaType string) {
+	writer.Header().Set("Content-Type", mediaType+"; charset=utf-8")
 }
@@ -0,0 +1,77 @@
+package graphqlendpoint
+
+import (
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/TenantService/business/event"
+)
+
+type applicationChangedEvent struct {
+	Type          string       `json:"type"`
+	TenantID      string       `json:"tenantID"`
+	ApplicationID string       `json:"applicationID"`
+	Application   *application `json:"-"`
+}
+
+var applicationChangedEventType = graphql.NewObject(
+	graphql.ObjectConfig{
+		Name: "ApplicationChangedEvent",
+		Fields: graphql.Fields{
+			"type":          &graphql.Field{Type: graphql.NewNonNull(changeTypeEnum)},
+			"tenantID":      &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"applicationID": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"application": &graphql.Field{
+				Type:        applicationType,
+				Description: "The application after the change, null if the application is deleted",
+				Resolve: func(resolveParams graphql.ResolveParams) (interface{}, error) {
+					if changedApplication := resolveParams.Source.(applicationChangedEvent).Application; changedApplication != nil {
+						return *changedApplication, nil
+					}
+
+					return nil, nil
+				},
+			},
+		},
+	},
+)
+
+func getApplicationChangedSubscription() *graphql.Field {
+	return &graphql.Field{
+		Type:        applicationChangedEventType,
+		Description: "Notifies every change made to the applications of the provided tenant",
+		Args: graphql.FieldConfigArgument{
+			"tenantID": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.String),
+			},
+		},
+
+		Subscribe: func(resolveParams graphql.ResolveParams) (interface{}, error) {
+			return subscribeToTenantEvents(resolveParams, func(changeEvent event.Event) bool {
+				return changeEvent.IsApplicationEvent()
+			})
+		},
+
+		Resolve: func(resolveParams graphql.ResolveParams) (interface{}, error) {
+			changeEvent, err := resolveSubscriptionPayload(resolveParams)
+
+			if err != nil {
+				return nil, err
+			}
+
+			changed := applicationChangedEvent{
+				Type:          changeTypes[changeEvent.Type],
+				TenantID:      changeEvent.TenantID.String(),
+				ApplicationID: changeEvent.ApplicationID.String(),
+			}
+
+			if changeEvent.Type != event.ApplicationDeleted {
+				changed.Application = &application{
+					ID:       changeEvent.ApplicationID.String(),
+					Name:     changeEvent.Application.Name,
+					TenantID: changeEvent.TenantID.String(),
+				}
+			}
+
+			return changed, nil
+		},
+	}
+}
@@ -11,14 +11,17 @@ const (
 )
 
 type application struct {
-	ID   string `json:"ID"`
-	Name string `json:"Name"`
+	ID       string `json:"ID"`
+	Name     string `json:"Name"`
+	TenantID string `json:"-"`
 }
 
 var applicationType = graphql.NewObject(
 	graphql.ObjectConfig{
-		Name: "Application",
+		Name:       applicationNodeTypeName,
+		Interfaces: []*graphql.Interface{nodeInterface},
 		Fields: graphql.Fields{
+			nodeID:        getGlobalIDField(),
 			applicationID: &graphql.Field{Type: graphql.String},
 			name:          &graphql.Field{Type: graphql.String},
 		},
@@ -27,7 +30,7 @@ var applicationType = graphql.NewObject(
 
 var inputApplicationType = graphql.NewInputObject(
 	graphql.InputObjectConfig{
-		Name: "Application",
+		Name: "ApplicationInput",
 		Fields: graphql.InputObjectConfigFieldMap{
 			name: &graphql.InputObjectFieldConfig{Type: graphql.String},
 		},
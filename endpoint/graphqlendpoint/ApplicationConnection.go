@@ -0,0 +1,133 @@
+package graphqlendpoint
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+)
+
+type pageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor"`
+}
+
+type applicationEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   application `json:"node"`
+}
+
+type applicationConnection struct {
+	Edges      []applicationEdge `json:"edges"`
+	PageInfo   pageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
+}
+
+var pageInfoType = graphql.NewObject(
+	graphql.ObjectConfig{
+		Name: "PageInfo",
+		Fields: graphql.Fields{
+			"hasNextPage": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"endCursor":   &graphql.Field{Type: graphql.String},
+		},
+	},
+)
+
+var applicationEdgeType = graphql.NewObject(
+	graphql.ObjectConfig{
+		Name: "ApplicationEdge",
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"node":   &graphql.Field{Type: applicationType},
+		},
+	},
+)
+
+var applicationConnectionType = graphql.NewObject(
+	graphql.ObjectConfig{
+		Name: "ApplicationConnection",
+		Fields: graphql.Fields{
+			"edges":      &graphql.Field{Type: graphql.NewList(applicationEdgeType)},
+			"pageInfo":   &graphql.Field{Type: graphql.NewNonNull(pageInfoType)},
+			"totalCount": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	},
+)
+
+// newApplicationConnection creates a page of the provided applications. Applications are ordered by their unique identifier
+// so the cursors remain stable between requests.
+// tenantID: Mandatory. The unique identifier of the tenant that owns the applications.
+// applications: Mandatory. The applications of the tenant.
+// first: Mandatory. The maximum number of applications to return. Negative means all the remaining applications.
+// after: Optional. The cursor of the application after which the page starts.
+// Returns the page of applications or error if the cursor is invalid.
+func newApplicationConnection(tenantID system.UUID, applications map[system.UUID]domain.Application, first int, after string) (applicationConnection, error) {
+	applicationIDs := make([]string, 0, len(applications))
+	applicationsByID := make(map[string]domain.Application, len(applications))
+
+	for applicationID, app := range applications {
+		applicationIDs = append(applicationIDs, applicationID.String())
+		applicationsByID[applicationID.String()] = app
+	}
+
+	sort.Strings(applicationIDs)
+
+	start := 0
+
+	if len(after) != 0 {
+		afterApplicationID, err := decodeApplicationCursor(after)
+
+		if err != nil {
+			return applicationConnection{}, err
+		}
+
+		start = sort.SearchStrings(applicationIDs, afterApplicationID)
+
+		if start < len(applicationIDs) && applicationIDs[start] == afterApplicationID {
+			start++
+		}
+	}
+
+	end := len(applicationIDs)
+
+	if first >= 0 && start+first < end {
+		end = start + first
+	}
+
+	connection := applicationConnection{
+		Edges:      make([]applicationEdge, 0, end-start),
+		PageInfo:   pageInfo{HasNextPage: end < len(applicationIDs)},
+		TotalCount: len(applicationIDs),
+	}
+
+	for _, applicationID := range applicationIDs[start:end] {
+		connection.Edges = append(connection.Edges, applicationEdge{
+			Cursor: encodeApplicationCursor(applicationID),
+			Node:   application{ID: applicationID, Name: applicationsByID[applicationID].Name, TenantID: tenantID.String()},
+		})
+	}
+
+	if len(connection.Edges) != 0 {
+		endCursor := connection.Edges[len(connection.Edges)-1].Cursor
+		connection.PageInfo.EndCursor = &endCursor
+	}
+
+	return connection, nil
+}
+
+func encodeApplicationCursor(applicationID string) string {
+	return base64.StdEncoding.EncodeToString([]byte("Application:" + applicationID))
+}
+
+func decodeApplicationCursor(cursor string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(cursor)
+
+	if err != nil || len(decoded) <= len("Application:") || string(decoded[:len("Application:")]) != "Application:" {
+		return "", fmt.Errorf("Invalid cursor. Cursor: %s", cursor)
+	}
+
+	return string(decoded[len("Application:"):]), nil
+}
@@ -24,6 +24,7 @@ var _ = Describe("ApplicationQuery method input parameters and dependency test",
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
 
 		tenantID, _ = system.RandomUUID()
 		applicationID, _ = system.RandomUUID()
@@ -79,6 +80,7 @@ var _ = Describe("ApplicationQuery method behaviour", func() {
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
 
 		tenantID, _ = system.RandomUUID()
 		applicationID, _ = system.RandomUUID()
@@ -0,0 +1,35 @@
+package graphqlendpoint
+
+import (
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/Micro-Business-Core/system"
+)
+
+func getApplicationTenantField() *graphql.Field {
+	return &graphql.Field{
+		Type:        tenantType,
+		Description: "Returns the tenant that owns the application",
+
+		Resolve: func(resolveParams graphql.ResolveParams) (interface{}, error) {
+			source, _ := resolveParams.Source.(application)
+
+			tenantID, err := system.ParseUUID(source.TenantID)
+
+			if err != nil {
+				return nil, err
+			}
+
+			loadTenant := requestLoadersOf(resolveParams).loadTenant(tenantID)
+
+			return func() (interface{}, error) {
+				returnedTenant, err := loadTenant()
+
+				if err != nil {
+					return nil, err
+				}
+
+				return tenant{ID: tenantID.String(), SecretKey: returnedTenant.SecretKey}, nil
+			}, nil
+		},
+	}
+}
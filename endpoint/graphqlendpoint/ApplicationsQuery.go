@@ -36,7 +36,7 @@ func getApplicationsQuery() *graphql.Field {
 			applications := make([]application, 0, len(returnedApplications))
 
 			for applicationID, app := range returnedApplications {
-				applications = append(applications, application{ID: applicationID.String(), Name: app.Name})
+				applications = append(applications, application{ID: applicationID.String(), Name: app.Name, TenantID: tenantID.String()})
 			}
 
 			return applications, nil
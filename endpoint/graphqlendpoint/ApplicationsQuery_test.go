@@ -28,6 +28,7 @@ var _ = Describe("ApplicationsQuery method input parameters and dependency test"
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
 	})
 
 	AfterEach(func() {
@@ -63,6 +64,7 @@ var _ = Describe("ApplicationsQuery method behaviour", func() {
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
 
 		tenantID, _ = system.RandomUUID()
 	})
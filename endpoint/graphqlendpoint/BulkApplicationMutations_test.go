@@ -0,0 +1,167 @@
+package graphqlendpoint_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/endpoint/graphqlendpoint"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("Bulk application mutations behaviour", func() {
+	var (
+		mockCtrl            *gomock.Controller
+		mockTenantService   *MockTenantService
+		tenantID            system.UUID
+		firstApplicationID  system.UUID
+		secondApplicationID system.UUID
+	)
+
+	execute := func(query string) (map[string]interface{}, []string) {
+		result := graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query}, mockTenantService, graphqlendpoint.Options{})
+		data, _ := result.Data.(map[string]interface{})
+		messages := []string{}
+
+		for _, err := range result.Errors {
+			messages = append(messages, err.Message)
+		}
+
+		return data, messages
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+
+		tenantID, _ = system.RandomUUID()
+		firstApplicationID, _ = system.RandomUUID()
+		secondApplicationID, _ = system.RandomUUID()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Describe("createApplications", func() {
+		It("should return the result of every application in the provided order", func() {
+			mockTenantService.EXPECT().CreateApplications(tenantID, []domain.Application{{Name: "First"}, {Name: "Second"}}, false).Return(
+				[]domain.ApplicationResult{{ApplicationID: firstApplicationID}, {Err: errors.New("Failed")}}, nil)
+
+			data, errs := execute("mutation {createApplications(tenantID: \"" + tenantID.String() + "\", applications: [{Name: \"First\"}, {Name: \"Second\"}]) {applicationID succeeded error}}")
+
+			Expect(errs).To(BeEmpty())
+			Expect(data["createApplications"]).To(Equal([]interface{}{
+				map[string]interface{}{"applicationID": firstApplicationID.String(), "succeeded": true, "error": nil},
+				map[string]interface{}{"applicationID": nil, "succeeded": false, "error": "Failed"},
+			}))
+		})
+
+		It("should report the valid applications as not applied if any application fails and allOrNothing is set", func() {
+			mockTenantService.EXPECT().CreateApplications(tenantID, gomock.Any(), true).Return(
+				[]domain.ApplicationResult{{}, {Err: errors.New("Failed")}}, nil)
+
+			data, errs := execute("mutation {createApplications(tenantID: \"" + tenantID.String() + "\", applications: [{Name: \"First\"}, {Name: \" \"}], allOrNothing: true) {succeeded error}}")
+
+			Expect(errs).To(BeEmpty())
+			Expect(data["createApplications"]).To(Equal([]interface{}{
+				map[string]interface{}{"succeeded": false, "error": "Not applied as another application of the request failed."},
+				map[string]interface{}{"succeeded": false, "error": "Failed"},
+			}))
+		})
+
+		It("should return error if too many applications are provided", func() {
+			applications := make([]string, 101)
+
+			for index := range applications {
+				applications[index] = fmt.Sprintf("{Name: \"Application %d\"}", index)
+			}
+
+			data, errs := execute("mutation {createApplications(tenantID: \"" + tenantID.String() + "\", applications: [" + strings.Join(applications, ",") + "]) {succeeded}}")
+
+			Expect(data).To(BeNil())
+			Expect(errs).To(ConsistOf(ContainSubstring("At most 100 applications")))
+		})
+
+		It("should return error if tenant service CreateApplications function returns error", func() {
+			mockTenantService.EXPECT().CreateApplications(tenantID, gomock.Any(), false).Return(nil, errors.New("Unavailable"))
+
+			_, errs := execute("mutation {createApplications(tenantID: \"" + tenantID.String() + "\", applications: [{Name: \"First\"}]) {succeeded}}")
+
+			Expect(errs).To(ConsistOf("Unavailable"))
+		})
+	})
+
+	Describe("updateApplications", func() {
+		It("should report invalid and duplicate application identifiers without sending them to tenant service", func() {
+			mockTenantService.EXPECT().UpdateApplications(tenantID, map[system.UUID]domain.Application{firstApplicationID: {Name: "First"}}, false).Return(map[system.UUID]error{}, nil)
+
+			data, errs := execute("mutation {updateApplications(tenantID: \"" + tenantID.String() + "\", applications: [" +
+				"{applicationID: \"" + firstApplicationID.String() + "\", application: {Name: \"First\"}}," +
+				"{applicationID: \"Invalid UUID\", application: {Name: \"Second\"}}," +
+				"{applicationID: \"" + firstApplicationID.String() + "\", application: {Name: \"Third\"}}]) {applicationID succeeded}}")
+
+			Expect(errs).To(BeEmpty())
+			Expect(data["updateApplications"]).To(Equal([]interface{}{
+				map[string]interface{}{"applicationID": firstApplicationID.String(), "succeeded": true},
+				map[string]interface{}{"applicationID": nil, "succeeded": false},
+				map[string]interface{}{"applicationID": nil, "succeeded": false},
+			}))
+		})
+
+		It("should not call tenant service if any application identifier is invalid and allOrNothing is set", func() {
+			data, errs := execute("mutation {updateApplications(tenantID: \"" + tenantID.String() + "\", allOrNothing: true, applications: [" +
+				"{applicationID: \"" + firstApplicationID.String() + "\", application: {Name: \"First\"}}," +
+				"{applicationID: \"Invalid UUID\", application: {Name: \"Second\"}}]) {succeeded error}}")
+
+			Expect(errs).To(BeEmpty())
+			Expect(data["updateApplications"]).To(HaveLen(2))
+			Expect(data["updateApplications"].([]interface{})[0]).To(Equal(map[string]interface{}{
+				"succeeded": false,
+				"error":     "Not applied as another application of the request failed.",
+			}))
+		})
+	})
+
+	Describe("deleteApplications", func() {
+		It("should report the applications tenant service failed to delete", func() {
+			mockTenantService.EXPECT().DeleteApplications(tenantID, []system.UUID{firstApplicationID, secondApplicationID}, false).Return(
+				map[system.UUID]error{secondApplicationID: errors.New("Not found")}, nil)
+
+			data, errs := execute("mutation {deleteApplications(tenantID: \"" + tenantID.String() + "\", applicationIDs: [\"" +
+				firstApplicationID.String() + "\", \"" + secondApplicationID.String() + "\"]) {applicationID succeeded error}}")
+
+			Expect(errs).To(BeEmpty())
+			Expect(data["deleteApplications"]).To(Equal([]interface{}{
+				map[string]interface{}{"applicationID": firstApplicationID.String(), "succeeded": true, "error": nil},
+				map[string]interface{}{"applicationID": nil, "succeeded": false, "error": "Not found"},
+			}))
+		})
+
+		It("should report every application as not applied if tenant service fails any application and allOrNothing is set", func() {
+			mockTenantService.EXPECT().DeleteApplications(tenantID, []system.UUID{firstApplicationID, secondApplicationID}, true).Return(
+				map[system.UUID]error{secondApplicationID: errors.New("Not found")}, nil)
+
+			data, errs := execute("mutation {deleteApplications(tenantID: \"" + tenantID.String() + "\", allOrNothing: true, applicationIDs: [\"" +
+				firstApplicationID.String() + "\", \"" + secondApplicationID.String() + "\"]) {succeeded error}}")
+
+			Expect(errs).To(BeEmpty())
+			Expect(data["deleteApplications"]).To(Equal([]interface{}{
+				map[string]interface{}{"succeeded": false, "error": "Not applied as another application of the request failed."},
+				map[string]interface{}{"succeeded": false, "error": "Not found"},
+			}))
+		})
+	})
+})
+
+func TestBulkApplicationMutations(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Bulk application mutations behaviour")
+}
@@ -0,0 +1,85 @@
+package graphqlendpoint
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/TenantService/business/contract"
+)
+
+type bulkApplicationResult struct {
+	ApplicationID *string `json:"applicationID"`
+	Succeeded     bool    `json:"succeeded"`
+	Error         *string `json:"error"`
+}
+
+var bulkApplicationResultType = graphql.NewObject(
+	graphql.ObjectConfig{
+		Name:        "BulkApplicationResult",
+		Description: "The outcome of a single application of a bulk mutation, in the order of the provided applications",
+		Fields: graphql.Fields{
+			"applicationID": &graphql.Field{Type: graphql.ID},
+			"succeeded":     &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"error":         &graphql.Field{Type: graphql.String},
+		},
+	},
+)
+
+func getAllOrNothingArgument() *graphql.ArgumentConfig {
+	return &graphql.ArgumentConfig{
+		Type:         graphql.Boolean,
+		DefaultValue: false,
+		Description:  "If true, no application is changed when any of the applications fails",
+	}
+}
+
+// newBulkApplicationListArgument returns the list argument of a bulk mutation or error if it holds too many applications.
+func newBulkApplicationListArgument(resolveParams graphql.ResolveParams, argumentName string) ([]interface{}, error) {
+	items, _ := resolveParams.Args[argumentName].([]interface{})
+
+	if len(items) > contract.MaxBulkApplications {
+		return nil, fmt.Errorf("At most %d applications can be changed by a single request. Provided: %d", contract.MaxBulkApplications, len(items))
+	}
+
+	return items, nil
+}
+
+func (result *bulkApplicationResult) succeed(applicationID string) {
+	result.ApplicationID = &applicationID
+	result.Succeeded = true
+	result.Error = nil
+}
+
+func (result *bulkApplicationResult) fail(err error) {
+	message := err.Error()
+	result.Succeeded = false
+	result.Error = &message
+}
+
+func (result bulkApplicationResult) failed() bool {
+	return result.Error != nil
+}
+
+// markNotApplied reports every result without an error as not applied.
+func markNotApplied(results []bulkApplicationResult) []bulkApplicationResult {
+	notApplied := contract.ApplicationNotAppliedMessage
+
+	for index := range results {
+		if !results[index].failed() {
+			results[index].Succeeded = false
+			results[index].Error = &notApplied
+		}
+	}
+
+	return results
+}
+
+func anyFailed(results []bulkApplicationResult) bool {
+	for _, result := range results {
+		if result.failed() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -16,6 +16,7 @@ func getCreateApplicationQuery() *graphql.Field {
 			"application": &graphql.ArgumentConfig{
 				Type: graphql.NewNonNull(inputApplicationType),
 			},
+			"idempotencyKey": idempotencyKeyArgument,
 		},
 
 		Resolve: func(resolveParams graphql.ResolveParams) (interface{}, error) {
@@ -31,14 +32,18 @@ func getCreateApplicationQuery() *graphql.Field {
 
 			application := resolveApplicationFromInputApplicationArgument(inputApplicationArgument)
 
-			executionContext := resolveParams.Context.Value("ExecutionContext").(executionContext)
-
-			applicationID, err := executionContext.tenantService.CreateApplication(tenantID, application)
+			tenantService, err := resolveIdempotentTenantService(resolveParams)
 
 			if err != nil {
 				return nil, err
 			}
 
+			applicationID, err := tenantService.CreateApplication(tenantID, application)
+
+			if err != nil {
+				return nil, mapIdempotencyError(err)
+			}
+
 			return applicationID.String(), nil
 		},
 	}
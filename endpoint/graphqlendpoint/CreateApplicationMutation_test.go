@@ -24,6 +24,7 @@ var _ = Describe("CreateApplication method input parameters and dependency test"
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
 
 		tenantID, _ = system.RandomUUID()
 
@@ -74,6 +75,7 @@ var _ = Describe("CreateApplication method behaviour", func() {
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
 
 		tenantID, _ = system.RandomUUID()
 		applicationID, _ = system.RandomUUID()
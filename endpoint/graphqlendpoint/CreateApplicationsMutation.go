@@ -0,0 +1,71 @@
+package graphqlendpoint
+
+import (
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+)
+
+func getCreateApplicationsQuery() *graphql.Field {
+	return &graphql.Field{
+		Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(bulkApplicationResultType))),
+		Description: "Creates new applications for the provided tenant",
+		Args: graphql.FieldConfigArgument{
+			"tenantID": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"applications": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(inputApplicationType))),
+			},
+			"allOrNothing": getAllOrNothingArgument(),
+		},
+
+		Resolve: func(resolveParams graphql.ResolveParams) (interface{}, error) {
+			tenantIDArg, _ := resolveParams.Args["tenantID"].(string)
+			allOrNothing, _ := resolveParams.Args["allOrNothing"].(bool)
+
+			tenantID, err := system.ParseUUID(tenantIDArg)
+
+			if err != nil {
+				return nil, err
+			}
+
+			items, err := newBulkApplicationListArgument(resolveParams, "applications")
+
+			if err != nil {
+				return nil, err
+			}
+
+			applications := make([]domain.Application, 0, len(items))
+
+			for _, item := range items {
+				inputApplicationArgument, _ := item.(map[string]interface{})
+				applications = append(applications, resolveApplicationFromInputApplicationArgument(inputApplicationArgument))
+			}
+
+			executionContext := resolveParams.Context.Value("ExecutionContext").(executionContext)
+
+			applicationResults, err := executionContext.tenantService.CreateApplications(tenantID, applications, allOrNothing)
+
+			if err != nil {
+				return nil, err
+			}
+
+			results := make([]bulkApplicationResult, len(applicationResults))
+
+			for index, applicationResult := range applicationResults {
+				if applicationResult.Err != nil {
+					results[index].fail(applicationResult.Err)
+				} else if applicationResult.ApplicationID != system.EmptyUUID {
+					results[index].succeed(applicationResult.ApplicationID.String())
+				}
+			}
+
+			if anyFailed(results) && allOrNothing {
+				return markNotApplied(results), nil
+			}
+
+			return results, nil
+		},
+	}
+}
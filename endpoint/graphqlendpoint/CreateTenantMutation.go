@@ -10,6 +10,7 @@ func getCreateTenantQuery() *graphql.Field {
 			"tenant": &graphql.ArgumentConfig{
 				Type: graphql.NewNonNull(inputTenantType),
 			},
+			"idempotencyKey": idempotencyKeyArgument,
 		},
 
 		Resolve: func(resolveParams graphql.ResolveParams) (interface{}, error) {
@@ -17,14 +18,18 @@ func getCreateTenantQuery() *graphql.Field {
 
 			tenant := resolveTenantFromInputTenantArgument(inputTenantArgument)
 
-			executionContext := resolveParams.Context.Value("ExecutionContext").(executionContext)
-
-			tenantID, err := executionContext.tenantService.CreateTenant(tenant)
+			tenantService, err := resolveIdempotentTenantService(resolveParams)
 
 			if err != nil {
 				return nil, err
 			}
 
+			tenantID, err := tenantService.CreateTenant(tenant)
+
+			if err != nil {
+				return nil, mapIdempotencyError(err)
+			}
+
 			return tenantID.String(), nil
 		},
 	}
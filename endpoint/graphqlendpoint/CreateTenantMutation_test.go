@@ -7,10 +7,13 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/graphql-go/graphql"
 	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/contract"
 	"github.com/micro-business/TenantService/business/domain"
 	"github.com/micro-business/TenantService/endpoint/graphqlendpoint"
+	"github.com/micro-business/TenantService/idempotency"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
 )
 
 var _ = Describe("CreateTenant method input parameters and dependency test", func() {
@@ -22,6 +25,7 @@ var _ = Describe("CreateTenant method input parameters and dependency test", fun
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
 	})
 
 	AfterEach(func() {
@@ -50,6 +54,7 @@ var _ = Describe("CreateTenant method behaviour", func() {
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
 
 		tenantID, _ = system.RandomUUID()
 
@@ -95,6 +100,83 @@ var _ = Describe("CreateTenant method behaviour", func() {
 		Expect(err).To(BeNil())
 		Expect(result).To(Equal(expectedTenant))
 	})
+
+	It("should call tenant service CreateTenant function bound to the provided idempotency key", func() {
+		var idempotencyKey string
+
+		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).DoAndReturn(func(ctx context.Context) contract.TenantService {
+			idempotencyKey, _ = idempotency.FromContext(ctx)
+
+			return mockTenantService
+		}).AnyTimes()
+		mockTenantService.EXPECT().CreateTenant(tenant).Return(tenantID, nil)
+
+		query := "mutation {createTenant (tenant: {SecretKey:\"" + tenant.SecretKey + "\"}, idempotencyKey: \"key\")}"
+
+		_, err := graphqlendpoint.ExecuteQuery(query, mockTenantService)
+		Expect(err).To(BeNil())
+		Expect(idempotencyKey).To(Equal("key"))
+	})
+
+	It("should report conflict error code if the idempotency key was used with a different tenant", func() {
+		mockTenantService.EXPECT().CreateTenant(tenant).Return(system.EmptyUUID, idempotency.ConflictError{})
+
+		query := "mutation {createTenant (tenant: {SecretKey:\"" + tenant.SecretKey + "\"}, idempotencyKey: \"key\")}"
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query}, mockTenantService, graphqlendpoint.Options{})
+		Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.IdempotencyKeyConflictErrorCode)).To(BeTrue())
+	})
+
+	It("should report invalid idempotency key error code if the idempotency key is not valid", func() {
+		query := "mutation {createTenant (tenant: {SecretKey:\"" + tenant.SecretKey + "\"}, idempotencyKey: \"\")}"
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query}, mockTenantService, graphqlendpoint.Options{})
+		Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.InvalidIdempotencyKeyErrorCode)).To(BeTrue())
+	})
+
+	It("should read the idempotency key from the request context if the argument is not provided", func() {
+		var idempotencyKey string
+
+		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).DoAndReturn(func(ctx context.Context) contract.TenantService {
+			idempotencyKey, _ = idempotency.FromContext(ctx)
+
+			return mockTenantService
+		}).AnyTimes()
+		mockTenantService.EXPECT().CreateTenant(tenant).Return(tenantID, nil)
+
+		query := "mutation {createTenant (tenant: {SecretKey:\"" + tenant.SecretKey + "\"})}"
+
+		result := graphqlendpoint.ExecuteRequest(idempotency.NewContext(context.Background(), "header key"), graphqlendpoint.Request{Query: query}, mockTenantService, graphqlendpoint.Options{})
+		Expect(result.HasErrors()).To(BeFalse())
+		Expect(idempotencyKey).To(Equal("header key"))
+	})
+
+	It("should reject the idempotency key of the request context if the operation holds more than one create mutation", func() {
+		query := "mutation {first: createTenant (tenant: {SecretKey:\"" + tenant.SecretKey + "\"}) second: createTenant (tenant: {SecretKey:\"" + tenant.SecretKey + "\"})}"
+
+		result := graphqlendpoint.ExecuteRequest(idempotency.NewContext(context.Background(), "header key"), graphqlendpoint.Request{Query: query}, mockTenantService, graphqlendpoint.Options{})
+		Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.InvalidIdempotencyKeyErrorCode)).To(BeTrue())
+	})
+
+	It("should reject the idempotency key of the request context if a fragment selects another create mutation", func() {
+		mockTenantService.EXPECT().CreateApplication(gomock.Any(), gomock.Any()).Times(0)
+
+		query := "mutation {createTenant (tenant: {SecretKey:\"" + tenant.SecretKey + "\"}) ...application} fragment application on RootMutation {createApplication (tenantID: \"" + tenantID.String() + "\", application: {Name: \"name\"})}"
+
+		result := graphqlendpoint.ExecuteRequest(idempotency.NewContext(context.Background(), "header key"), graphqlendpoint.Request{Query: query}, mockTenantService, graphqlendpoint.Options{})
+		Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.InvalidIdempotencyKeyErrorCode)).To(BeTrue())
+	})
+
+	It("should accept the idempotency key arguments of every create mutation of the operation", func() {
+		mockTenantService.EXPECT().CreateTenant(tenant).Return(tenantID, nil).Times(2)
+
+		query := "mutation {first: createTenant (tenant: {SecretKey:\"" + tenant.SecretKey + "\"}, idempotencyKey: \"first\") second: createTenant (tenant: {SecretKey:\"" + tenant.SecretKey + "\"}, idempotencyKey: \"second\")}"
+
+		result := graphqlendpoint.ExecuteRequest(idempotency.NewContext(context.Background(), "header key"), graphqlendpoint.Request{Query: query}, mockTenantService, graphqlendpoint.Options{})
+		Expect(result.HasErrors()).To(BeFalse())
+	})
 })
 
 func TestCreateTenant(t *testing.T) {
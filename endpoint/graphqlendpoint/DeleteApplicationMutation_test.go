@@ -23,6 +23,7 @@ var _ = Describe("DeleteApplication method input parameters and dependency test"
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
 
 		tenantID, _ = system.RandomUUID()
 		applicationID, _ = system.RandomUUID()
@@ -78,6 +79,7 @@ var _ = Describe("DeleteApplication method behaviour", func() {
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
 
 		tenantID, _ = system.RandomUUID()
 		applicationID, _ = system.RandomUUID()
@@ -0,0 +1,72 @@
+package graphqlendpoint
+
+import (
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/Micro-Business-Core/system"
+)
+
+func getDeleteApplicationsQuery() *graphql.Field {
+	return &graphql.Field{
+		Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(bulkApplicationResultType))),
+		Description: "Deletes existing applications of the provided tenant",
+		Args: graphql.FieldConfigArgument{
+			"tenantID": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"applicationIDs": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.ID))),
+			},
+			"allOrNothing": getAllOrNothingArgument(),
+		},
+
+		Resolve: func(resolveParams graphql.ResolveParams) (interface{}, error) {
+			tenantIDArg, _ := resolveParams.Args["tenantID"].(string)
+			allOrNothing, _ := resolveParams.Args["allOrNothing"].(bool)
+
+			tenantID, err := system.ParseUUID(tenantIDArg)
+
+			if err != nil {
+				return nil, err
+			}
+
+			items, err := newBulkApplicationListArgument(resolveParams, "applicationIDs")
+
+			if err != nil {
+				return nil, err
+			}
+
+			results := make([]bulkApplicationResult, len(items))
+			applicationIDs := make([]system.UUID, 0, len(items))
+			applicationIndexes := make(map[system.UUID]int)
+
+			for index, item := range items {
+				applicationIDArg, _ := item.(string)
+
+				applicationID, err := parseBulkApplicationID(applicationIDArg, applicationIndexes)
+
+				if err != nil {
+					results[index].fail(err)
+
+					continue
+				}
+
+				applicationIDs = append(applicationIDs, applicationID)
+				applicationIndexes[applicationID] = index
+			}
+
+			if anyFailed(results) && allOrNothing {
+				return markNotApplied(results), nil
+			}
+
+			executionContext := resolveParams.Context.Value("ExecutionContext").(executionContext)
+
+			applicationErrors, err := executionContext.tenantService.DeleteApplications(tenantID, applicationIDs, allOrNothing)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return completeBulkApplicationResults(results, applicationIndexes, applicationErrors, allOrNothing), nil
+		},
+	}
+}
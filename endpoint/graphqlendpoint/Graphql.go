@@ -3,9 +3,15 @@ package graphqlendpoint
 import (
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/location"
+	"github.com/graphql-go/graphql/language/parser"
 	"github.com/micro-business/TenantService/business/contract"
+	"github.com/micro-business/TenantService/business/event"
 	"golang.org/x/net/context"
 )
 
@@ -16,6 +22,8 @@ var rootQueryType = graphql.NewObject(
 			"tenant":       getTenantQuery(),
 			"application":  getApplicationQuery(),
 			"applications": getApplicationsQuery(),
+			"node":         getNodeQuery(),
+			"nodes":        getNodesQuery(),
 		},
 	},
 )
@@ -24,30 +32,122 @@ var rootMutationType = graphql.NewObject(
 	graphql.ObjectConfig{
 		Name: "RootMutation",
 		Fields: graphql.Fields{
-			"createTenant":      getCreateTenantQuery(),
-			"updateTenant":      getUpdateTenantQuery(),
-			"deleteTenant":      getDeleteTenantQuery(),
-			"createApplication": getCreateApplicationQuery(),
-			"updateApplication": getUpdateApplicationQuery(),
-			"deleteApplication": getDeleteApplicationQuery(),
+			"createTenant":       getCreateTenantQuery(),
+			"updateTenant":       getUpdateTenantQuery(),
+			"deleteTenant":       getDeleteTenantQuery(),
+			"createApplication":  getCreateApplicationQuery(),
+			"updateApplication":  getUpdateApplicationQuery(),
+			"deleteApplication":  getDeleteApplicationQuery(),
+			"createApplications": getCreateApplicationsQuery(),
+			"updateApplications": getUpdateApplicationsQuery(),
+			"deleteApplications": getDeleteApplicationsQuery(),
 		},
 	},
 )
 
-var tenantSchema, _ = graphql.NewSchema(graphql.SchemaConfig{Query: rootQueryType, Mutation: rootMutationType})
+var rootSubscriptionType = graphql.NewObject(
+	graphql.ObjectConfig{
+		Name: "RootSubscription",
+		Fields: graphql.Fields{
+			"tenantChanged":      getTenantChangedSubscription(),
+			"applicationChanged": getApplicationChangedSubscription(),
+		},
+	},
+)
+
+var tenantSchema graphql.Schema
+
+func init() {
+	// The nested fields and the Node type resolution reference each other's types so they can only be set once the types are created
+	tenantType.AddFieldConfig("applications", getTenantApplicationsField())
+	applicationType.AddFieldConfig("tenant", getApplicationTenantField())
+	nodeInterface.ResolveType = resolveNodeType
+
+	var err error
+
+	if tenantSchema, err = graphql.NewSchema(graphql.SchemaConfig{Query: rootQueryType, Mutation: rootMutationType, Subscription: rootSubscriptionType}); err != nil {
+		panic(err)
+	}
+
+	tenantSchema.AddExtensions(observerExtension{})
+}
+
+// Options defines how the queries are executed
+type Options struct {
+	// SensitiveFieldPolicy defines how sensitive fields are resolved for callers without the required permission.
+	SensitiveFieldPolicy SensitiveFieldPolicy
+
+	// MaxQueryDepth is the maximum nesting depth of the fields of a query. Zero means no limit.
+	MaxQueryDepth int
+
+	// MaxQueryComplexity is the maximum estimated cost of a query. Zero means no limit.
+	MaxQueryComplexity int
+
+	// SubscriptionBufferSize is the number of events a subscription can hold before it overflows. Zero means the default size.
+	SubscriptionBufferSize int
+
+	// IntrospectionDisabled rejects the queries selecting the __schema or __type introspection fields. __typename remains available.
+	IntrospectionDisabled bool
+
+	// PersistedQueries is optional. When provided, the clients can send the hash of a persisted query instead of the query.
+	PersistedQueries *PersistedQueryStore
+
+	// Observer is optional. When provided, it is notified of the executed operations and the resolved fields.
+	Observer Observer
+}
 
 type executionContext struct {
 	tenantService contract.TenantService
+	options       Options
+	loaders       *requestLoaders
+	eventBus      *event.Bus
 }
 
-// ExecuteQuery executes the provided query and returns the result.
+const (
+	// MutationNotAllowedErrorCode is the code of the error reported when a mutation is sent in a query only request.
+	MutationNotAllowedErrorCode = "MUTATION_NOT_ALLOWED"
+
+	// QueryTooComplexErrorCode is the code of the error reported when a query exceeds the maximum depth or cost.
+	QueryTooComplexErrorCode = "QUERY_TOO_COMPLEX"
+
+	// ForbiddenErrorCode is the code of the error reported when the caller is not allowed to resolve a field.
+	ForbiddenErrorCode = "FORBIDDEN"
+
+	// SubscriptionNotAllowedErrorCode is the code of the error reported when a subscription is sent in a request that can
+	// only return a single response.
+	SubscriptionNotAllowedErrorCode = "SUBSCRIPTION_NOT_ALLOWED"
+
+	// SubscriptionOverflowErrorCode is the code of the error reported when a subscriber could not keep up with the events
+	// and the subscription is ended.
+	SubscriptionOverflowErrorCode = "SUBSCRIPTION_OVERFLOW"
+
+	// IntrospectionDisabledErrorCode is the code of the error reported when an introspection query is sent while introspection is disabled.
+	IntrospectionDisabledErrorCode = "INTROSPECTION_DISABLED"
+)
+
+// Request defines a GraphQL request as sent by the client
+type Request struct {
+	// Query is the GraphQL document containing the operations to execute.
+	Query string `json:"query"`
+
+	// Variables are the values of the variables defined by the executed operation.
+	Variables map[string]interface{} `json:"variables"`
+
+	// OperationName is the name of the operation to execute. Optional if the document contains a single operation.
+	OperationName string `json:"operationName"`
+
+	// Extensions are the protocol extensions sent along with the request.
+	Extensions map[string]interface{} `json:"extensions"`
+
+	// QueryOnly rejects mutations, it is set for requests that must not change any state such as requests sent using GET.
+	QueryOnly bool `json:"-"`
+}
+
+// ExecuteQuery executes the provided query using the default options and returns the result. The errors reported while
+// executing the query are joined into a single error and the partial result is discarded, use ExecuteRequest to get the
+// complete GraphQL response.
 func ExecuteQuery(query string, tenantService contract.TenantService) (interface{}, error) {
-	result := graphql.Do(
-		graphql.Params{
-			Schema:        tenantSchema,
-			RequestString: query,
-			Context:       context.WithValue(context.Background(), "ExecutionContext", executionContext{tenantService}),
-		})
+	result := ExecuteRequest(context.Background(), Request{Query: query}, tenantService, Options{})
 
 	if result.HasErrors() {
 		errorMessages := []string{}
@@ -61,3 +161,171 @@ func ExecuteQuery(query string, tenantService contract.TenantService) (interface
 
 	return result, nil
 }
+
+// ExecuteRequest executes the provided request using the provided request context and options and returns the GraphQL
+// response. The response carries the data resolved successfully along with an error for each field that failed, each
+// error with its message, path, locations and extensions. The request context carries the request scoped values such as
+// the authenticated principal to the resolvers, and the tenant service is bound to the request context, see
+// contract.TenantService.WithContext, so its logs and spans are correlated with the request.
+// The query can be omitted if the request carries the hash of a persisted query, see PersistedQueryStore.
+// Returns the response. Data is nil if the request failed before the execution started, e.g. when the query is invalid.
+func ExecuteRequest(ctx context.Context, request Request, tenantService contract.TenantService, options Options) *graphql.Result {
+	request, result := resolvePersistedQuery(request, options)
+
+	if result != nil {
+		return result
+	}
+
+	return executeResolvedRequest(ctx, request, tenantService, options)
+}
+
+// executeResolvedRequest executes the provided request whose query is already resolved from the persisted queries.
+func executeResolvedRequest(ctx context.Context, request Request, tenantService contract.TenantService, options Options) (result *graphql.Result) {
+	startTime := time.Now()
+	operationType, operationName := unknownOperationType, request.OperationName
+	ctx, span := startOperationSpan(ctx)
+
+	defer func() {
+		endOperationSpan(span, operationType, operationName, result)
+	}()
+
+	if options.Observer != nil {
+		defer func() {
+			options.Observer.ObserveOperation(operationType, time.Since(startTime), result.HasErrors())
+		}()
+	}
+
+	tenantService = tenantService.WithContext(ctx)
+
+	if document, err := parser.Parse(parser.ParseParams{Source: request.Query}); err == nil {
+		operationType, operationName = describeOperation(document, request.OperationName)
+
+		if request.QueryOnly && containsOperation(document, request.OperationName, ast.OperationTypeMutation) {
+			return newRequestErrorResult(MutationNotAllowedErrorCode, "Mutations can only be sent using POST method.")
+		}
+
+		if containsOperation(document, request.OperationName, ast.OperationTypeSubscription) {
+			return newRequestErrorResult(SubscriptionNotAllowedErrorCode, "Subscriptions can only be sent over WebSocket.")
+		}
+
+		if options.IntrospectionDisabled && containsIntrospection(document) {
+			return newRequestErrorResult(IntrospectionDisabledErrorCode, "Introspection is disabled.")
+		}
+
+		if err = validateQueryComplexity(document, request, options); err != nil {
+			return newRequestErrorResult(QueryTooComplexErrorCode, err.Error())
+		}
+	}
+
+	return graphql.Do(
+		graphql.Params{
+			Schema:         tenantSchema,
+			RequestString:  request.Query,
+			VariableValues: request.Variables,
+			OperationName:  request.OperationName,
+			Context:        context.WithValue(ctx, "ExecutionContext", executionContext{tenantService, options, newRequestLoaders(tenantService), nil}),
+		})
+}
+
+// IsRequestError checks whether the provided response reports a request error, an error that prevented the execution
+// from starting, and therefore carries no data.
+func IsRequestError(result *graphql.Result) bool {
+	return result.Data == nil && result.HasErrors()
+}
+
+// HasErrorCode checks whether any of the errors of the provided response carries the provided error code.
+func HasErrorCode(result *graphql.Result, code string) bool {
+	for _, err := range result.Errors {
+		if err.Extensions != nil && err.Extensions["code"] == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newRequestErrorResult creates a response that reports a single request error with the provided code and message.
+func newRequestErrorResult(code string, message string) *graphql.Result {
+	return &graphql.Result{
+		Errors: []gqlerrors.FormattedError{
+			{
+				Message:    message,
+				Locations:  []location.SourceLocation{},
+				Extensions: map[string]interface{}{"code": code},
+			},
+		},
+	}
+}
+
+// containsOperation checks whether the operation to execute is of the provided type. All operations are checked if the operation name is not provided.
+func containsOperation(document *ast.Document, operationName string, operationType string) bool {
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+
+		if !ok || (len(operationName) != 0 && (operation.Name == nil || operation.Name.Value != operationName)) {
+			continue
+		}
+
+		if operation.Operation == operationType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containsIntrospection checks whether any operation or fragment of the provided document selects the __schema or __type introspection fields.
+func containsIntrospection(document *ast.Document) bool {
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.OperationDefinition:
+			if selectsIntrospection(definition.SelectionSet) {
+				return true
+			}
+
+		case *ast.FragmentDefinition:
+			if selectsIntrospection(definition.SelectionSet) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func selectsIntrospection(selectionSet *ast.SelectionSet) bool {
+	if selectionSet == nil {
+		return false
+	}
+
+	for _, selection := range selectionSet.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if selection.Name.Value == "__schema" || selection.Name.Value == "__type" || selectsIntrospection(selection.SelectionSet) {
+				return true
+			}
+
+		case *ast.InlineFragment:
+			if selectsIntrospection(selection.SelectionSet) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// codedError is an error reported by a resolver that carries an error code in the extensions of the GraphQL error.
+type codedError struct {
+	code    string
+	message string
+}
+
+func (err codedError) Error() string {
+	return err.message
+}
+
+// Extensions returns the extensions of the GraphQL error.
+func (err codedError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": err.code}
+}
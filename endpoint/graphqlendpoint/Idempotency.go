@@ -0,0 +1,99 @@
+package graphqlendpoint
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/micro-business/TenantService/business/contract"
+	"github.com/micro-business/TenantService/idempotency"
+)
+
+const (
+	// InvalidIdempotencyKeyErrorCode is the code of the error reported when the idempotency key sent by the client is not valid.
+	InvalidIdempotencyKeyErrorCode = "INVALID_IDEMPOTENCY_KEY"
+
+	// IdempotencyKeyConflictErrorCode is the code of the error reported when an idempotency key is reused with a different
+	// request, or while the request first sent with the key is still in progress.
+	IdempotencyKeyConflictErrorCode = "IDEMPOTENCY_KEY_CONFLICT"
+)
+
+// idempotencyKeyArgument is the optional argument of the create mutations carrying the idempotency key.
+var idempotencyKeyArgument = &graphql.ArgumentConfig{
+	Type:        graphql.String,
+	Description: "Makes the mutation safe to retry. A retry with the same key and input returns the ID created first.",
+}
+
+// idempotentMutations are the names of the mutations accepting an idempotency key.
+var idempotentMutations = map[string]bool{"createTenant": true, "createApplication": true}
+
+// resolveIdempotentTenantService returns the tenant service a create mutation is resolved with. The idempotency key is
+// read from the idempotencyKey argument, or from the Idempotency-Key header if the argument is not provided. The header
+// identifies a single request, so it is rejected if the operation holds more than one create mutation: they would all be
+// recorded with the same key, and every retry but the first would be reported as a conflict.
+// Returns either the tenant service bound to the idempotency key, if any, or error if the key is not valid.
+func resolveIdempotentTenantService(resolveParams graphql.ResolveParams) (contract.TenantService, error) {
+	tenantService := resolveParams.Context.Value("ExecutionContext").(executionContext).tenantService
+	key, ok := resolveParams.Args["idempotencyKey"].(string)
+
+	if !ok {
+		if key, ok = idempotency.FromContext(resolveParams.Context); !ok {
+			return tenantService, nil
+		}
+
+		if operation, isOperation := resolveParams.Info.Operation.(*ast.OperationDefinition); isOperation &&
+			countIdempotentMutations(operation.SelectionSet, resolveParams.Info.Fragments) > 1 {
+			return nil, codedError{
+				code:    InvalidIdempotencyKeyErrorCode,
+				message: "Idempotency-Key header can only be sent with a single create mutation, use the idempotencyKey argument of every mutation instead.",
+			}
+		}
+	}
+
+	if !idempotency.IsValidKey(key) {
+		return nil, codedError{
+			code:    InvalidIdempotencyKeyErrorCode,
+			message: fmt.Sprintf("Idempotency key must be made of at most %d printable ASCII characters.", idempotency.MaxKeyLength),
+		}
+	}
+
+	return tenantService.WithContext(idempotency.NewContext(resolveParams.Context, key)), nil
+}
+
+// mapIdempotencyError reports the idempotency conflicts returned by the tenant service with their error code.
+func mapIdempotencyError(err error) error {
+	if conflictErr, ok := err.(interface{ Conflict() bool }); ok && conflictErr.Conflict() {
+		return codedError{code: IdempotencyKeyConflictErrorCode, message: err.Error()}
+	}
+
+	return err
+}
+
+// countIdempotentMutations counts the create mutations accepting an idempotency key in the provided selection set of an
+// operation, the mutations selected through fragments included.
+func countIdempotentMutations(selectionSet *ast.SelectionSet, fragments map[string]ast.Definition) int {
+	if selectionSet == nil {
+		return 0
+	}
+
+	count := 0
+
+	for _, selection := range selectionSet.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if idempotentMutations[selection.Name.Value] {
+				count++
+			}
+
+		case *ast.InlineFragment:
+			count += countIdempotentMutations(selection.SelectionSet, fragments)
+
+		case *ast.FragmentSpread:
+			if fragment, ok := fragments[selection.Name.Value].(*ast.FragmentDefinition); ok {
+				count += countIdempotentMutations(fragment.SelectionSet, fragments)
+			}
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,159 @@
+package graphqlendpoint
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/contract"
+	"github.com/micro-business/TenantService/business/domain"
+)
+
+// loadResult defines the value loaded for a key or the error reported while loading it
+type loadResult struct {
+	value interface{}
+	err   error
+}
+
+// dataLoader batches the loads requested while a level of the query is resolved. Resolvers register the keys they need and
+// return a thunk, the first thunk evaluated loads all the registered keys with a single call, the following ones read the
+// cached result. Each key is loaded at most once per request.
+type dataLoader struct {
+	mutex    sync.Mutex
+	load     func(keys []system.UUID) (map[system.UUID]interface{}, error)
+	notFound func(key system.UUID) error
+	pending  []system.UUID
+	results  map[system.UUID]loadResult
+}
+
+// requestLoaders defines the loaders shared by the resolvers of a single request
+type requestLoaders struct {
+	tenants      *dataLoader
+	applications *dataLoader
+}
+
+// newRequestLoaders creates the loaders of a single request backed by the provided tenant service.
+func newRequestLoaders(tenantService contract.TenantService) *requestLoaders {
+	return &requestLoaders{
+		tenants: newDataLoader(func(tenantIDs []system.UUID) (map[system.UUID]interface{}, error) {
+			returnedTenants, err := tenantService.ReadTenants(tenantIDs)
+			values := make(map[system.UUID]interface{})
+
+			for tenantID, returnedTenant := range returnedTenants {
+				values[tenantID] = returnedTenant
+			}
+
+			return values, err
+		}, newTenantNotFoundError),
+		applications: newDataLoader(func(tenantIDs []system.UUID) (map[system.UUID]interface{}, error) {
+			returnedApplicationsOfTenants, err := tenantService.ReadApplicationsOfTenants(tenantIDs)
+			values := make(map[system.UUID]interface{})
+
+			for tenantID, returnedApplications := range returnedApplicationsOfTenants {
+				values[tenantID] = returnedApplications
+			}
+
+			return values, err
+		}, newTenantNotFoundError),
+	}
+}
+
+// newDataLoader creates a loader that loads the keys of a batch using the provided function. The keys the function does not
+// return a value for are reported with the error returned by notFound.
+func newDataLoader(load func(keys []system.UUID) (map[system.UUID]interface{}, error), notFound func(key system.UUID) error) *dataLoader {
+	return &dataLoader{load: load, notFound: notFound, results: make(map[system.UUID]loadResult)}
+}
+
+// newTenantNotFoundError creates the error reported when the provided tenant does not exist.
+func newTenantNotFoundError(tenantID system.UUID) error {
+	return fmt.Errorf("Tenant not found. Tenant ID: %s", tenantID.String())
+}
+
+// loadTenant registers the provided tenant to be loaded in the next batch.
+// Returns a thunk that returns the tenant information once evaluated.
+func (loaders *requestLoaders) loadTenant(tenantID system.UUID) func() (domain.Tenant, error) {
+	thunk := loaders.tenants.enqueue(tenantID)
+
+	return func() (domain.Tenant, error) {
+		value, err := thunk()
+
+		if err != nil {
+			return domain.Tenant{}, err
+		}
+
+		return value.(domain.Tenant), nil
+	}
+}
+
+// loadApplications registers the applications of the provided tenant to be loaded in the next batch.
+// Returns a thunk that returns the applications of the tenant once evaluated.
+func (loaders *requestLoaders) loadApplications(tenantID system.UUID) func() (map[system.UUID]domain.Application, error) {
+	thunk := loaders.applications.enqueue(tenantID)
+
+	return func() (map[system.UUID]domain.Application, error) {
+		value, err := thunk()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return value.(map[system.UUID]domain.Application), nil
+	}
+}
+
+// enqueue registers the provided key to be loaded in the next batch.
+// Returns a thunk that returns the loaded value once evaluated.
+func (loader *dataLoader) enqueue(key system.UUID) func() (interface{}, error) {
+	loader.mutex.Lock()
+
+	if _, loaded := loader.results[key]; !loaded && !loader.isPending(key) {
+		loader.pending = append(loader.pending, key)
+	}
+
+	loader.mutex.Unlock()
+
+	return func() (interface{}, error) {
+		loader.mutex.Lock()
+		defer loader.mutex.Unlock()
+
+		if _, loaded := loader.results[key]; !loaded {
+			loader.loadPending()
+		}
+
+		result := loader.results[key]
+
+		return result.value, result.err
+	}
+}
+
+// loadPending loads all the pending keys with a single call and caches the results. The caller must hold the loader mutex.
+func (loader *dataLoader) loadPending() {
+	keys := loader.pending
+	loader.pending = nil
+
+	if len(keys) == 0 {
+		return
+	}
+
+	values, err := loader.load(keys)
+
+	for _, key := range keys {
+		if err != nil {
+			loader.results[key] = loadResult{err: err}
+		} else if value, found := values[key]; found {
+			loader.results[key] = loadResult{value: value}
+		} else {
+			loader.results[key] = loadResult{err: loader.notFound(key)}
+		}
+	}
+}
+
+func (loader *dataLoader) isPending(key system.UUID) bool {
+	for _, pendingKey := range loader.pending {
+		if pendingKey == key {
+			return true
+		}
+	}
+
+	return false
+}
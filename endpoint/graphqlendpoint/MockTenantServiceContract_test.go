@@ -6,7 +6,9 @@ package graphqlendpoint_test
 import (
 	gomock "github.com/golang/mock/gomock"
 	system "github.com/micro-business/Micro-Business-Core/system"
+	contract "github.com/micro-business/TenantService/business/contract"
 	domain "github.com/micro-business/TenantService/business/domain"
+	context "golang.org/x/net/context"
 )
 
 // Mock of TenantService interface
@@ -62,6 +64,28 @@ func (_mr *_MockTenantServiceRecorder) ReadTenant(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReadTenant", arg0)
 }
 
+func (_m *MockTenantService) ReadAllTenants() (map[system.UUID]domain.Tenant, error) {
+	ret := _m.ctrl.Call(_m, "ReadAllTenants")
+	ret0, _ := ret[0].(map[system.UUID]domain.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockTenantServiceRecorder) ReadAllTenants() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReadAllTenants")
+}
+
+func (_m *MockTenantService) ReadTenants(tenantIDs []system.UUID) (map[system.UUID]domain.Tenant, error) {
+	ret := _m.ctrl.Call(_m, "ReadTenants", tenantIDs)
+	ret0, _ := ret[0].(map[system.UUID]domain.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockTenantServiceRecorder) ReadTenants(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReadTenants", arg0)
+}
+
 func (_m *MockTenantService) DeleteTenant(tenantID system.UUID) error {
 	ret := _m.ctrl.Call(_m, "DeleteTenant", tenantID)
 	ret0, _ := ret[0].(error)
@@ -115,6 +139,17 @@ func (_mr *_MockTenantServiceRecorder) ReadAllApplications(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReadAllApplications", arg0)
 }
 
+func (_m *MockTenantService) ReadApplicationsOfTenants(tenantIDs []system.UUID) (map[system.UUID]map[system.UUID]domain.Application, error) {
+	ret := _m.ctrl.Call(_m, "ReadApplicationsOfTenants", tenantIDs)
+	ret0, _ := ret[0].(map[system.UUID]map[system.UUID]domain.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockTenantServiceRecorder) ReadApplicationsOfTenants(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReadApplicationsOfTenants", arg0)
+}
+
 func (_m *MockTenantService) DeleteApplication(tenantID system.UUID, applicationID system.UUID) error {
 	ret := _m.ctrl.Call(_m, "DeleteApplication", tenantID, applicationID)
 	ret0, _ := ret[0].(error)
@@ -124,3 +159,68 @@ func (_m *MockTenantService) DeleteApplication(tenantID system.UUID, application
 func (_mr *_MockTenantServiceRecorder) DeleteApplication(arg0, arg1 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteApplication", arg0, arg1)
 }
+
+func (_m *MockTenantService) CreateApplications(tenantID system.UUID, applications []domain.Application, allOrNothing bool) ([]domain.ApplicationResult, error) {
+	ret := _m.ctrl.Call(_m, "CreateApplications", tenantID, applications, allOrNothing)
+	ret0, _ := ret[0].([]domain.ApplicationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockTenantServiceRecorder) CreateApplications(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateApplications", arg0, arg1, arg2)
+}
+
+func (_m *MockTenantService) UpdateApplications(tenantID system.UUID, applications map[system.UUID]domain.Application, allOrNothing bool) (map[system.UUID]error, error) {
+	ret := _m.ctrl.Call(_m, "UpdateApplications", tenantID, applications, allOrNothing)
+	ret0, _ := ret[0].(map[system.UUID]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockTenantServiceRecorder) UpdateApplications(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateApplications", arg0, arg1, arg2)
+}
+
+func (_m *MockTenantService) DeleteApplications(tenantID system.UUID, applicationIDs []system.UUID, allOrNothing bool) (map[system.UUID]error, error) {
+	ret := _m.ctrl.Call(_m, "DeleteApplications", tenantID, applicationIDs, allOrNothing)
+	ret0, _ := ret[0].(map[system.UUID]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockTenantServiceRecorder) DeleteApplications(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteApplications", arg0, arg1, arg2)
+}
+
+func (_m *MockTenantService) CheckHealth() error {
+	ret := _m.ctrl.Call(_m, "CheckHealth")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockTenantServiceRecorder) CheckHealth() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CheckHealth")
+}
+
+func (_m *MockTenantService) CountTenantsAndApplications() (int, int, error) {
+	ret := _m.ctrl.Call(_m, "CountTenantsAndApplications")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+func (_mr *_MockTenantServiceRecorder) CountTenantsAndApplications() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CountTenantsAndApplications")
+}
+
+func (_m *MockTenantService) WithContext(ctx context.Context) contract.TenantService {
+	ret := _m.ctrl.Call(_m, "WithContext", ctx)
+	ret0, _ := ret[0].(contract.TenantService)
+	return ret0
+}
+
+func (_mr *_MockTenantServiceRecorder) WithContext(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WithContext", arg0)
+}
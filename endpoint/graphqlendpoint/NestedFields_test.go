@@ -0,0 +1,120 @@
+package graphqlendpoint_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/endpoint/graphqlendpoint"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("Nested fields behaviour", func() {
+	var (
+		mockCtrl          *gomock.Controller
+		mockTenantService *MockTenantService
+		tenantID          system.UUID
+		applications      map[system.UUID]domain.Application
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+
+		tenantID, _ = system.RandomUUID()
+
+		applications = make(map[system.UUID]domain.Application)
+
+		for idx := 0; idx < 3; idx++ {
+			applicationID, _ := system.RandomUUID()
+			applications[applicationID] = domain.Application{Name: applicationID.String()}
+		}
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should read the applications of a tenant only once when the tenant is requested multiple times", func() {
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{SecretKey: "Secret Key"}, nil).Times(2)
+		mockTenantService.EXPECT().ReadApplicationsOfTenants([]system.UUID{tenantID}).Return(map[system.UUID]map[system.UUID]domain.Application{tenantID: applications}, nil).Times(1)
+
+		query := "{first: tenant(tenantID:\"" + tenantID.String() + "\"){applications{totalCount}}" +
+			" second: tenant(tenantID:\"" + tenantID.String() + "\"){applications{totalCount}}}"
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query}, mockTenantService, graphqlendpoint.Options{})
+		Expect(result.HasErrors()).To(BeFalse())
+
+		data := result.Data.(map[string]interface{})
+		Expect(data["first"]).To(Equal(map[string]interface{}{"applications": map[string]interface{}{"totalCount": 3}}))
+		Expect(data["second"]).To(Equal(map[string]interface{}{"applications": map[string]interface{}{"totalCount": 3}}))
+	})
+
+	It("should return the applications of a tenant page by page", func() {
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{SecretKey: "Secret Key"}, nil).AnyTimes()
+		mockTenantService.EXPECT().ReadApplicationsOfTenants([]system.UUID{tenantID}).Return(map[system.UUID]map[system.UUID]domain.Application{tenantID: applications}, nil).AnyTimes()
+
+		returnedApplicationIDs := []string{}
+		after := ""
+
+		for {
+			query := "{tenant(tenantID:\"" + tenantID.String() + "\"){applications(first: 2, after: \"" + after + "\"){edges{node{ID}} pageInfo{hasNextPage endCursor}}}}"
+
+			result := graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query}, mockTenantService, graphqlendpoint.Options{})
+			Expect(result.HasErrors()).To(BeFalse())
+
+			connection := result.Data.(map[string]interface{})["tenant"].(map[string]interface{})["applications"].(map[string]interface{})
+
+			for _, edge := range connection["edges"].([]interface{}) {
+				returnedApplicationIDs = append(returnedApplicationIDs, edge.(map[string]interface{})["node"].(map[string]interface{})["ID"].(string))
+			}
+
+			pageInfo := connection["pageInfo"].(map[string]interface{})
+
+			if !pageInfo["hasNextPage"].(bool) {
+				break
+			}
+
+			after = pageInfo["endCursor"].(string)
+		}
+
+		Expect(returnedApplicationIDs).To(HaveLen(len(applications)))
+
+		for applicationID := range applications {
+			Expect(returnedApplicationIDs).To(ContainElement(applicationID.String()))
+		}
+	})
+
+	It("should return error if the cursor is invalid", func() {
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{SecretKey: "Secret Key"}, nil)
+		mockTenantService.EXPECT().ReadApplicationsOfTenants([]system.UUID{tenantID}).Return(map[system.UUID]map[system.UUID]domain.Application{tenantID: applications}, nil)
+
+		query := "{tenant(tenantID:\"" + tenantID.String() + "\"){applications(after: \"invalid\"){totalCount}}}"
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query}, mockTenantService, graphqlendpoint.Options{})
+		Expect(result.HasErrors()).To(BeTrue())
+	})
+
+	It("should read the tenant of the applications only once", func() {
+		mockTenantService.EXPECT().ReadAllApplications(tenantID).Return(applications, nil)
+		mockTenantService.EXPECT().ReadTenants([]system.UUID{tenantID}).Return(map[system.UUID]domain.Tenant{tenantID: {SecretKey: "Secret Key"}}, nil).Times(1)
+
+		query := "{applications(tenantID:\"" + tenantID.String() + "\"){ID tenant{ID}}}"
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query}, mockTenantService, graphqlendpoint.Options{})
+		Expect(result.HasErrors()).To(BeFalse())
+
+		for _, returnedApplication := range result.Data.(map[string]interface{})["applications"].([]interface{}) {
+			Expect(returnedApplication.(map[string]interface{})["tenant"]).To(Equal(map[string]interface{}{"ID": tenantID.String()}))
+		}
+	})
+})
+
+func TestNestedFields(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Nested fields method behaviour")
+}
@@ -0,0 +1,196 @@
+package graphqlendpoint
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/Micro-Business-Core/system"
+)
+
+const (
+	nodeID                  = "id"
+	tenantNodeTypeName      = "Tenant"
+	applicationNodeTypeName = "Application"
+)
+
+// nodeInterface is the Relay Node interface implemented by every object that can be refetched using its global ID. Its
+// ResolveType is assigned once the implementing types are created, see init.
+var nodeInterface = graphql.NewInterface(
+	graphql.InterfaceConfig{
+		Name:        "Node",
+		Description: "An object that can be refetched using its global ID",
+		Fields: graphql.Fields{
+			nodeID: &graphql.Field{Type: graphql.NewNonNull(graphql.ID), Description: "The opaque global ID of the object"},
+		},
+	},
+)
+
+func getNodeQuery() *graphql.Field {
+	return &graphql.Field{
+		Type:        nodeInterface,
+		Description: "Returns the object with the provided global ID",
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+
+		Resolve: func(resolveParams graphql.ResolveParams) (interface{}, error) {
+			globalID, _ := resolveParams.Args["id"].(string)
+
+			return loadNode(resolveParams, globalID), nil
+		},
+	}
+}
+
+func getNodesQuery() *graphql.Field {
+	return &graphql.Field{
+		Type:        graphql.NewNonNull(graphql.NewList(nodeInterface)),
+		Description: "Returns the objects with the provided global IDs, in the order of the provided IDs",
+		Args: graphql.FieldConfigArgument{
+			"ids": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.ID))),
+			},
+		},
+
+		Resolve: func(resolveParams graphql.ResolveParams) (interface{}, error) {
+			globalIDs, _ := resolveParams.Args["ids"].([]interface{})
+			nodes := make([]interface{}, 0, len(globalIDs))
+
+			for _, globalID := range globalIDs {
+				globalIDArg, _ := globalID.(string)
+				nodes = append(nodes, loadNode(resolveParams, globalIDArg))
+			}
+
+			return nodes, nil
+		},
+	}
+}
+
+// getGlobalIDField returns the id field of the Node interface, resolved from the keys of the source object.
+func getGlobalIDField() *graphql.Field {
+	return &graphql.Field{
+		Type:        graphql.NewNonNull(graphql.ID),
+		Description: "The opaque global ID of the object",
+
+		Resolve: func(resolveParams graphql.ResolveParams) (interface{}, error) {
+			switch source := resolveParams.Source.(type) {
+			case tenant:
+				return encodeGlobalID(tenantNodeTypeName, source.ID), nil
+
+			case *tenant:
+				return encodeGlobalID(tenantNodeTypeName, source.ID), nil
+
+			case application:
+				return encodeGlobalID(applicationNodeTypeName, source.TenantID, source.ID), nil
+
+			case *application:
+				return encodeGlobalID(applicationNodeTypeName, source.TenantID, source.ID), nil
+			}
+
+			return nil, fmt.Errorf("Global ID is not supported. Type: %s", resolveParams.Info.ParentType.Name())
+		},
+	}
+}
+
+// resolveNodeType returns the object type of the provided node.
+func resolveNodeType(resolveTypeParams graphql.ResolveTypeParams) *graphql.Object {
+	switch resolveTypeParams.Value.(type) {
+	case tenant, *tenant:
+		return tenantType
+
+	case application, *application:
+		return applicationType
+	}
+
+	return nil
+}
+
+// loadNode registers the object with the provided global ID to be loaded in the next batch.
+// Returns a thunk that returns the object once evaluated, or error if the global ID is invalid or the object cannot be read.
+func loadNode(resolveParams graphql.ResolveParams, globalID string) func() (interface{}, error) {
+	typeName, keys, err := decodeGlobalID(globalID)
+
+	if err != nil {
+		return func() (interface{}, error) {
+			return nil, err
+		}
+	}
+
+	loaders := requestLoadersOf(resolveParams)
+
+	if typeName == tenantNodeTypeName {
+		tenantID := keys[0]
+		loadTenant := loaders.loadTenant(tenantID)
+
+		return func() (interface{}, error) {
+			returnedTenant, err := loadTenant()
+
+			if err != nil {
+				return nil, err
+			}
+
+			return tenant{ID: tenantID.String(), SecretKey: returnedTenant.SecretKey}, nil
+		}
+	}
+
+	tenantID, applicationID := keys[0], keys[1]
+	loadApplications := loaders.loadApplications(tenantID)
+
+	return func() (interface{}, error) {
+		returnedApplications, err := loadApplications()
+
+		if err != nil {
+			return nil, err
+		}
+
+		returnedApplication, found := returnedApplications[applicationID]
+
+		if !found {
+			return nil, fmt.Errorf("Tenant Application not found. Tenant ID: %s, Application ID: %s", tenantID.String(), applicationID.String())
+		}
+
+		return application{ID: applicationID.String(), Name: returnedApplication.Name, TenantID: tenantID.String()}, nil
+	}
+}
+
+// encodeGlobalID encodes the provided type name and keys into an opaque global ID.
+func encodeGlobalID(typeName string, keys ...string) string {
+	return base64.StdEncoding.EncodeToString([]byte(typeName + ":" + strings.Join(keys, ":")))
+}
+
+// decodeGlobalID decodes the provided global ID into the type name and the keys of the object, the tenant unique
+// identifier for tenants, the tenant and the application unique identifiers for applications.
+// Returns either the type name and the keys or error if the global ID is invalid.
+func decodeGlobalID(globalID string) (string, []system.UUID, error) {
+	invalidGlobalIDErr := fmt.Errorf("Invalid global ID. ID: %s", globalID)
+
+	decoded, err := base64.StdEncoding.DecodeString(globalID)
+
+	if err != nil {
+		return "", nil, invalidGlobalIDErr
+	}
+
+	parts := strings.Split(string(decoded), ":")
+	typeName := parts[0]
+
+	if !(typeName == tenantNodeTypeName && len(parts) == 2) && !(typeName == applicationNodeTypeName && len(parts) == 3) {
+		return "", nil, invalidGlobalIDErr
+	}
+
+	keys := make([]system.UUID, 0, len(parts)-1)
+
+	for _, part := range parts[1:] {
+		key, err := system.ParseUUID(part)
+
+		if err != nil {
+			return "", nil, invalidGlobalIDErr
+		}
+
+		keys = append(keys, key)
+	}
+
+	return typeName, keys, nil
+}
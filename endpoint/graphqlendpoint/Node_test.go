@@ -0,0 +1,143 @@
+package graphqlendpoint_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/endpoint/graphqlendpoint"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("Node interface behaviour", func() {
+	var (
+		mockCtrl          *gomock.Controller
+		mockTenantService *MockTenantService
+		tenantID          system.UUID
+		applicationID     system.UUID
+		tenantGlobalID    string
+		appGlobalID       string
+	)
+
+	execute := func(query string) *graphql.Result {
+		return graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query}, mockTenantService, graphqlendpoint.Options{})
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+
+		tenantID, _ = system.RandomUUID()
+		applicationID, _ = system.RandomUUID()
+		tenantGlobalID = base64.StdEncoding.EncodeToString([]byte("Tenant:" + tenantID.String()))
+		appGlobalID = base64.StdEncoding.EncodeToString([]byte("Application:" + tenantID.String() + ":" + applicationID.String()))
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should return the global ID of tenants and applications", func() {
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{}, nil)
+		mockTenantService.EXPECT().ReadApplication(tenantID, applicationID).Return(domain.Application{Name: "Application"}, nil)
+
+		result := execute("{tenant(tenantID:\"" + tenantID.String() + "\"){id ID} application(tenantID:\"" + tenantID.String() + "\", applicationID:\"" + applicationID.String() + "\"){id ID}}")
+
+		Expect(result.HasErrors()).To(BeFalse())
+		Expect(result.Data).To(Equal(map[string]interface{}{
+			"tenant":      map[string]interface{}{"id": tenantGlobalID, "ID": tenantID.String()},
+			"application": map[string]interface{}{"id": appGlobalID, "ID": applicationID.String()},
+		}))
+	})
+
+	It("should refetch a tenant using its global ID", func() {
+		mockTenantService.EXPECT().ReadTenants([]system.UUID{tenantID}).Return(map[system.UUID]domain.Tenant{tenantID: {}}, nil)
+
+		result := execute("{node(id:\"" + tenantGlobalID + "\"){id __typename ... on Tenant {ID}}}")
+
+		Expect(result.HasErrors()).To(BeFalse())
+		Expect(result.Data).To(Equal(map[string]interface{}{
+			"node": map[string]interface{}{"id": tenantGlobalID, "__typename": "Tenant", "ID": tenantID.String()},
+		}))
+	})
+
+	It("should refetch an application using its global ID only", func() {
+		mockTenantService.EXPECT().ReadTenants([]system.UUID{tenantID}).Return(map[system.UUID]domain.Tenant{tenantID: {}}, nil)
+		mockTenantService.EXPECT().ReadApplicationsOfTenants([]system.UUID{tenantID}).Return(map[system.UUID]map[system.UUID]domain.Application{
+			tenantID: {applicationID: {Name: "Application"}},
+		}, nil)
+
+		result := execute("{node(id:\"" + appGlobalID + "\"){... on Application {Name tenant{ID}}}}")
+
+		Expect(result.HasErrors()).To(BeFalse())
+		Expect(result.Data).To(Equal(map[string]interface{}{
+			"node": map[string]interface{}{"Name": "Application", "tenant": map[string]interface{}{"ID": tenantID.String()}},
+		}))
+	})
+
+	It("should refetch multiple objects in the order of the provided global IDs loading each tenant once", func() {
+		otherApplicationID, _ := system.RandomUUID()
+		otherAppGlobalID := base64.StdEncoding.EncodeToString([]byte("Application:" + tenantID.String() + ":" + otherApplicationID.String()))
+
+		mockTenantService.EXPECT().ReadTenants([]system.UUID{tenantID}).Return(map[system.UUID]domain.Tenant{tenantID: {}}, nil).Times(1)
+		mockTenantService.EXPECT().ReadApplicationsOfTenants([]system.UUID{tenantID}).Return(map[system.UUID]map[system.UUID]domain.Application{
+			tenantID: {
+				applicationID:      {Name: "Application"},
+				otherApplicationID: {Name: "Other Application"},
+			},
+		}, nil).Times(1)
+
+		result := execute("{nodes(ids:[\"" + otherAppGlobalID + "\", \"" + tenantGlobalID + "\", \"" + appGlobalID + "\"]){id}}")
+
+		Expect(result.HasErrors()).To(BeFalse())
+		Expect(result.Data).To(Equal(map[string]interface{}{
+			"nodes": []interface{}{
+				map[string]interface{}{"id": otherAppGlobalID},
+				map[string]interface{}{"id": tenantGlobalID},
+				map[string]interface{}{"id": appGlobalID},
+			},
+		}))
+	})
+
+	It("should read all the tenants of the provided global IDs at once", func() {
+		otherTenantID, _ := system.RandomUUID()
+		otherTenantGlobalID := base64.StdEncoding.EncodeToString([]byte("Tenant:" + otherTenantID.String()))
+
+		mockTenantService.EXPECT().ReadTenants([]system.UUID{tenantID, otherTenantID}).Return(map[system.UUID]domain.Tenant{tenantID: {}}, nil).Times(1)
+
+		result := execute("{nodes(ids:[\"" + tenantGlobalID + "\", \"" + otherTenantGlobalID + "\"]){id}}")
+
+		Expect(result.Errors).To(HaveLen(1))
+		Expect(result.Errors[0].Message).To(ContainSubstring("Tenant not found"))
+		Expect(result.Data).To(Equal(map[string]interface{}{
+			"nodes": []interface{}{map[string]interface{}{"id": tenantGlobalID}, nil},
+		}))
+	})
+
+	It("should return null with an error for invalid or unknown global IDs", func() {
+		unknownApplicationID, _ := system.RandomUUID()
+		unknownAppGlobalID := base64.StdEncoding.EncodeToString([]byte("Application:" + tenantID.String() + ":" + unknownApplicationID.String()))
+
+		mockTenantService.EXPECT().ReadApplicationsOfTenants([]system.UUID{tenantID}).Return(map[system.UUID]map[system.UUID]domain.Application{
+			tenantID: {applicationID: {Name: "Application"}},
+		}, nil)
+
+		result := execute("{nodes(ids:[\"Invalid\", \"" + unknownAppGlobalID + "\", \"" + appGlobalID + "\"]){id}}")
+
+		Expect(result.Errors).To(HaveLen(2))
+		Expect(result.Data).To(Equal(map[string]interface{}{
+			"nodes": []interface{}{nil, nil, map[string]interface{}{"id": appGlobalID}},
+		}))
+	})
+})
+
+func TestNode(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Node interface behaviour")
+}
@@ -0,0 +1,96 @@
+package graphqlendpoint
+
+import (
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"golang.org/x/net/context"
+)
+
+// unknownOperationType is reported for the requests whose operation cannot be determined, e.g. when the query cannot be parsed.
+const unknownOperationType = "unknown"
+
+// Observer is notified of the executed operations and the resolved fields, e.g. to record their latency.
+type Observer interface {
+	// ObserveOperation is called once an operation of the provided type is executed. The operation name is not provided,
+	// it is chosen by the client so it cannot be used to group the operations.
+	ObserveOperation(operationType string, duration time.Duration, failed bool)
+
+	// ObserveField is called once the resolver of the provided field returns. Resolvers that defer loading, e.g. to batch
+	// the reads, are measured until they return the deferred value, not until it is loaded.
+	ObserveField(parentType string, fieldName string, duration time.Duration, failed bool)
+}
+
+// describeOperation returns the type and the name of the operation of the provided document to execute.
+func describeOperation(document *ast.Document, operationName string) (string, string) {
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+
+		if !ok {
+			continue
+		}
+
+		name := ""
+
+		if operation.Name != nil {
+			name = operation.Name.Value
+		}
+
+		if len(operationName) == 0 || name == operationName {
+			return operation.Operation, name
+		}
+	}
+
+	return unknownOperationType, operationName
+}
+
+// observerExtension notifies the observer provided in the options of the executed request of every resolved field.
+// Introspection fields, including __typename, are not observed.
+type observerExtension struct{}
+
+func (extension observerExtension) Init(ctx context.Context, params *graphql.Params) context.Context {
+	return ctx
+}
+
+func (extension observerExtension) Name() string {
+	return "Observer"
+}
+
+func (extension observerExtension) ParseDidStart(ctx context.Context) (context.Context, graphql.ParseFinishFunc) {
+	return ctx, func(error) {}
+}
+
+func (extension observerExtension) ValidationDidStart(ctx context.Context) (context.Context, graphql.ValidationFinishFunc) {
+	return ctx, func([]gqlerrors.FormattedError) {}
+}
+
+func (extension observerExtension) ExecutionDidStart(ctx context.Context) (context.Context, graphql.ExecutionFinishFunc) {
+	return ctx, func(*graphql.Result) {}
+}
+
+func (extension observerExtension) ResolveFieldDidStart(ctx context.Context, info *graphql.ResolveInfo) (context.Context, graphql.ResolveFieldFinishFunc) {
+	executionContext, _ := ctx.Value("ExecutionContext").(executionContext)
+	observer := executionContext.options.Observer
+
+	if observer == nil || strings.HasPrefix(info.ParentType.Name(), "__") || strings.HasPrefix(info.FieldName, "__") {
+		return ctx, func(interface{}, error) {}
+	}
+
+	startTime := time.Now()
+	parentType, fieldName := info.ParentType.Name(), info.FieldName
+
+	return ctx, func(result interface{}, err error) {
+		observer.ObserveField(parentType, fieldName, time.Since(startTime), err != nil)
+	}
+}
+
+func (extension observerExtension) HasResult() bool {
+	return false
+}
+
+func (extension observerExtension) GetResult(ctx context.Context) interface{} {
+	return nil
+}
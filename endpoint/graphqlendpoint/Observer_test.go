@@ -0,0 +1,100 @@
+package graphqlendpoint_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/endpoint/graphqlendpoint"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+type observedOperation struct {
+	operationType string
+	failed        bool
+}
+
+type observedField struct {
+	parentType string
+	fieldName  string
+	failed     bool
+}
+
+type recordingObserver struct {
+	operations []observedOperation
+	fields     []observedField
+}
+
+func (observer *recordingObserver) ObserveOperation(operationType string, duration time.Duration, failed bool) {
+	observer.operations = append(observer.operations, observedOperation{operationType, failed})
+}
+
+func (observer *recordingObserver) ObserveField(parentType string, fieldName string, duration time.Duration, failed bool) {
+	observer.fields = append(observer.fields, observedField{parentType, fieldName, failed})
+}
+
+var _ = Describe("Observer behaviour", func() {
+	var (
+		mockCtrl          *gomock.Controller
+		mockTenantService *MockTenantService
+		tenantID          system.UUID
+		observer          *recordingObserver
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+		observer = &recordingObserver{}
+
+		tenantID, _ = system.RandomUUID()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should notify the observer of the executed operation and the resolved fields", func() {
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{SecretKey: "Secret Key"}, nil)
+
+		request := graphqlendpoint.Request{Query: "query readTenant {tenant(tenantID:\"" + tenantID.String() + "\"){ID __typename}}"}
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), request, mockTenantService, graphqlendpoint.Options{Observer: observer})
+
+		Expect(result.HasErrors()).To(BeFalse())
+		Expect(observer.operations).To(Equal([]observedOperation{{"query", false}}))
+		Expect(observer.fields).To(ContainElement(observedField{"RootQuery", "tenant", false}))
+		Expect(observer.fields).To(ContainElement(observedField{"Tenant", "ID", false}))
+		Expect(observer.fields).NotTo(ContainElement(observedField{"Tenant", "__typename", false}))
+	})
+
+	It("should report the failed fields and operations", func() {
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{}, errors.New("Failed"))
+
+		request := graphqlendpoint.Request{Query: "{tenant(tenantID:\"" + tenantID.String() + "\"){ID}}"}
+
+		graphqlendpoint.ExecuteRequest(context.Background(), request, mockTenantService, graphqlendpoint.Options{Observer: observer})
+
+		Expect(observer.operations).To(Equal([]observedOperation{{"query", true}}))
+		Expect(observer.fields).To(ContainElement(observedField{"RootQuery", "tenant", true}))
+	})
+
+	It("should report the operation type as unknown if the query cannot be parsed", func() {
+		request := graphqlendpoint.Request{Query: "{tenant(", OperationName: "broken"}
+
+		graphqlendpoint.ExecuteRequest(context.Background(), request, mockTenantService, graphqlendpoint.Options{Observer: observer})
+
+		Expect(observer.operations).To(Equal([]observedOperation{{"unknown", true}}))
+		Expect(observer.fields).To(BeEmpty())
+	})
+})
+
+func TestObserver(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Observer behaviour")
+}
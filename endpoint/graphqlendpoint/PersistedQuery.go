@@ -0,0 +1,215 @@
+package graphqlendpoint
+
+import (
+	"container/list"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/TenantService/config"
+)
+
+const (
+	// PersistedQueryNotFoundErrorCode is the code of the error reported when the query of the provided hash is not known.
+	// The client is expected to send the request again along with the query.
+	PersistedQueryNotFoundErrorCode = "PERSISTED_QUERY_NOT_FOUND"
+
+	// PersistedQueryNotSupportedErrorCode is the code of the error reported when a persisted query is sent while persisted
+	// queries are disabled.
+	PersistedQueryNotSupportedErrorCode = "PERSISTED_QUERY_NOT_SUPPORTED"
+
+	// PersistedQueryNotAllowedErrorCode is the code of the error reported in strict mode when the query is not in the manifest.
+	PersistedQueryNotAllowedErrorCode = "PERSISTED_QUERY_NOT_ALLOWED"
+
+	// PersistedQueryInvalidErrorCode is the code of the error reported when the persisted query extension is malformed or
+	// its hash does not match the provided query.
+	PersistedQueryInvalidErrorCode = "PERSISTED_QUERY_INVALID"
+)
+
+// persistedQueryExtension is the name of the request extension carrying the hash of the query.
+const persistedQueryExtension = "persistedQuery"
+
+// persistedQueryVersion is the only supported version of the persisted query extension.
+const persistedQueryVersion = 1
+
+// PersistedQueryStore keeps the queries that clients can execute by sending the sha256 hash of the query instead of the
+// query itself. The queries of the manifest are always known. In automatic mode, the queries sent along with their hash
+// are registered too, the least recently used ones evicted once the cache is full. In strict mode, only the queries of
+// the manifest can be executed.
+type PersistedQueryStore struct {
+	manifest  map[string]string
+	strict    bool
+	cacheSize int
+
+	mutex   sync.Mutex
+	entries map[string]*list.Element
+	recency *list.List
+}
+
+type cachedPersistedQuery struct {
+	hash  string
+	query string
+}
+
+// NewPersistedQueryStore creates a new persisted query store.
+// manifest: Optional. The queries known at startup, keyed by their lowercase hex encoded sha256 hash.
+// strict: Mandatory. If true, only the queries of the manifest can be executed.
+// cacheSize: Mandatory. The maximum number of automatic persisted queries kept in memory. Ignored in strict mode.
+// Returns the new persisted query store.
+func NewPersistedQueryStore(manifest map[string]string, strict bool, cacheSize int) *PersistedQueryStore {
+	if manifest == nil {
+		manifest = map[string]string{}
+	}
+
+	return &PersistedQueryStore{
+		manifest:  manifest,
+		strict:    strict,
+		cacheSize: cacheSize,
+		entries:   make(map[string]*list.Element),
+		recency:   list.New(),
+	}
+}
+
+// lookup returns the query of the provided hash, if known.
+func (store *PersistedQueryStore) lookup(hash string) (string, bool) {
+	if query, ok := store.manifest[hash]; ok {
+		return query, true
+	}
+
+	if store.strict {
+		return "", false
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	element, ok := store.entries[hash]
+
+	if !ok {
+		return "", false
+	}
+
+	store.recency.MoveToFront(element)
+
+	return element.Value.(cachedPersistedQuery).query, true
+}
+
+// register remembers the provided query under the provided hash, evicting the least recently used query if the cache is full.
+func (store *PersistedQueryStore) register(hash string, query string) {
+	if _, ok := store.manifest[hash]; ok || store.strict {
+		return
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if element, ok := store.entries[hash]; ok {
+		store.recency.MoveToFront(element)
+
+		return
+	}
+
+	store.entries[hash] = store.recency.PushFront(cachedPersistedQuery{hash: hash, query: query})
+
+	for store.recency.Len() > store.cacheSize {
+		oldest := store.recency.Back()
+		store.recency.Remove(oldest)
+		delete(store.entries, oldest.Value.(cachedPersistedQuery).hash)
+	}
+}
+
+// resolvePersistedQuery resolves the query of the provided request using the persisted query extension, following
+// Apollo automatic persisted query protocol. A request without a query is resolved from the hash. A request sent along
+// with its hash is registered so the following requests can omit the query. In strict mode, only the queries of the
+// manifest are accepted, whether they are sent as a hash or in full.
+// Returns either the request with its query resolved or the response reporting why the request cannot be executed.
+func resolvePersistedQuery(request Request, options Options) (Request, *graphql.Result) {
+	store := options.PersistedQueries
+	hash, provided, err := persistedQueryHash(request)
+
+	if err != nil {
+		return request, newRequestErrorResult(PersistedQueryInvalidErrorCode, err.Error())
+	}
+
+	if store == nil {
+		if provided && len(request.Query) == 0 {
+			return request, newRequestErrorResult(PersistedQueryNotSupportedErrorCode, "PersistedQueryNotSupported")
+		}
+
+		return request, nil
+	}
+
+	if !provided {
+		if store.strict {
+			if _, ok := store.manifest[config.HashPersistedQuery(request.Query)]; !ok {
+				return request, newRequestErrorResult(PersistedQueryNotAllowedErrorCode, "Only the persisted queries can be executed.")
+			}
+		}
+
+		return request, nil
+	}
+
+	if len(request.Query) == 0 {
+		query, ok := store.lookup(hash)
+
+		if !ok && store.strict {
+			return request, newRequestErrorResult(PersistedQueryNotAllowedErrorCode, "Only the persisted queries can be executed.")
+		}
+
+		if !ok {
+			return request, newRequestErrorResult(PersistedQueryNotFoundErrorCode, "PersistedQueryNotFound")
+		}
+
+		request.Query = query
+
+		return request, nil
+	}
+
+	if config.HashPersistedQuery(request.Query) != hash {
+		return request, newRequestErrorResult(PersistedQueryInvalidErrorCode, "Provided sha256Hash does not match the query.")
+	}
+
+	if _, ok := store.manifest[hash]; !ok && store.strict {
+		return request, newRequestErrorResult(PersistedQueryNotAllowedErrorCode, "Only the persisted queries can be executed.")
+	}
+
+	store.register(hash, request.Query)
+
+	return request, nil
+}
+
+// persistedQueryHash returns the hash sent in the persisted query extension of the provided request.
+// Returns either the lowercase hash and whether the extension is provided, or error if the extension is malformed.
+func persistedQueryHash(request Request) (string, bool, error) {
+	extension, provided := request.Extensions[persistedQueryExtension]
+
+	if !provided {
+		return "", false, nil
+	}
+
+	persistedQuery, ok := extension.(map[string]interface{})
+
+	if !ok {
+		return "", false, fmt.Errorf("%s extension must be an object.", persistedQueryExtension)
+	}
+
+	if version, _ := persistedQuery["version"].(float64); version != persistedQueryVersion {
+		return "", false, fmt.Errorf("%s extension version is not supported. Supported version: %d", persistedQueryExtension, persistedQueryVersion)
+	}
+
+	hash, _ := persistedQuery["sha256Hash"].(string)
+
+	if len(hash) == 0 {
+		return "", false, fmt.Errorf("%s extension must provide sha256Hash.", persistedQueryExtension)
+	}
+
+	return strings.ToLower(hash), true, nil
+}
+
+// HasPersistedQuery checks whether the provided request carries the persisted query extension, in which case the query can be omitted.
+func HasPersistedQuery(request Request) bool {
+	_, provided := request.Extensions[persistedQueryExtension]
+
+	return provided
+}
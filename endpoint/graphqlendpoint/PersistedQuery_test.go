@@ -0,0 +1,147 @@
+package graphqlendpoint_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/TenantService/endpoint/graphqlendpoint"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("Persisted queries behaviour", func() {
+	const query = "{__typename}"
+	const otherQuery = "query Other {__typename}"
+
+	var (
+		mockCtrl          *gomock.Controller
+		mockTenantService *MockTenantService
+	)
+
+	hashOf := func(query string) string {
+		hash := sha256.Sum256([]byte(query))
+
+		return hex.EncodeToString(hash[:])
+	}
+
+	persistedQueryRequest := func(query string, hash string) graphqlendpoint.Request {
+		return graphqlendpoint.Request{
+			Query:      query,
+			Extensions: map[string]interface{}{"persistedQuery": map[string]interface{}{"version": float64(1), "sha256Hash": hash}},
+		}
+	}
+
+	execute := func(request graphqlendpoint.Request, store *graphqlendpoint.PersistedQueryStore) *graphql.Result {
+		return graphqlendpoint.ExecuteRequest(context.Background(), request, mockTenantService, graphqlendpoint.Options{PersistedQueries: store})
+	}
+
+	expectExecuted := func(result *graphql.Result) {
+		Expect(result.Errors).To(BeEmpty())
+		Expect(result.Data).To(Equal(map[string]interface{}{"__typename": "RootQuery"}))
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Describe("Automatic persisted queries", func() {
+		var store *graphqlendpoint.PersistedQueryStore
+
+		BeforeEach(func() {
+			store = graphqlendpoint.NewPersistedQueryStore(nil, false, 10)
+		})
+
+		It("should report unknown hash as not found", func() {
+			result := execute(persistedQueryRequest("", hashOf(query)), store)
+
+			Expect(graphqlendpoint.IsRequestError(result)).To(BeTrue())
+			Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.PersistedQueryNotFoundErrorCode)).To(BeTrue())
+			Expect(result.Errors[0].Message).To(Equal("PersistedQueryNotFound"))
+		})
+
+		It("should execute the query sent with its hash and execute it again using the hash only", func() {
+			expectExecuted(execute(persistedQueryRequest(query, hashOf(query)), store))
+			expectExecuted(execute(persistedQueryRequest("", hashOf(query)), store))
+		})
+
+		It("should accept uppercase hashes", func() {
+			expectExecuted(execute(persistedQueryRequest(query, hashOf(query)), store))
+			expectExecuted(execute(persistedQueryRequest("", strings.ToUpper(hashOf(query))), store))
+		})
+
+		It("should reject the query if the hash does not match the query", func() {
+			result := execute(persistedQueryRequest(query, hashOf(otherQuery)), store)
+
+			Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.PersistedQueryInvalidErrorCode)).To(BeTrue())
+		})
+
+		It("should reject unsupported persisted query extension versions", func() {
+			request := graphqlendpoint.Request{
+				Query:      query,
+				Extensions: map[string]interface{}{"persistedQuery": map[string]interface{}{"version": float64(2), "sha256Hash": hashOf(query)}},
+			}
+
+			Expect(graphqlendpoint.HasErrorCode(execute(request, store), graphqlendpoint.PersistedQueryInvalidErrorCode)).To(BeTrue())
+		})
+
+		It("should evict the least recently used query once the cache is full", func() {
+			store = graphqlendpoint.NewPersistedQueryStore(nil, false, 1)
+
+			execute(persistedQueryRequest(query, hashOf(query)), store)
+			execute(persistedQueryRequest(otherQuery, hashOf(otherQuery)), store)
+
+			Expect(graphqlendpoint.HasErrorCode(execute(persistedQueryRequest("", hashOf(query)), store), graphqlendpoint.PersistedQueryNotFoundErrorCode)).To(BeTrue())
+			expectExecuted(execute(persistedQueryRequest("", hashOf(otherQuery)), store))
+		})
+
+		It("should keep executing the queries sent without hash", func() {
+			expectExecuted(execute(graphqlendpoint.Request{Query: query}, store))
+		})
+	})
+
+	Describe("Strict mode", func() {
+		var store *graphqlendpoint.PersistedQueryStore
+
+		BeforeEach(func() {
+			store = graphqlendpoint.NewPersistedQueryStore(map[string]string{hashOf(query): query}, true, 10)
+		})
+
+		It("should execute the query of the manifest using the hash only", func() {
+			expectExecuted(execute(persistedQueryRequest("", hashOf(query)), store))
+		})
+
+		It("should execute the query of the manifest sent without hash", func() {
+			expectExecuted(execute(graphqlendpoint.Request{Query: query}, store))
+		})
+
+		It("should reject the queries that are not in the manifest", func() {
+			Expect(graphqlendpoint.HasErrorCode(execute(graphqlendpoint.Request{Query: otherQuery}, store), graphqlendpoint.PersistedQueryNotAllowedErrorCode)).To(BeTrue())
+			Expect(graphqlendpoint.HasErrorCode(execute(persistedQueryRequest(otherQuery, hashOf(otherQuery)), store), graphqlendpoint.PersistedQueryNotAllowedErrorCode)).To(BeTrue())
+			Expect(graphqlendpoint.HasErrorCode(execute(persistedQueryRequest("", hashOf(otherQuery)), store), graphqlendpoint.PersistedQueryNotAllowedErrorCode)).To(BeTrue())
+		})
+	})
+
+	Describe("Disabled persisted queries", func() {
+		It("should report the requests sent with hash only as not supported", func() {
+			result := execute(persistedQueryRequest("", hashOf(query)), nil)
+
+			Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.PersistedQueryNotSupportedErrorCode)).To(BeTrue())
+		})
+	})
+})
+
+func TestPersistedQueries(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Persisted queries behaviour")
+}
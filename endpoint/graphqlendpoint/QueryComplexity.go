@@ -0,0 +1,332 @@
+package graphqlendpoint
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// listSizeEstimate is the number of items a list field is assumed to return when the cost of a query is estimated and
+// the field has no argument sizing the list.
+const listSizeEstimate = 10
+
+// defaultFieldCost is the cost of a field that is not listed in fieldCosts, leaf fields included.
+const defaultFieldCost = 1
+
+// fieldCosts defines the cost of the fields that are more expensive than a plain field, keyed by Type.field.
+var fieldCosts = map[string]int{
+	"RootQuery.tenant":                    1,
+	"RootQuery.application":               1,
+	"RootQuery.applications":              2,
+	"RootQuery.node":                      1,
+	"RootQuery.nodes":                     1,
+	"Tenant.applications":                 2,
+	"Application.tenant":                  1,
+	"RootMutation.createTenant":           5,
+	"RootMutation.updateTenant":           5,
+	"RootMutation.deleteTenant":           5,
+	"RootMutation.createApplication":      5,
+	"RootMutation.updateApplication":      5,
+	"RootMutation.deleteApplication":      5,
+	"RootSubscription.tenantChanged":      1,
+	"RootSubscription.applicationChanged": 1,
+}
+
+// itemFieldCosts defines the cost of every item processed by the bulk fields, keyed by Type.field. The cost of the field
+// is the cost of an item multiplied by the number of items.
+var itemFieldCosts = map[string]int{
+	"RootMutation.createApplications": 5,
+	"RootMutation.updateApplications": 5,
+	"RootMutation.deleteApplications": 5,
+}
+
+// listSizeArguments defines the argument holding the number of items a field returns, keyed by Type.field. The argument
+// is either the number of items or the list of the items.
+var listSizeArguments = map[string]string{
+	"RootQuery.nodes":                 "ids",
+	"Tenant.applications":             "first",
+	"RootMutation.createApplications": "applications",
+	"RootMutation.updateApplications": "applications",
+	"RootMutation.deleteApplications": "applicationIDs",
+}
+
+// queryComplexity defines the depth and the estimated cost of a query
+type queryComplexity struct {
+	depth int
+	cost  int
+}
+
+// complexityMeasurer measures the depth and the cost of the operations of a document. Every fragment is measured once
+// and its complexity reused at every spread, and the measure stops as soon as a limit is exceeded, so measuring a
+// document takes time proportional to its size.
+type complexityMeasurer struct {
+	fragments          map[string]*ast.FragmentDefinition
+	measuredFragments  map[string]queryComplexity
+	measuringFragments map[string]bool
+	variables          map[string]interface{}
+	maxDepth           int
+	maxCost            int
+}
+
+// validateQueryComplexity makes sure the operations of the provided document do not exceed the maximum depth and cost
+// provided by the options. Zero maximum means no limit.
+// document: Mandatory. The parsed query.
+// request: Mandatory. The request the document is parsed from. All operations are checked if it names no operation.
+// options: Mandatory. The options defining the limits.
+// Returns error if any of the limits is exceeded.
+func validateQueryComplexity(document *ast.Document, request Request, options Options) error {
+	if options.MaxQueryDepth <= 0 && options.MaxQueryComplexity <= 0 {
+		return nil
+	}
+
+	measurer := &complexityMeasurer{
+		fragments:          make(map[string]*ast.FragmentDefinition),
+		measuredFragments:  make(map[string]queryComplexity),
+		measuringFragments: make(map[string]bool),
+		variables:          request.Variables,
+		maxDepth:           options.MaxQueryDepth,
+		maxCost:            options.MaxQueryComplexity,
+	}
+
+	for _, definition := range document.Definitions {
+		if fragment, ok := definition.(*ast.FragmentDefinition); ok {
+			measurer.fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+
+		if !ok || (len(request.OperationName) != 0 && (operation.Name == nil || operation.Name.Value != request.OperationName)) {
+			continue
+		}
+
+		var rootType graphql.Type = tenantSchema.QueryType()
+
+		if operation.Operation == ast.OperationTypeMutation {
+			rootType = tenantSchema.MutationType()
+		} else if operation.Operation == ast.OperationTypeSubscription {
+			rootType = tenantSchema.SubscriptionType()
+		}
+
+		if _, err := measurer.measureSelectionSet(operation.SelectionSet, rootType, listSizeEstimate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// measureSelectionSet measures the depth and the cost of the provided selection set resolved against the provided parent type.
+// listSize is the number of items the list fields of the selection set are assumed to return.
+// Returns error as soon as the depth or the cost of the selection set exceeds the limits.
+func (measurer *complexityMeasurer) measureSelectionSet(selectionSet *ast.SelectionSet, parentType graphql.Type, listSize int) (queryComplexity, error) {
+	complexity := queryComplexity{}
+
+	if selectionSet == nil {
+		return complexity, nil
+	}
+
+	for _, selection := range selectionSet.Selections {
+		var selectionComplexity queryComplexity
+		var err error
+
+		switch selection := selection.(type) {
+		case *ast.Field:
+			selectionComplexity, err = measurer.measureField(selection, parentType, listSize)
+
+		case *ast.InlineFragment:
+			fragmentType := parentType
+
+			if selection.TypeCondition != nil {
+				fragmentType = tenantSchema.Type(selection.TypeCondition.Name.Value)
+			}
+
+			selectionComplexity, err = measurer.measureSelectionSet(selection.SelectionSet, fragmentType, listSize)
+
+		case *ast.FragmentSpread:
+			selectionComplexity, err = measurer.measureFragment(selection.Name.Value, listSize)
+		}
+
+		if err != nil {
+			return queryComplexity{}, err
+		}
+
+		complexity.cost += selectionComplexity.cost
+
+		if selectionComplexity.depth > complexity.depth {
+			complexity.depth = selectionComplexity.depth
+		}
+
+		if err = measurer.checkLimits(complexity); err != nil {
+			return queryComplexity{}, err
+		}
+	}
+
+	return complexity, nil
+}
+
+// measureFragment measures the depth and the cost of the fragment with the provided name, once per list size.
+// A fragment spread within itself costs nothing, the document is rejected by the validation anyway.
+func (measurer *complexityMeasurer) measureFragment(name string, listSize int) (queryComplexity, error) {
+	key := name + "/" + strconv.Itoa(listSize)
+
+	if complexity, ok := measurer.measuredFragments[key]; ok {
+		return complexity, nil
+	}
+
+	fragment, ok := measurer.fragments[name]
+
+	if !ok || measurer.measuringFragments[name] {
+		return queryComplexity{}, nil
+	}
+
+	measurer.measuringFragments[name] = true
+	complexity, err := measurer.measureSelectionSet(fragment.SelectionSet, tenantSchema.Type(fragment.TypeCondition.Name.Value), listSize)
+	delete(measurer.measuringFragments, name)
+
+	if err != nil {
+		return queryComplexity{}, err
+	}
+
+	measurer.measuredFragments[key] = complexity
+
+	return complexity, nil
+}
+
+// measureField measures the depth and the cost of the provided field and its sub selections. The cost of the sub
+// selections of a list field is multiplied by the number of items the field is assumed to return, read from the
+// argument sizing the list if the field has one. A field sizing a list it does not return itself, e.g. a connection,
+// passes the size on to the list fields of its sub selections.
+func (measurer *complexityMeasurer) measureField(field *ast.Field, parentType graphql.Type, listSize int) (queryComplexity, error) {
+	fieldDefinition := findFieldDefinition(parentType, field.Name.Value)
+
+	if fieldDefinition == nil {
+		return queryComplexity{depth: 1, cost: defaultFieldCost}, nil
+	}
+
+	key := parentType.Name() + "." + field.Name.Value
+	size, sized := measurer.readListSize(field, listSizeArguments[key])
+	_, isList := graphql.GetNullable(fieldDefinition.Type).(*graphql.List)
+
+	childListSize := listSizeEstimate
+	multiplier := 1
+
+	if isList {
+		multiplier = listSize
+
+		if sized {
+			multiplier = size
+		}
+	} else if sized {
+		childListSize = size
+	}
+
+	childComplexity, err := measurer.measureSelectionSet(field.SelectionSet, namedType(fieldDefinition.Type), childListSize)
+
+	if err != nil {
+		return queryComplexity{}, err
+	}
+
+	cost, ok := fieldCosts[key]
+
+	if !ok {
+		cost = defaultFieldCost
+	}
+
+	if itemCost, ok := itemFieldCosts[key]; ok {
+		cost = itemCost * multiplier
+	}
+
+	complexity := queryComplexity{depth: childComplexity.depth + 1, cost: cost + childComplexity.cost*multiplier}
+
+	return complexity, measurer.checkLimits(complexity)
+}
+
+// readListSize reads the number of items sized by the provided argument of the provided field, either an integer or a list.
+// Returns the number of items and true, or false if the field has no such argument or its value is not known.
+func (measurer *complexityMeasurer) readListSize(field *ast.Field, argumentName string) (int, bool) {
+	if len(argumentName) == 0 {
+		return 0, false
+	}
+
+	for _, argument := range field.Arguments {
+		if argument.Name == nil || argument.Name.Value != argumentName {
+			continue
+		}
+
+		switch value := argument.Value.(type) {
+		case *ast.IntValue:
+			if size, err := strconv.Atoi(value.Value); err == nil && size >= 0 && size <= math.MaxInt32 {
+				return size, true
+			}
+
+		case *ast.ListValue:
+			return len(value.Values), true
+
+		case *ast.Variable:
+			switch variable := measurer.variables[value.Name.Value].(type) {
+			case float64:
+				if variable >= 0 && variable <= math.MaxInt32 {
+					return int(variable), true
+				}
+
+			case int:
+				if variable >= 0 && variable <= math.MaxInt32 {
+					return variable, true
+				}
+
+			case []interface{}:
+				return len(variable), true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// checkLimits makes sure the provided complexity does not exceed the maximum depth and cost. The depth and the cost of
+// a query are never lower than the ones of any of its parts, so the measure can stop once a part exceeds a limit.
+func (measurer *complexityMeasurer) checkLimits(complexity queryComplexity) error {
+	if measurer.maxDepth > 0 && complexity.depth > measurer.maxDepth {
+		return fmt.Errorf("Query depth exceeds the maximum allowed depth %d.", measurer.maxDepth)
+	}
+
+	if measurer.maxCost > 0 && complexity.cost > measurer.maxCost {
+		return fmt.Errorf("Query cost exceeds the maximum allowed cost %d.", measurer.maxCost)
+	}
+
+	return nil
+}
+
+// findFieldDefinition finds the definition of the field with the provided name on the provided type.
+func findFieldDefinition(parentType graphql.Type, fieldName string) *graphql.FieldDefinition {
+	switch parentType := parentType.(type) {
+	case *graphql.Object:
+		return parentType.Fields()[fieldName]
+
+	case *graphql.Interface:
+		return parentType.Fields()[fieldName]
+	}
+
+	return nil
+}
+
+// namedType unwraps the provided type from its list and non-null modifiers.
+func namedType(fieldType graphql.Type) graphql.Type {
+	for {
+		switch modifiedType := fieldType.(type) {
+		case *graphql.List:
+			fieldType = modifiedType.OfType
+
+		case *graphql.NonNull:
+			fieldType = modifiedType.OfType
+
+		default:
+			return fieldType
+		}
+	}
+}
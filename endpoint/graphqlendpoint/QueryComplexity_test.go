@@ -0,0 +1,129 @@
+package graphqlendpoint_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/endpoint/graphqlendpoint"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("Query depth and complexity limits", func() {
+	var (
+		mockCtrl          *gomock.Controller
+		mockTenantService *MockTenantService
+		tenantID          system.UUID
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+
+		tenantID, _ = system.RandomUUID()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should return error without calling tenant service if query depth exceeds the maximum depth", func() {
+		query := "{tenant(tenantID:\"" + tenantID.String() + "\"){ID}}"
+		options := graphqlendpoint.Options{MaxQueryDepth: 1}
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query}, mockTenantService, options)
+		Expect(graphqlendpoint.IsRequestError(result)).To(BeTrue())
+		Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.QueryTooComplexErrorCode)).To(BeTrue())
+		Expect(result.Errors[0].Message).To(ContainSubstring("depth"))
+	})
+
+	It("should measure query depth through fragments", func() {
+		query := "query {...tenantFields} fragment tenantFields on RootQuery {tenant(tenantID:\"" + tenantID.String() + "\"){ID}}"
+		options := graphqlendpoint.Options{MaxQueryDepth: 1}
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query}, mockTenantService, options)
+		Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.QueryTooComplexErrorCode)).To(BeTrue())
+	})
+
+	It("should return error without calling tenant service if query cost exceeds the maximum cost", func() {
+		query := "{first:applications(tenantID:\"" + tenantID.String() + "\"){ID Name} second:applications(tenantID:\"" + tenantID.String() + "\"){ID Name}}"
+		options := graphqlendpoint.Options{MaxQueryComplexity: 3}
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query}, mockTenantService, options)
+		Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.QueryTooComplexErrorCode)).To(BeTrue())
+		Expect(result.Errors[0].Message).To(ContainSubstring("cost"))
+	})
+
+	It("should return error quickly if the fragments of the query expand exponentially", func() {
+		const fragmentCount = 22
+		query := "{tenant(tenantID:\"" + tenantID.String() + "\"){...F0}}"
+
+		for index := 0; index < fragmentCount; index++ {
+			next := "F" + strconv.Itoa(index+1)
+			query += " fragment F" + strconv.Itoa(index) + " on Tenant {ID ..." + next + " ..." + next + "}"
+		}
+
+		query += " fragment F" + strconv.Itoa(fragmentCount) + " on Tenant {ID}"
+		options := graphqlendpoint.Options{MaxQueryDepth: 10, MaxQueryComplexity: 100}
+
+		start := time.Now()
+		result := graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query}, mockTenantService, options)
+
+		Expect(time.Since(start)).To(BeNumerically("<", 100*time.Millisecond))
+		Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.QueryTooComplexErrorCode)).To(BeTrue())
+	})
+
+	It("should size the lists using the arguments of the fields", func() {
+		ids := make([]interface{}, 12)
+
+		for index := range ids {
+			ids[index] = "unknown" + strconv.Itoa(index)
+		}
+
+		query := "query($ids:[ID!]!){nodes(ids:$ids){id}}"
+		options := graphqlendpoint.Options{MaxQueryComplexity: 12}
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query, Variables: map[string]interface{}{"ids": ids}}, mockTenantService, options)
+		Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.QueryTooComplexErrorCode)).To(BeTrue())
+
+		result = graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query, Variables: map[string]interface{}{"ids": ids[:11]}}, mockTenantService, options)
+		Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.QueryTooComplexErrorCode)).To(BeFalse())
+	})
+
+	It("should size the connections using their first argument", func() {
+		query := "{tenant(tenantID:\"" + tenantID.String() + "\"){applications(first:50){edges{node{ID}}}}}"
+		options := graphqlendpoint.Options{MaxQueryComplexity: 100}
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query}, mockTenantService, options)
+		Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.QueryTooComplexErrorCode)).To(BeTrue())
+	})
+
+	It("should cost the bulk mutations by the number of items", func() {
+		query := "mutation{deleteApplications(tenantID:\"" + tenantID.String() + "\", applicationIDs:[\"1\",\"2\",\"3\",\"4\"]){applicationID}}"
+		options := graphqlendpoint.Options{MaxQueryComplexity: 20}
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query}, mockTenantService, options)
+		Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.QueryTooComplexErrorCode)).To(BeTrue())
+	})
+
+	It("should execute the query if it is within the limits", func() {
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{SecretKey: "Secret Key"}, nil)
+
+		query := "{tenant(tenantID:\"" + tenantID.String() + "\"){ID}}"
+		options := graphqlendpoint.Options{MaxQueryDepth: 2, MaxQueryComplexity: 2}
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query}, mockTenantService, options)
+		Expect(result.HasErrors()).To(BeFalse())
+	})
+})
+
+func TestQueryComplexity(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Query depth and complexity limits")
+}
@@ -0,0 +1,108 @@
+package graphqlendpoint_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/endpoint/graphqlendpoint"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("ExecuteRequest method behaviour", func() {
+	var (
+		mockCtrl          *gomock.Controller
+		mockTenantService *MockTenantService
+		tenantID          system.UUID
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+
+		tenantID, _ = system.RandomUUID()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should use the provided variables to execute the query", func() {
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{SecretKey: "Secret Key"}, nil)
+
+		request := graphqlendpoint.Request{
+			Query:     "query ($tenantID: String!) {tenant(tenantID: $tenantID){ID}}",
+			Variables: map[string]interface{}{"tenantID": tenantID.String()},
+		}
+
+		expectedResult := &graphql.Result{
+			Data: map[string]interface{}{
+				"tenant": map[string]interface{}{
+					"ID": tenantID.String(),
+				},
+			},
+		}
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), request, mockTenantService, graphqlendpoint.Options{})
+		Expect(result).To(Equal(expectedResult))
+	})
+
+	It("should execute only the operation with the provided operation name", func() {
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{SecretKey: "Secret Key"}, nil)
+
+		request := graphqlendpoint.Request{
+			Query: "query readTenant {tenant(tenantID:\"" + tenantID.String() + "\"){ID}}" +
+				" mutation removeTenant {deleteTenant(tenantID:\"" + tenantID.String() + "\")}",
+			OperationName: "readTenant",
+			QueryOnly:     true,
+		}
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), request, mockTenantService, graphqlendpoint.Options{})
+		Expect(result.HasErrors()).To(BeFalse())
+	})
+
+	It("should return error without calling tenant service if a mutation is sent in a query only request", func() {
+		request := graphqlendpoint.Request{
+			Query:     "mutation {deleteTenant(tenantID:\"" + tenantID.String() + "\")}",
+			QueryOnly: true,
+		}
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), request, mockTenantService, graphqlendpoint.Options{})
+		Expect(graphqlendpoint.IsRequestError(result)).To(BeTrue())
+		Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.MutationNotAllowedErrorCode)).To(BeTrue())
+	})
+
+	It("should return the data resolved successfully along with the errors of the fields that failed", func() {
+		missingTenantID, _ := system.RandomUUID()
+
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{SecretKey: "Secret Key"}, nil)
+		mockTenantService.EXPECT().ReadTenant(missingTenantID).Return(domain.Tenant{}, errors.New("Tenant not found."))
+
+		request := graphqlendpoint.Request{
+			Query: "{existing:tenant(tenantID:\"" + tenantID.String() + "\"){ID}" +
+				" missing:tenant(tenantID:\"" + missingTenantID.String() + "\"){ID}}",
+		}
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), request, mockTenantService, graphqlendpoint.Options{})
+		Expect(graphqlendpoint.IsRequestError(result)).To(BeFalse())
+		Expect(result.Data).To(Equal(map[string]interface{}{
+			"existing": map[string]interface{}{"ID": tenantID.String()},
+			"missing":  nil,
+		}))
+		Expect(result.Errors).To(HaveLen(1))
+		Expect(result.Errors[0].Message).To(Equal("Tenant not found."))
+		Expect(result.Errors[0].Path).To(Equal([]interface{}{"missing"}))
+		Expect(result.Errors[0].Locations).NotTo(BeEmpty())
+	})
+})
+
+func TestExecuteRequest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ExecuteRequest method behaviour")
+}
@@ -0,0 +1,246 @@
+package graphqlendpoint
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+)
+
+// builtInScalars are the scalars defined by the GraphQL specification, they are not printed.
+var builtInScalars = map[string]bool{"String": true, "Int": true, "Float": true, "Boolean": true, "ID": true}
+
+// PrintSchema prints the schema of the API in the GraphQL schema definition language. Types, fields, arguments and enum
+// values are sorted by name so the output only changes when the schema changes.
+// Returns the schema definition.
+func PrintSchema() string {
+	definitions := []string{printSchemaDefinition(tenantSchema)}
+	typeMap := tenantSchema.TypeMap()
+	typeNames := make([]string, 0, len(typeMap))
+
+	for typeName := range typeMap {
+		if !strings.HasPrefix(typeName, "__") && !builtInScalars[typeName] {
+			typeNames = append(typeNames, typeName)
+		}
+	}
+
+	sort.Strings(typeNames)
+
+	for _, typeName := range typeNames {
+		definitions = append(definitions, printTypeDefinition(typeMap[typeName]))
+	}
+
+	return strings.Join(definitions, "\n\n") + "\n"
+}
+
+func printSchemaDefinition(schema graphql.Schema) string {
+	operationTypes := []string{"  query: " + schema.QueryType().Name()}
+
+	if schema.MutationType() != nil {
+		operationTypes = append(operationTypes, "  mutation: "+schema.MutationType().Name())
+	}
+
+	if schema.SubscriptionType() != nil {
+		operationTypes = append(operationTypes, "  subscription: "+schema.SubscriptionType().Name())
+	}
+
+	return "schema {\n" + strings.Join(operationTypes, "\n") + "\n}"
+}
+
+func printTypeDefinition(namedType graphql.Type) string {
+	switch namedType := namedType.(type) {
+	case *graphql.Object:
+		implements := ""
+
+		if interfaces := namedType.Interfaces(); len(interfaces) != 0 {
+			interfaceNames := make([]string, 0, len(interfaces))
+
+			for _, implementedInterface := range interfaces {
+				interfaceNames = append(interfaceNames, implementedInterface.Name())
+			}
+
+			implements = " implements " + strings.Join(interfaceNames, " & ")
+		}
+
+		return printDescription(namedType.Description(), "") + "type " + namedType.Name() + implements + printFields(namedType.Fields())
+
+	case *graphql.Interface:
+		return printDescription(namedType.Description(), "") + "interface " + namedType.Name() + printFields(namedType.Fields())
+
+	case *graphql.Union:
+		memberNames := make([]string, 0, len(namedType.Types()))
+
+		for _, member := range namedType.Types() {
+			memberNames = append(memberNames, member.Name())
+		}
+
+		return printDescription(namedType.Description(), "") + "union " + namedType.Name() + " = " + strings.Join(memberNames, " | ")
+
+	case *graphql.Enum:
+		values := append([]*graphql.EnumValueDefinition{}, namedType.Values()...)
+		lines := make([]string, 0, len(values))
+
+		sort.Slice(values, func(i, j int) bool { return values[i].Name < values[j].Name })
+
+		for _, value := range values {
+			lines = append(lines, printDescription(value.Description, "  ")+"  "+value.Name+printDeprecation(value.DeprecationReason))
+		}
+
+		return printDescription(namedType.Description(), "") + "enum " + namedType.Name() + " {\n" + strings.Join(lines, "\n") + "\n}"
+
+	case *graphql.InputObject:
+		fields := namedType.Fields()
+		fieldNames := make([]string, 0, len(fields))
+		lines := make([]string, 0, len(fields))
+
+		for fieldName := range fields {
+			fieldNames = append(fieldNames, fieldName)
+		}
+
+		sort.Strings(fieldNames)
+
+		for _, fieldName := range fieldNames {
+			field := fields[fieldName]
+			lines = append(lines, printDescription(field.Description(), "  ")+"  "+printInputValue(fieldName, field.Type, field.DefaultValue))
+		}
+
+		return printDescription(namedType.Description(), "") + "input " + namedType.Name() + " {\n" + strings.Join(lines, "\n") + "\n}"
+
+	case *graphql.Scalar:
+		return printDescription(namedType.Description(), "") + "scalar " + namedType.Name()
+	}
+
+	return ""
+}
+
+func printFields(fields graphql.FieldDefinitionMap) string {
+	fieldNames := make([]string, 0, len(fields))
+	lines := make([]string, 0, len(fields))
+
+	for fieldName := range fields {
+		fieldNames = append(fieldNames, fieldName)
+	}
+
+	sort.Strings(fieldNames)
+
+	for _, fieldName := range fieldNames {
+		field := fields[fieldName]
+		lines = append(lines, printDescription(field.Description, "  ")+"  "+fieldName+printArguments(field.Args)+": "+field.Type.String()+printDeprecation(field.DeprecationReason))
+	}
+
+	return " {\n" + strings.Join(lines, "\n") + "\n}"
+}
+
+// printArguments prints the provided arguments on a single line, or one per line if any of them has a description.
+func printArguments(arguments []*graphql.Argument) string {
+	if len(arguments) == 0 {
+		return ""
+	}
+
+	sorted := append([]*graphql.Argument{}, arguments...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name() < sorted[j].Name() })
+
+	described := false
+	printed := make([]string, 0, len(sorted))
+
+	for _, argument := range sorted {
+		described = described || len(argument.Description()) != 0
+	}
+
+	for _, argument := range sorted {
+		if described {
+			printed = append(printed, printDescription(argument.Description(), "    ")+"    "+printInputValue(argument.Name(), argument.Type, argument.DefaultValue))
+		} else {
+			printed = append(printed, printInputValue(argument.Name(), argument.Type, argument.DefaultValue))
+		}
+	}
+
+	if described {
+		return "(\n" + strings.Join(printed, "\n") + "\n  )"
+	}
+
+	return "(" + strings.Join(printed, ", ") + ")"
+}
+
+func printInputValue(name string, inputType graphql.Input, defaultValue interface{}) string {
+	if defaultValue == nil {
+		return name + ": " + inputType.String()
+	}
+
+	return name + ": " + inputType.String() + " = " + printValue(defaultValue, inputType)
+}
+
+// printValue prints the provided Go value as a GraphQL literal of the provided input type.
+func printValue(value interface{}, inputType graphql.Input) string {
+	switch inputType := graphql.GetNullable(inputType).(type) {
+	case *graphql.Enum:
+		for _, enumValue := range inputType.Values() {
+			if reflect.DeepEqual(enumValue.Value, value) {
+				return enumValue.Name
+			}
+		}
+
+	case *graphql.List:
+		if items := reflect.ValueOf(value); items.Kind() == reflect.Slice {
+			printed := make([]string, 0, items.Len())
+
+			for index := 0; index < items.Len(); index++ {
+				printed = append(printed, printValue(items.Index(index).Interface(), inputType.OfType))
+			}
+
+			return "[" + strings.Join(printed, ", ") + "]"
+		}
+	}
+
+	switch value := value.(type) {
+	case string:
+		encoded, _ := json.Marshal(value)
+
+		return string(encoded)
+
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		printed := make([]string, 0, len(value))
+
+		for key := range value {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			printed = append(printed, key+": "+printValue(value[key], nil))
+		}
+
+		return "{" + strings.Join(printed, ", ") + "}"
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+func printDescription(description string, indentation string) string {
+	if len(description) == 0 {
+		return ""
+	}
+
+	description = strings.Replace(description, `"""`, `\"""`, -1)
+
+	if !strings.Contains(description, "\n") {
+		return indentation + `"""` + description + `"""` + "\n"
+	}
+
+	return indentation + `"""` + "\n" + indentation + strings.Replace(description, "\n", "\n"+indentation, -1) + "\n" + indentation + `"""` + "\n"
+}
+
+func printDeprecation(reason string) string {
+	if len(reason) == 0 {
+		return ""
+	}
+
+	encoded, _ := json.Marshal(reason)
+
+	return " @deprecated(reason: " + string(encoded) + ")"
+}
@@ -0,0 +1,84 @@
+package graphqlendpoint_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/TenantService/endpoint/graphqlendpoint"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+// schemaGoldenFilePath is the path to the expected schema. Run the tests with -update-schema to accept a schema change.
+const schemaGoldenFilePath = "testdata/schema.graphql"
+
+var updateSchemaGoldenFile = flag.Bool("update-schema", false, "Overwrites the schema golden file with the current schema.")
+
+var _ = Describe("Schema behaviour", func() {
+	var (
+		mockCtrl          *gomock.Controller
+		mockTenantService *MockTenantService
+	)
+
+	execute := func(query string, options graphqlendpoint.Options) *graphql.Result {
+		return graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query}, mockTenantService, options)
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should match the schema golden file", func() {
+		schema := graphqlendpoint.PrintSchema()
+
+		if *updateSchemaGoldenFile {
+			Expect(ioutil.WriteFile(schemaGoldenFilePath, []byte(schema), 0644)).To(Succeed())
+		}
+
+		expectedSchema, err := ioutil.ReadFile(schemaGoldenFilePath)
+
+		Expect(err).To(BeNil())
+		Expect(schema).To(Equal(string(expectedSchema)), "The schema changed. Make sure the change is backward compatible and run the tests with -update-schema to accept it.")
+	})
+
+	It("should print the same schema every time", func() {
+		Expect(graphqlendpoint.PrintSchema()).To(Equal(graphqlendpoint.PrintSchema()))
+	})
+
+	It("should answer introspection queries by default", func() {
+		result := execute("{__schema {queryType {name}}}", graphqlendpoint.Options{})
+
+		Expect(result.HasErrors()).To(BeFalse())
+		Expect(result.Data).To(Equal(map[string]interface{}{"__schema": map[string]interface{}{"queryType": map[string]interface{}{"name": "RootQuery"}}}))
+	})
+
+	It("should reject introspection queries when introspection is disabled", func() {
+		options := graphqlendpoint.Options{IntrospectionDisabled: true}
+
+		Expect(graphqlendpoint.HasErrorCode(execute("{__schema {queryType {name}}}", options), graphqlendpoint.IntrospectionDisabledErrorCode)).To(BeTrue())
+		Expect(graphqlendpoint.HasErrorCode(execute("{__type(name: \"Tenant\") {name}}", options), graphqlendpoint.IntrospectionDisabledErrorCode)).To(BeTrue())
+		Expect(graphqlendpoint.HasErrorCode(execute("query {...Introspection} fragment Introspection on RootQuery {__schema {types {name}}}", options), graphqlendpoint.IntrospectionDisabledErrorCode)).To(BeTrue())
+	})
+
+	It("should keep resolving __typename when introspection is disabled", func() {
+		result := execute("{__typename}", graphqlendpoint.Options{IntrospectionDisabled: true})
+
+		Expect(result.HasErrors()).To(BeFalse())
+		Expect(result.Data).To(Equal(map[string]interface{}{"__typename": "RootQuery"}))
+	})
+})
+
+func TestSchema(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Schema behaviour")
+}
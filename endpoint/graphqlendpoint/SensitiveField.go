@@ -0,0 +1,57 @@
+package graphqlendpoint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/TenantService/endpoint/security"
+)
+
+// SensitiveFieldPolicy defines how a sensitive field is resolved for a caller without the required permission
+type SensitiveFieldPolicy int
+
+const (
+	// MaskSensitiveField resolves the sensitive field to a masked value.
+	MaskSensitiveField SensitiveFieldPolicy = iota
+
+	// RejectSensitiveField resolves the sensitive field to null and reports a field error.
+	RejectSensitiveField
+)
+
+const maskedValue = "********"
+
+// sensitiveField marks the provided field as sensitive. The field is resolved only for the principals that are explicitly
+// granted the provided permission, other callers get either a masked value or a field error depending on the sensitive
+// field policy used to execute the query.
+// field: Mandatory. The field to protect.
+// permission: Mandatory. The permission required to resolve the field.
+// Returns the protected field.
+func sensitiveField(field *graphql.Field, permission string) *graphql.Field {
+	field.Description = strings.TrimSpace(fmt.Sprintf("%s Sensitive, requires %s permission.", field.Description, permission))
+
+	resolve := field.Resolve
+
+	if resolve == nil {
+		resolve = graphql.DefaultResolveFn
+	}
+
+	field.Resolve = func(resolveParams graphql.ResolveParams) (interface{}, error) {
+		if principal, authenticated := security.PrincipalFromContext(resolveParams.Context); authenticated && principal.HasPermission(permission) {
+			return resolve(resolveParams)
+		}
+
+		executionContext := resolveParams.Context.Value("ExecutionContext").(executionContext)
+
+		if executionContext.options.SensitiveFieldPolicy == RejectSensitiveField {
+			return nil, codedError{
+				code:    ForbiddenErrorCode,
+				message: fmt.Sprintf("Not authorized to read %s field. Required permission: %s", resolveParams.Info.FieldName, permission),
+			}
+		}
+
+		return maskedValue, nil
+	}
+
+	return field
+}
@@ -0,0 +1,211 @@
+package graphqlendpoint
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/contract"
+	"github.com/micro-business/TenantService/business/event"
+	"github.com/micro-business/TenantService/endpoint/security"
+	"golang.org/x/net/context"
+)
+
+// defaultSubscriptionBufferSize is the number of events a subscription can hold when the options do not provide the size.
+const defaultSubscriptionBufferSize = 64
+
+// subscriptionPayload is the root value each event of a subscription is resolved against. Every event is resolved as a
+// separate response with its own loaders so the data of an event is never served from the cache of a previous one.
+type subscriptionPayload struct {
+	event      event.Event
+	overflowed bool
+	loaders    *requestLoaders
+}
+
+var changeTypeEnum = graphql.NewEnum(
+	graphql.EnumConfig{
+		Name:        "ChangeType",
+		Description: "The kind of change made to a tenant or an application",
+		Values: graphql.EnumValueConfigMap{
+			"CREATED": &graphql.EnumValueConfig{Value: "CREATED"},
+			"UPDATED": &graphql.EnumValueConfig{Value: "UPDATED"},
+			"DELETED": &graphql.EnumValueConfig{Value: "DELETED"},
+		},
+	},
+)
+
+var changeTypes = map[event.Type]string{
+	event.TenantCreated:      "CREATED",
+	event.TenantUpdated:      "UPDATED",
+	event.TenantDeleted:      "DELETED",
+	event.ApplicationCreated: "CREATED",
+	event.ApplicationUpdated: "UPDATED",
+	event.ApplicationDeleted: "DELETED",
+}
+
+// Subscribe executes the provided request and delivers the responses on the returned channel. A subscription operation
+// delivers a response for every matching event until the provided context is cancelled, queries and mutations deliver a
+// single response. The channel is closed once no more responses are delivered. The caller must read the channel until it
+// is closed, also after cancelling the context.
+// ctx: Mandatory. The context of the subscription, cancelling it ends the subscription.
+// request: Mandatory. The request to execute.
+// tenantService: Mandatory. The tenant service used to resolve the fields, bound to the provided context.
+// eventBus: Mandatory. The bus the change events are published on.
+// options: Mandatory. The options used to execute the request.
+// Returns the channel the responses are delivered on.
+func Subscribe(ctx context.Context, request Request, tenantService contract.TenantService, eventBus *event.Bus, options Options) <-chan *graphql.Result {
+	request, result := resolvePersistedQuery(request, options)
+
+	if result != nil {
+		return newSingleResultChannel(result)
+	}
+
+	document, err := parser.Parse(parser.ParseParams{Source: request.Query})
+
+	if err != nil || !containsOperation(document, request.OperationName, ast.OperationTypeSubscription) {
+		return newSingleResultChannel(executeResolvedRequest(ctx, request, tenantService, options))
+	}
+
+	if err = validateQueryComplexity(document, request, options); err != nil {
+		return newSingleResultChannel(newRequestErrorResult(QueryTooComplexErrorCode, err.Error()))
+	}
+
+	if !selectsSingleRootField(document, request.OperationName) {
+		return newSingleResultChannel(newRequestErrorResult(SubscriptionNotAllowedErrorCode, "Subscriptions must select exactly one root field."))
+	}
+
+	tenantService = tenantService.WithContext(ctx)
+
+	return graphql.Subscribe(
+		graphql.Params{
+			Schema:         tenantSchema,
+			RequestString:  request.Query,
+			VariableValues: request.Variables,
+			OperationName:  request.OperationName,
+			Context:        context.WithValue(ctx, "ExecutionContext", executionContext{tenantService, options, nil, eventBus}),
+		})
+}
+
+// subscribeToTenantEvents subscribes to the events of the provided tenant that match the provided filter. The events are
+// delivered on the returned channel until the context of the subscription is cancelled. If the subscriber cannot keep up
+// with the events, a last payload flagged as overflowed is delivered before the channel is closed.
+func subscribeToTenantEvents(resolveParams graphql.ResolveParams, filter func(event.Event) bool) (interface{}, error) {
+	tenantIDArg, _ := resolveParams.Args["tenantID"].(string)
+
+	tenantID, err := system.ParseUUID(tenantIDArg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// An authenticated caller can only subscribe to the changes of the tenant bound to its certificate, a caller bound to
+	// no tenant cannot subscribe at all. The anonymous callers are only let through when the transport allows them.
+	if principal, authenticated := security.PrincipalFromContext(resolveParams.Context); authenticated && principal.TenantID != tenantID.String() {
+		return nil, codedError{code: ForbiddenErrorCode, message: fmt.Sprintf("Not authorized to subscribe to the changes of the tenant. Tenant ID: %s", tenantID.String())}
+	}
+
+	executionContext := resolveParams.Context.Value("ExecutionContext").(executionContext)
+
+	bufferSize := executionContext.options.SubscriptionBufferSize
+
+	if bufferSize <= 0 {
+		bufferSize = defaultSubscriptionBufferSize
+	}
+
+	subscription := executionContext.eventBus.Subscribe(bufferSize)
+	payloads := make(chan interface{})
+
+	go func() {
+		defer close(payloads)
+		defer subscription.Close()
+
+		for {
+			select {
+			case <-resolveParams.Context.Done():
+				return
+
+			case changeEvent, more := <-subscription.Events():
+				if !more {
+					if subscription.Overflowed() {
+						select {
+						case payloads <- subscriptionPayload{overflowed: true}:
+						case <-resolveParams.Context.Done():
+						}
+					}
+
+					return
+				}
+
+				if changeEvent.TenantID != tenantID || !filter(changeEvent) {
+					continue
+				}
+
+				select {
+				case payloads <- subscriptionPayload{event: changeEvent, loaders: newRequestLoaders(executionContext.tenantService)}:
+				case <-resolveParams.Context.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return payloads, nil
+}
+
+// resolveSubscriptionPayload returns the event of the payload being resolved or error if the subscriber could not keep up with the events.
+func resolveSubscriptionPayload(resolveParams graphql.ResolveParams) (event.Event, error) {
+	payload, ok := resolveParams.Source.(subscriptionPayload)
+
+	if !ok {
+		return event.Event{}, codedError{code: SubscriptionNotAllowedErrorCode, message: "Subscriptions can only be sent over WebSocket."}
+	}
+
+	if payload.overflowed {
+		return event.Event{}, codedError{
+			code:    SubscriptionOverflowErrorCode,
+			message: "The subscriber could not keep up with the events and some events were dropped. Subscribe again and reload the data.",
+		}
+	}
+
+	return payload.event, nil
+}
+
+// requestLoadersOf returns the loaders of the response being resolved.
+func requestLoadersOf(resolveParams graphql.ResolveParams) *requestLoaders {
+	if payload, ok := resolveParams.Info.RootValue.(subscriptionPayload); ok {
+		return payload.loaders
+	}
+
+	return resolveParams.Context.Value("ExecutionContext").(executionContext).loaders
+}
+
+// selectsSingleRootField checks whether the operation to execute selects exactly one root field.
+func selectsSingleRootField(document *ast.Document, operationName string) bool {
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+
+		if !ok || (len(operationName) != 0 && (operation.Name == nil || operation.Name.Value != operationName)) {
+			continue
+		}
+
+		if operation.SelectionSet == nil || len(operation.SelectionSet.Selections) != 1 {
+			return false
+		}
+
+		if _, isField := operation.SelectionSet.Selections[0].(*ast.Field); !isField {
+			return false
+		}
+	}
+
+	return true
+}
+
+func newSingleResultChannel(result *graphql.Result) <-chan *graphql.Result {
+	results := make(chan *graphql.Result, 1)
+	results <- result
+	close(results)
+
+	return results
+}
@@ -0,0 +1,229 @@
+package graphqlendpoint_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/business/event"
+	"github.com/micro-business/TenantService/endpoint/graphqlendpoint"
+	"github.com/micro-business/TenantService/endpoint/security"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("Subscribe method behaviour", func() {
+	var (
+		mockCtrl          *gomock.Controller
+		mockTenantService *MockTenantService
+		eventBus          *event.Bus
+		tenantID          system.UUID
+		ctx               context.Context
+		cancel            context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+		eventBus = event.NewBus()
+
+		tenantID, _ = system.RandomUUID()
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+		mockCtrl.Finish()
+	})
+
+	// publishUntilReceived publishes the provided event until the subscription, started asynchronously, delivers a response.
+	publishUntilReceived := func(results <-chan *graphql.Result, changeEvent event.Event) *graphql.Result {
+		for {
+			eventBus.Publish(changeEvent)
+
+			select {
+			case result := <-results:
+				return result
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+
+	It("should deliver the changes of the subscribed tenant", func() {
+		request := graphqlendpoint.Request{Query: "subscription {tenantChanged(tenantID:\"" + tenantID.String() + "\"){type tenantID tenant{ID}}}"}
+
+		results := graphqlendpoint.Subscribe(ctx, request, mockTenantService, eventBus, graphqlendpoint.Options{})
+
+		result := publishUntilReceived(results, event.Event{Type: event.TenantUpdated, TenantID: tenantID, Tenant: domain.Tenant{SecretKey: "Secret Key"}})
+
+		Expect(result.HasErrors()).To(BeFalse())
+		Expect(result.Data).To(Equal(map[string]interface{}{
+			"tenantChanged": map[string]interface{}{
+				"type":     "UPDATED",
+				"tenantID": tenantID.String(),
+				"tenant":   map[string]interface{}{"ID": tenantID.String()},
+			},
+		}))
+	})
+
+	It("should not deliver the changes of other tenants or of the applications to tenant subscribers", func() {
+		otherTenantID, _ := system.RandomUUID()
+		applicationID, _ := system.RandomUUID()
+
+		request := graphqlendpoint.Request{Query: "subscription {tenantChanged(tenantID:\"" + tenantID.String() + "\"){type}}"}
+
+		results := graphqlendpoint.Subscribe(ctx, request, mockTenantService, eventBus, graphqlendpoint.Options{})
+
+		for {
+			eventBus.Publish(event.Event{Type: event.TenantUpdated, TenantID: otherTenantID})
+			eventBus.Publish(event.Event{Type: event.ApplicationCreated, TenantID: tenantID, ApplicationID: applicationID})
+			eventBus.Publish(event.Event{Type: event.TenantDeleted, TenantID: tenantID})
+
+			select {
+			case result := <-results:
+				Expect(result.Data).To(Equal(map[string]interface{}{"tenantChanged": map[string]interface{}{"type": "DELETED"}}))
+
+				return
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	})
+
+	It("should deliver the application changes of the subscribed tenant and resolve the nested fields", func() {
+		applicationID, _ := system.RandomUUID()
+
+		mockTenantService.EXPECT().ReadTenants([]system.UUID{tenantID}).Return(map[system.UUID]domain.Tenant{tenantID: {SecretKey: "Secret Key"}}, nil)
+
+		request := graphqlendpoint.Request{Query: "subscription {applicationChanged(tenantID:\"" + tenantID.String() + "\"){type applicationID application{Name tenant{ID}}}}"}
+
+		results := graphqlendpoint.Subscribe(ctx, request, mockTenantService, eventBus, graphqlendpoint.Options{})
+
+		result := publishUntilReceived(results, event.Event{
+			Type:          event.ApplicationCreated,
+			TenantID:      tenantID,
+			ApplicationID: applicationID,
+			Application:   domain.Application{Name: "Application"},
+		})
+
+		Expect(result.HasErrors()).To(BeFalse())
+		Expect(result.Data).To(Equal(map[string]interface{}{
+			"applicationChanged": map[string]interface{}{
+				"type":          "CREATED",
+				"applicationID": applicationID.String(),
+				"application": map[string]interface{}{
+					"Name":   "Application",
+					"tenant": map[string]interface{}{"ID": tenantID.String()},
+				},
+			},
+		}))
+	})
+
+	It("should report an error and end the subscription when the subscriber cannot keep up with the events", func() {
+		request := graphqlendpoint.Request{Query: "subscription {tenantChanged(tenantID:\"" + tenantID.String() + "\"){type}}"}
+
+		results := graphqlendpoint.Subscribe(ctx, request, mockTenantService, eventBus, graphqlendpoint.Options{SubscriptionBufferSize: 1})
+
+		var result *graphql.Result
+
+		Eventually(func() bool {
+			for index := 0; index < 10; index++ {
+				eventBus.Publish(event.Event{Type: event.TenantUpdated, TenantID: tenantID})
+			}
+
+			for {
+				select {
+				case result = <-results:
+					if result == nil || graphqlendpoint.HasErrorCode(result, graphqlendpoint.SubscriptionOverflowErrorCode) {
+						return true
+					}
+				default:
+					return false
+				}
+			}
+		}).Should(BeTrue())
+
+		Expect(result).NotTo(BeNil())
+		Eventually(results).Should(BeClosed())
+	})
+
+	It("should end the subscription when the context is cancelled", func() {
+		request := graphqlendpoint.Request{Query: "subscription {tenantChanged(tenantID:\"" + tenantID.String() + "\"){type}}"}
+
+		results := graphqlendpoint.Subscribe(ctx, request, mockTenantService, eventBus, graphqlendpoint.Options{})
+
+		cancel()
+
+		Eventually(results).Should(BeClosed())
+	})
+
+	It("should return error if the principal acts on behalf of another tenant", func() {
+		otherTenantID, _ := system.RandomUUID()
+		principalCtx := security.WithPrincipal(ctx, security.Principal{Name: "gateway", TenantID: otherTenantID.String()})
+
+		request := graphqlendpoint.Request{Query: "subscription {tenantChanged(tenantID:\"" + tenantID.String() + "\"){type}}"}
+
+		result := <-graphqlendpoint.Subscribe(principalCtx, request, mockTenantService, eventBus, graphqlendpoint.Options{})
+
+		Expect(result.HasErrors()).To(BeTrue())
+	})
+
+	It("should return error if the principal is not bound to any tenant", func() {
+		principalCtx := security.WithPrincipal(ctx, security.Principal{Name: "gateway"})
+
+		request := graphqlendpoint.Request{Query: "subscription {tenantChanged(tenantID:\"" + tenantID.String() + "\"){type}}"}
+
+		result := <-graphqlendpoint.Subscribe(principalCtx, request, mockTenantService, eventBus, graphqlendpoint.Options{})
+
+		Expect(result.HasErrors()).To(BeTrue())
+	})
+
+	It("should deliver the changes of the tenant the principal is bound to", func() {
+		principalCtx := security.WithPrincipal(ctx, security.Principal{Name: "gateway", TenantID: tenantID.String()})
+
+		request := graphqlendpoint.Request{Query: "subscription {tenantChanged(tenantID:\"" + tenantID.String() + "\"){type}}"}
+
+		results := graphqlendpoint.Subscribe(principalCtx, request, mockTenantService, eventBus, graphqlendpoint.Options{})
+		result := publishUntilReceived(results, event.Event{Type: event.TenantUpdated, TenantID: tenantID})
+
+		Expect(result.HasErrors()).To(BeFalse())
+	})
+
+	It("should return error if more than one root field is selected", func() {
+		request := graphqlendpoint.Request{Query: "subscription {tenantChanged(tenantID:\"" + tenantID.String() + "\"){type} applicationChanged(tenantID:\"" + tenantID.String() + "\"){type}}"}
+
+		result := <-graphqlendpoint.Subscribe(ctx, request, mockTenantService, eventBus, graphqlendpoint.Options{})
+
+		Expect(graphqlendpoint.IsRequestError(result)).To(BeTrue())
+		Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.SubscriptionNotAllowedErrorCode)).To(BeTrue())
+	})
+
+	It("should execute queries as a single response", func() {
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{SecretKey: "Secret Key"}, nil)
+
+		request := graphqlendpoint.Request{Query: "{tenant(tenantID:\"" + tenantID.String() + "\"){ID}}"}
+
+		results := graphqlendpoint.Subscribe(ctx, request, mockTenantService, eventBus, graphqlendpoint.Options{})
+
+		Expect(<-results).To(Equal(&graphql.Result{Data: map[string]interface{}{"tenant": map[string]interface{}{"ID": tenantID.String()}}}))
+		Eventually(results).Should(BeClosed())
+	})
+
+	It("should reject subscriptions sent to ExecuteRequest", func() {
+		request := graphqlendpoint.Request{Query: "subscription {tenantChanged(tenantID:\"" + tenantID.String() + "\"){type}}"}
+
+		result := graphqlendpoint.ExecuteRequest(ctx, request, mockTenantService, graphqlendpoint.Options{})
+
+		Expect(graphqlendpoint.IsRequestError(result)).To(BeTrue())
+		Expect(graphqlendpoint.HasErrorCode(result, graphqlendpoint.SubscriptionNotAllowedErrorCode)).To(BeTrue())
+	})
+})
+
+func TestSubscribe(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Subscribe method behaviour")
+}
@@ -0,0 +1,55 @@
+package graphqlendpoint
+
+import (
+	"errors"
+
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/Micro-Business-Core/system"
+)
+
+func getTenantApplicationsField() *graphql.Field {
+	return &graphql.Field{
+		Type:        graphql.NewNonNull(applicationConnectionType),
+		Description: "Returns a page of the applications registered for the tenant",
+		Args: graphql.FieldConfigArgument{
+			"first": &graphql.ArgumentConfig{
+				Type: graphql.Int,
+			},
+			"after": &graphql.ArgumentConfig{
+				Type: graphql.String,
+			},
+		},
+
+		Resolve: func(resolveParams graphql.ResolveParams) (interface{}, error) {
+			source, _ := resolveParams.Source.(tenant)
+
+			tenantID, err := system.ParseUUID(source.ID)
+
+			if err != nil {
+				return nil, err
+			}
+
+			first, firstProvided := resolveParams.Args["first"].(int)
+
+			if !firstProvided {
+				first = -1
+			} else if first < 0 {
+				return nil, errors.New("First must be greater than or equal to zero.")
+			}
+
+			after, _ := resolveParams.Args["after"].(string)
+
+			loadApplications := requestLoadersOf(resolveParams).loadApplications(tenantID)
+
+			return func() (interface{}, error) {
+				returnedApplications, err := loadApplications()
+
+				if err != nil {
+					return nil, err
+				}
+
+				return newApplicationConnection(tenantID, returnedApplications, first, after)
+			}, nil
+		},
+	}
+}
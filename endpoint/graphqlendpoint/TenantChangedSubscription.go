@@ -0,0 +1,67 @@
+package graphqlendpoint
+
+import (
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/TenantService/business/event"
+)
+
+type tenantChangedEvent struct {
+	Type     string  `json:"type"`
+	TenantID string  `json:"tenantID"`
+	Tenant   *tenant `json:"-"`
+}
+
+var tenantChangedEventType = graphql.NewObject(
+	graphql.ObjectConfig{
+		Name: "TenantChangedEvent",
+		Fields: graphql.Fields{
+			"type":     &graphql.Field{Type: graphql.NewNonNull(changeTypeEnum)},
+			"tenantID": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"tenant": &graphql.Field{
+				Type:        tenantType,
+				Description: "The tenant after the change, null if the tenant is deleted",
+				Resolve: func(resolveParams graphql.ResolveParams) (interface{}, error) {
+					if changedTenant := resolveParams.Source.(tenantChangedEvent).Tenant; changedTenant != nil {
+						return *changedTenant, nil
+					}
+
+					return nil, nil
+				},
+			},
+		},
+	},
+)
+
+func getTenantChangedSubscription() *graphql.Field {
+	return &graphql.Field{
+		Type:        tenantChangedEventType,
+		Description: "Notifies every change made to the provided tenant",
+		Args: graphql.FieldConfigArgument{
+			"tenantID": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.String),
+			},
+		},
+
+		Subscribe: func(resolveParams graphql.ResolveParams) (interface{}, error) {
+			return subscribeToTenantEvents(resolveParams, func(changeEvent event.Event) bool {
+				return !changeEvent.IsApplicationEvent()
+			})
+		},
+
+		Resolve: func(resolveParams graphql.ResolveParams) (interface{}, error) {
+			changeEvent, err := resolveSubscriptionPayload(resolveParams)
+
+			if err != nil {
+				return nil, err
+			}
+
+			changed := tenantChangedEvent{Type: changeTypes[changeEvent.Type], TenantID: changeEvent.TenantID.String()}
+
+			if changeEvent.Type != event.TenantDeleted {
+				changed.Tenant = &tenant{ID: changeEvent.TenantID.String(), SecretKey: changeEvent.Tenant.SecretKey}
+			}
+
+			return changed, nil
+		},
+	}
+}
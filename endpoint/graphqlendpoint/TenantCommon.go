@@ -3,6 +3,7 @@ package graphqlendpoint
 import (
 	"github.com/graphql-go/graphql"
 	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/endpoint/security"
 )
 
 const (
@@ -17,17 +18,19 @@ type tenant struct {
 
 var tenantType = graphql.NewObject(
 	graphql.ObjectConfig{
-		Name: "Tenant",
+		Name:       tenantNodeTypeName,
+		Interfaces: []*graphql.Interface{nodeInterface},
 		Fields: graphql.Fields{
+			nodeID:    getGlobalIDField(),
 			tenantID:  &graphql.Field{Type: graphql.String},
-			secretKey: &graphql.Field{Type: graphql.String},
+			secretKey: sensitiveField(&graphql.Field{Type: graphql.String}, security.ReadTenantSecretKeyPermission),
 		},
 	},
 )
 
 var inputTenantType = graphql.NewInputObject(
 	graphql.InputObjectConfig{
-		Name: "Tenant",
+		Name: "TenantInput",
 		Fields: graphql.InputObjectConfigFieldMap{
 			secretKey: &graphql.InputObjectFieldConfig{Type: graphql.String},
 		},
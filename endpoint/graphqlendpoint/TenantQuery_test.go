@@ -9,8 +9,10 @@ import (
 	"github.com/micro-business/Micro-Business-Core/system"
 	"github.com/micro-business/TenantService/business/domain"
 	"github.com/micro-business/TenantService/endpoint/graphqlendpoint"
+	"github.com/micro-business/TenantService/endpoint/security"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
 )
 
 var _ = Describe("TenantQuery method input parameters and dependency test", func() {
@@ -22,6 +24,7 @@ var _ = Describe("TenantQuery method input parameters and dependency test", func
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
 	})
 
 	AfterEach(func() {
@@ -52,13 +55,18 @@ var _ = Describe("TenantQuery method behaviour", func() {
 		mockCtrl          *gomock.Controller
 		mockTenantService *MockTenantService
 		tenantID          system.UUID
+		authorizedContext context.Context
 	)
 
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
 
 		tenantID, _ = system.RandomUUID()
+		authorizedContext = security.WithPrincipal(
+			context.Background(),
+			security.Principal{Name: "admin", Permissions: []string{security.ReadTenantSecretKeyPermission}})
 	})
 
 	AfterEach(func() {
@@ -102,8 +110,7 @@ var _ = Describe("TenantQuery method behaviour", func() {
 
 		query := "{tenant(tenantID:\"" + tenantID.String() + "\"){ID SecretKey}}"
 
-		returnedTenant, err := graphqlendpoint.ExecuteQuery(query, mockTenantService)
-		Expect(err).To(BeNil())
+		returnedTenant := graphqlendpoint.ExecuteRequest(authorizedContext, graphqlendpoint.Request{Query: query}, mockTenantService, graphqlendpoint.Options{})
 		Expect(returnedTenant).To(Equal(expectedTenant))
 	})
 
@@ -142,10 +149,71 @@ var _ = Describe("TenantQuery method behaviour", func() {
 
 		query := "{tenant(tenantID:\"" + tenantID.String() + "\"){SecretKey}}"
 
+		returnedTenant := graphqlendpoint.ExecuteRequest(authorizedContext, graphqlendpoint.Request{Query: query}, mockTenantService, graphqlendpoint.Options{})
+		Expect(returnedTenant).To(Equal(expectedTenant))
+	})
+
+	It("should return masked SecretKey if the caller is not authenticated", func() {
+		randomValue, _ := system.RandomUUID()
+		tenant := domain.Tenant{SecretKey: randomValue.String()}
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(tenant, nil)
+
+		expectedTenant := &graphql.Result{
+			Data: map[string]interface{}{
+				"tenant": map[string]interface{}{
+					"ID":        tenantID.String(),
+					"SecretKey": "********",
+				},
+			},
+		}
+
+		query := "{tenant(tenantID:\"" + tenantID.String() + "\"){ID SecretKey}}"
+
 		returnedTenant, err := graphqlendpoint.ExecuteQuery(query, mockTenantService)
 		Expect(err).To(BeNil())
 		Expect(returnedTenant).To(Equal(expectedTenant))
 	})
+
+	It("should return masked SecretKey if the caller is not granted the required permission", func() {
+		randomValue, _ := system.RandomUUID()
+		tenant := domain.Tenant{SecretKey: randomValue.String()}
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(tenant, nil)
+
+		expectedTenant := &graphql.Result{
+			Data: map[string]interface{}{
+				"tenant": map[string]interface{}{
+					"SecretKey": "********",
+				},
+			},
+		}
+
+		query := "{tenant(tenantID:\"" + tenantID.String() + "\"){SecretKey}}"
+		ctx := security.WithPrincipal(context.Background(), security.Principal{Name: "reader"})
+
+		returnedTenant := graphqlendpoint.ExecuteRequest(ctx, graphqlendpoint.Request{Query: query}, mockTenantService, graphqlendpoint.Options{})
+		Expect(returnedTenant).To(Equal(expectedTenant))
+	})
+
+	It("should return field error and the rest of tenant information if the caller is not granted the required permission and sensitive fields are rejected", func() {
+		randomValue, _ := system.RandomUUID()
+		tenant := domain.Tenant{SecretKey: randomValue.String()}
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(tenant, nil)
+
+		query := "{tenant(tenantID:\"" + tenantID.String() + "\"){ID SecretKey}}"
+		options := graphqlendpoint.Options{SensitiveFieldPolicy: graphqlendpoint.RejectSensitiveField}
+
+		returnedTenant := graphqlendpoint.ExecuteRequest(context.Background(), graphqlendpoint.Request{Query: query}, mockTenantService, options)
+		Expect(returnedTenant.Data).To(Equal(map[string]interface{}{
+			"tenant": map[string]interface{}{
+				"ID":        tenantID.String(),
+				"SecretKey": nil,
+			},
+		}))
+		Expect(returnedTenant.Errors).To(HaveLen(1))
+		Expect(returnedTenant.Errors[0].Message).NotTo(ContainSubstring(randomValue.String()))
+		Expect(returnedTenant.Errors[0].Path).To(Equal([]interface{}{"tenant", "SecretKey"}))
+		Expect(returnedTenant.Errors[0].Extensions).To(Equal(map[string]interface{}{"code": graphqlendpoint.ForbiddenErrorCode}))
+	})
 })
 
 func TestTenantQuery(t *testing.T) {
@@ -0,0 +1,39 @@
+package graphqlendpoint
+
+import (
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/TenantService/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+)
+
+// operationSpanName is the name of the span of an operation until the executed operation is known.
+const operationSpanName = "GraphQL operation"
+
+// startOperationSpan starts the span of the operation executed by a request, as a child of the span carried by the
+// provided context.
+// Returns the context carrying the new span, and the new span.
+func startOperationSpan(ctx context.Context) (context.Context, trace.Span) {
+	return tracing.Start(ctx, operationSpanName, trace.WithAttributes(attribute.String("graphql.operation.type", unknownOperationType)))
+}
+
+// endOperationSpan names the provided span after the executed operation, e.g. "query readTenant", marks it as failed if
+// the response carries errors, and ends it.
+func endOperationSpan(span trace.Span, operationType string, operationName string, result *graphql.Result) {
+	spanName := operationType
+
+	if len(operationName) != 0 {
+		spanName += " " + operationName
+	}
+
+	span.SetName(spanName)
+	span.SetAttributes(attribute.String("graphql.operation.type", operationType), attribute.String("graphql.operation.name", operationName))
+
+	if result.HasErrors() {
+		span.SetStatus(codes.Error, result.Errors[0].Message)
+	}
+
+	span.End()
+}
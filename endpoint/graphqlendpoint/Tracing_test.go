@@ -0,0 +1,79 @@
+package graphqlendpoint_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/endpoint/graphqlendpoint"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("Tracing behaviour", func() {
+	var (
+		mockCtrl          *gomock.Controller
+		mockTenantService *MockTenantService
+		recorder          *tracetest.SpanRecorder
+		tenantID          system.UUID
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantService = NewMockTenantService(mockCtrl)
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+		tenantID, _ = system.RandomUUID()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should record the executed operation span and bind the tenant service to it", func() {
+		var tenantServiceContext context.Context
+
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Do(func(ctx context.Context) { tenantServiceContext = ctx }).Return(mockTenantService)
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{SecretKey: "Secret Key"}, nil)
+
+		request := graphqlendpoint.Request{Query: "query readTenant {tenant(tenantID:\"" + tenantID.String() + "\"){ID}}"}
+
+		result := graphqlendpoint.ExecuteRequest(context.Background(), request, mockTenantService, graphqlendpoint.Options{})
+
+		Expect(result.HasErrors()).To(BeFalse())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name()).To(Equal("query readTenant"))
+		Expect(spans[0].Status().Code).NotTo(Equal(codes.Error))
+		Expect(trace.SpanContextFromContext(tenantServiceContext).SpanID()).To(Equal(spans[0].SpanContext().SpanID()))
+	})
+
+	It("should mark the operation span as failed when the response carries errors", func() {
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService)
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{}, errors.New("Failed"))
+
+		request := graphqlendpoint.Request{Query: "{tenant(tenantID:\"" + tenantID.String() + "\"){ID}}"}
+
+		graphqlendpoint.ExecuteRequest(context.Background(), request, mockTenantService, graphqlendpoint.Options{})
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name()).To(Equal("query"))
+		Expect(spans[0].Status().Code).To(Equal(codes.Error))
+	})
+})
+
+func TestTracing(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Tracing behaviour")
+}
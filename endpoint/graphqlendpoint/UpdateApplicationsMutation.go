@@ -0,0 +1,124 @@
+package graphqlendpoint
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+)
+
+var inputApplicationUpdateType = graphql.NewInputObject(
+	graphql.InputObjectConfig{
+		Name: "ApplicationUpdateInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"applicationID": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+			"application":   &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(inputApplicationType)},
+		},
+	},
+)
+
+func getUpdateApplicationsQuery() *graphql.Field {
+	return &graphql.Field{
+		Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(bulkApplicationResultType))),
+		Description: "Updates existing applications of the provided tenant",
+		Args: graphql.FieldConfigArgument{
+			"tenantID": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"applications": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(inputApplicationUpdateType))),
+			},
+			"allOrNothing": getAllOrNothingArgument(),
+		},
+
+		Resolve: func(resolveParams graphql.ResolveParams) (interface{}, error) {
+			tenantIDArg, _ := resolveParams.Args["tenantID"].(string)
+			allOrNothing, _ := resolveParams.Args["allOrNothing"].(bool)
+
+			tenantID, err := system.ParseUUID(tenantIDArg)
+
+			if err != nil {
+				return nil, err
+			}
+
+			items, err := newBulkApplicationListArgument(resolveParams, "applications")
+
+			if err != nil {
+				return nil, err
+			}
+
+			results := make([]bulkApplicationResult, len(items))
+			applications := make(map[system.UUID]domain.Application)
+			applicationIndexes := make(map[system.UUID]int)
+
+			for index, item := range items {
+				inputApplicationUpdateArgument, _ := item.(map[string]interface{})
+				applicationIDArg, _ := inputApplicationUpdateArgument["applicationID"].(string)
+				inputApplicationArgument, _ := inputApplicationUpdateArgument["application"].(map[string]interface{})
+
+				applicationID, err := parseBulkApplicationID(applicationIDArg, applicationIndexes)
+
+				if err != nil {
+					results[index].fail(err)
+
+					continue
+				}
+
+				applications[applicationID] = resolveApplicationFromInputApplicationArgument(inputApplicationArgument)
+				applicationIndexes[applicationID] = index
+			}
+
+			if anyFailed(results) && allOrNothing {
+				return markNotApplied(results), nil
+			}
+
+			executionContext := resolveParams.Context.Value("ExecutionContext").(executionContext)
+
+			applicationErrors, err := executionContext.tenantService.UpdateApplications(tenantID, applications, allOrNothing)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return completeBulkApplicationResults(results, applicationIndexes, applicationErrors, allOrNothing), nil
+		},
+	}
+}
+
+// parseBulkApplicationID parses the provided application unique identifier of a bulk mutation.
+// Returns either the application unique identifier or error if it is invalid or already provided.
+func parseBulkApplicationID(applicationIDArg string, applicationIndexes map[system.UUID]int) (system.UUID, error) {
+	applicationID, err := system.ParseUUID(applicationIDArg)
+
+	if err != nil {
+		return system.EmptyUUID, err
+	}
+
+	if _, duplicate := applicationIndexes[applicationID]; duplicate {
+		return system.EmptyUUID, fmt.Errorf("Application provided more than once. Application ID: %s", applicationID.String())
+	}
+
+	return applicationID, nil
+}
+
+// completeBulkApplicationResults completes the results of the applications sent to the tenant service with the errors it reported.
+func completeBulkApplicationResults(
+	results []bulkApplicationResult,
+	applicationIndexes map[system.UUID]int,
+	applicationErrors map[system.UUID]error,
+	allOrNothing bool) []bulkApplicationResult {
+	for applicationID, index := range applicationIndexes {
+		if applicationErr, failed := applicationErrors[applicationID]; failed {
+			results[index].fail(applicationErr)
+		} else {
+			results[index].succeed(applicationID.String())
+		}
+	}
+
+	if anyFailed(results) && allOrNothing {
+		return markNotApplied(results)
+	}
+
+	return results
+}
@@ -0,0 +1,311 @@
+// Package grpcendpoint serves the tenant service over gRPC for service-to-service calls, alongside the HTTP API.
+package grpcendpoint
+
+import (
+	"crypto/tls"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/micro-business/Micro-Business-Core/common/diagnostics"
+	"github.com/micro-business/TenantService/business/contract"
+	"github.com/micro-business/TenantService/endpoint/grpcendpoint/tenantservicepb"
+	"github.com/micro-business/TenantService/endpoint/ratelimit"
+	"github.com/micro-business/TenantService/endpoint/security"
+	"github.com/micro-business/TenantService/logging"
+	"github.com/micro-business/TenantService/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+const correlationIDMetadataKey = "x-request-id"
+const idempotencyKeyMetadataKey = "idempotency-key"
+const http2Protocol = "h2"
+
+// serverErrorCodes are the codes reporting that the service, not the caller, failed to serve the request.
+var serverErrorCodes = map[codes.Code]bool{
+	codes.Unknown:          true,
+	codes.DeadlineExceeded: true,
+	codes.Unimplemented:    true,
+	codes.Internal:         true,
+	codes.Unavailable:      true,
+	codes.DataLoss:         true,
+}
+
+// Options defines how the gRPC requests are served.
+type Options struct {
+	// TLSConfig is optional. When provided, the server only accepts TLS connections and the callers presenting a verified
+	// client certificate are authenticated.
+	TLSConfig *tls.Config
+
+	// PrincipalPermissions is optional. The permissions granted to the authenticated callers, keyed by the common name of their client certificate.
+	PrincipalPermissions map[string][]string
+
+	// PrincipalTenants is optional. The tenant and application the authenticated callers act on behalf of, keyed by the
+	// common name of their client certificate.
+	PrincipalTenants map[string]security.TenantBinding
+
+	// RateLimiter is optional. When provided, the requests are throttled the same way as the HTTP API, sharing the
+	// buckets of the callers, and the throttled requests are answered with RESOURCE_EXHAUSTED.
+	RateLimiter *ratelimit.Limiter
+
+	// Logger is optional. When provided, every request is assigned a correlation identifier, read from the x-request-id
+	// metadata or generated, returned in the x-request-id response header and written in every log line of the request.
+	Logger log.Logger
+}
+
+// NewServer creates the gRPC server serving the tenant service. Server reflection is registered so the service can be
+// discovered by generic gRPC clients. Every request is traced, continuing the trace sent by the caller in the traceparent
+// metadata, if any.
+// tenantService: Mandatory. Reference to the tenant service the requests are served by.
+// options: Mandatory. Defines how the requests are served.
+// Returns the new gRPC server, ready to serve.
+func NewServer(tenantService contract.TenantService, options Options) *grpc.Server {
+	diagnostics.IsNotNil(tenantService, "tenantService", "tenantService must be provided.")
+
+	interceptors := []grpc.UnaryServerInterceptor{createPrincipalInterceptor(options.PrincipalPermissions, options.PrincipalTenants)}
+
+	if options.RateLimiter != nil {
+		interceptors = append(interceptors, createRateLimitInterceptor(options.RateLimiter))
+	}
+
+	interceptors = append(interceptors, errorInterceptor)
+
+	if options.Logger != nil {
+		interceptors = append([]grpc.UnaryServerInterceptor{createCorrelationInterceptor(options.Logger)}, interceptors...)
+	}
+
+	interceptors = append([]grpc.UnaryServerInterceptor{tracingInterceptor}, interceptors...)
+
+	serverOptions := []grpc.ServerOption{grpc.ChainUnaryInterceptor(interceptors...)}
+
+	if options.TLSConfig != nil {
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(withHTTP2(options.TLSConfig))))
+	}
+
+	server := grpc.NewServer(serverOptions...)
+
+	tenantservicepb.RegisterTenantServiceServer(server, tenantServiceServer{tenantService: tenantService})
+	reflection.Register(server)
+
+	return server
+}
+
+// withHTTP2 returns a copy of the provided TLS configuration that negotiates HTTP/2, as required by gRPC, including the
+// configurations returned per client, e.g. to pick up the reloaded client CA bundle.
+func withHTTP2(tlsConfig *tls.Config) *tls.Config {
+	grpcTLSConfig := tlsConfig.Clone()
+	grpcTLSConfig.NextProtos = []string{http2Protocol}
+
+	if getConfigForClient := tlsConfig.GetConfigForClient; getConfigForClient != nil {
+		grpcTLSConfig.GetConfigForClient = func(clientHello *tls.ClientHelloInfo) (*tls.Config, error) {
+			clientConfig, err := getConfigForClient(clientHello)
+
+			if err != nil || clientConfig == nil {
+				return clientConfig, err
+			}
+
+			clientConfig = clientConfig.Clone()
+			clientConfig.NextProtos = []string{http2Protocol}
+
+			return clientConfig, nil
+		}
+	}
+
+	return grpcTLSConfig
+}
+
+// createCorrelationInterceptor creates the interceptor assigning a correlation identifier to every request, the same way
+// the HTTP API does, and logging every served request.
+func createCorrelationInterceptor(logger log.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		incomingMetadata, _ := metadata.FromIncomingContext(ctx)
+		correlationID := firstMetadataValue(incomingMetadata, correlationIDMetadataKey)
+
+		if !logging.IsValidCorrelationID(correlationID) {
+			correlationID = logging.NewCorrelationID()
+		}
+
+		grpc.SetHeader(ctx, metadata.Pairs(correlationIDMetadataKey, correlationID))
+
+		ctx = logging.NewContext(ctx, logger, correlationID)
+		requestLogger, _ := logging.FromContext(ctx)
+		startTime := time.Now()
+
+		response, err := handler(ctx, request)
+
+		level.Info(requestLogger).Log(
+			"msg", "Request served.",
+			"method", info.FullMethod,
+			"code", status.Code(err).String(),
+			"duration_ms", time.Since(startTime).Seconds()*1000)
+
+		return response, err
+	}
+}
+
+// tracingInterceptor records a span for every request, continuing the trace sent by the caller in the W3C traceparent
+// metadata, if any. The span is marked as failed when the request fails with a server error code.
+func tracingInterceptor(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	incomingMetadata, _ := metadata.FromIncomingContext(ctx)
+	ctx, span := tracing.Start(
+		tracing.Extract(ctx, metadataCarrier(incomingMetadata)),
+		strings.TrimPrefix(info.FullMethod, "/"),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attribute.String("rpc.system", "grpc"), attribute.String("rpc.method", info.FullMethod)))
+	defer span.End()
+
+	response, err := handler(ctx, request)
+	code := status.Code(err)
+
+	span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(code)))
+
+	if serverErrorCodes[code] {
+		span.SetStatus(otelcodes.Error, status.Convert(err).Message())
+	}
+
+	return response, err
+}
+
+// createPrincipalInterceptor creates the interceptor authenticating the callers presenting a verified client
+// certificate, the same way the HTTP API does. The tenant and application the caller acts on behalf of are bound to the
+// certificate by the configuration, never read from the metadata.
+func createPrincipalInterceptor(principalPermissions map[string][]string, principalTenants map[string]security.TenantBinding) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		callerPeer, ok := peer.FromContext(ctx)
+
+		if !ok {
+			return handler(ctx, request)
+		}
+
+		tlsInfo, ok := callerPeer.AuthInfo.(credentials.TLSInfo)
+
+		if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+			return handler(ctx, request)
+		}
+
+		principal := security.NewCertificatePrincipal(tlsInfo.State.VerifiedChains[0][0], principalPermissions, principalTenants)
+
+		return handler(security.WithPrincipal(ctx, principal), request)
+	}
+}
+
+// createRateLimitInterceptor creates the interceptor throttling the requests using the provided rate limiter. The rate
+// limit headers of the HTTP API are returned as metadata and the throttled requests are answered with RESOURCE_EXHAUSTED.
+func createRateLimitInterceptor(rateLimiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		remoteAddress := ""
+
+		if callerPeer, ok := peer.FromContext(ctx); ok && callerPeer.Addr != nil {
+			remoteAddress = callerPeer.Addr.String()
+		}
+
+		limit, result, err := rateLimiter.Take(ctx, remoteAddress)
+
+		if err != nil {
+			logger, _ := logging.FromContext(ctx)
+			level.Warn(logger).Log("msg", "Failed to apply rate limit, letting the request through.", "err", err)
+
+			return handler(ctx, request)
+		}
+
+		if limit.Burst == 0 {
+			return handler(ctx, request)
+		}
+
+		header := metadata.Pairs(
+			"ratelimit-limit", strconv.Itoa(limit.Burst),
+			"ratelimit-remaining", strconv.Itoa(result.Remaining),
+			"ratelimit-reset", strconv.Itoa(result.ResetSeconds()))
+
+		if !result.Allowed {
+			header.Set("retry-after", strconv.Itoa(result.RetryAfterSeconds()))
+		}
+
+		grpc.SetHeader(ctx, header)
+
+		if !result.Allowed {
+			return nil, status.Error(codes.ResourceExhausted, "Too many requests. Retry after the time given in retry-after metadata.")
+		}
+
+		return handler(ctx, request)
+	}
+}
+
+// errorInterceptor maps the errors returned by the tenant service to gRPC status codes. Not found errors are reported
+// as NOT_FOUND and idempotency key conflicts as ALREADY_EXISTS. Unexpected errors, including panics, are logged and
+// reported as INTERNAL without their details.
+func errorInterceptor(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (response interface{}, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			logger, _ := logging.FromContext(ctx)
+			level.Error(logger).Log("msg", "Failed to serve gRPC request.", "method", info.FullMethod, "err", fmt.Sprint(recovered))
+
+			response, err = nil, status.Error(codes.Internal, "The request could not be served.")
+		}
+	}()
+
+	response, err = handler(ctx, request)
+
+	if err == nil {
+		return response, nil
+	}
+
+	if _, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+		return nil, err
+	}
+
+	if notFoundErr, ok := err.(interface{ NotFound() bool }); ok && notFoundErr.NotFound() {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if conflictErr, ok := err.(interface{ Conflict() bool }); ok && conflictErr.Conflict() {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	logger, _ := logging.FromContext(ctx)
+	level.Error(logger).Log("msg", "Failed to serve gRPC request.", "method", info.FullMethod, "err", err)
+
+	return nil, status.Error(codes.Internal, "The request could not be served.")
+}
+
+// metadataCarrier reads the W3C trace context from the metadata of a gRPC request.
+type metadataCarrier metadata.MD
+
+func (carrier metadataCarrier) Get(key string) string {
+	return firstMetadataValue(metadata.MD(carrier), key)
+}
+
+func (carrier metadataCarrier) Set(key string, value string) {
+	metadata.MD(carrier).Set(key, value)
+}
+
+func (carrier metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(carrier))
+
+	for key := range carrier {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+func firstMetadataValue(incomingMetadata metadata.MD, key string) string {
+	if values := incomingMetadata.Get(key); len(values) != 0 {
+		return values[0]
+	}
+
+	return ""
+}
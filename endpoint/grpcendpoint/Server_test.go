@@ -0,0 +1,207 @@
+package grpcendpoint_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/TenantService/endpoint/grpcendpoint"
+	"github.com/micro-business/TenantService/endpoint/grpcendpoint/tenantservicepb"
+	"github.com/micro-business/TenantService/endpoint/ratelimit"
+	"github.com/micro-business/TenantService/endpoint/security"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// issueCertificate issues a certificate of the provided common name signed by the provided authority, or self-signed if
+// no authority is provided.
+func issueCertificate(commonName string, authority *tls.Certificate, isCA bool) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).To(BeNil())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		DNSNames:              []string{commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+
+	parent, signer := template, interface{}(key)
+
+	if authority != nil {
+		parent, signer = authority.Leaf, authority.PrivateKey
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	Expect(err).To(BeNil())
+
+	leaf, err := x509.ParseCertificate(der)
+	Expect(err).To(BeNil())
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+var _ = Describe("gRPC server behaviour", func() {
+	var (
+		mockCtrl    *gomock.Controller
+		server      *grpc.Server
+		listener    *bufconn.Listener
+		connections []*grpc.ClientConn
+		limiter     *ratelimit.Limiter
+	)
+
+	serve := func(options grpcendpoint.Options) {
+		mockTenantService := NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+
+		listener = bufconn.Listen(1024 * 1024)
+		server = grpcendpoint.NewServer(mockTenantService, options)
+
+		go server.Serve(listener)
+	}
+
+	connect := func(transportCredentials credentials.TransportCredentials) tenantservicepb.TenantServiceClient {
+		connection, err := grpc.NewClient(
+			"passthrough:///bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) { return listener.DialContext(ctx) }),
+			grpc.WithTransportCredentials(transportCredentials))
+		Expect(err).To(BeNil())
+
+		connections = append(connections, connection)
+
+		return tenantservicepb.NewTenantServiceClient(connection)
+	}
+
+	// call sends a request that is rejected by the validation, so only the interceptors decide of the returned code.
+	call := func(client tenantservicepb.TenantServiceClient, ctx context.Context, header *metadata.MD) codes.Code {
+		_, err := client.CreateTenant(ctx, &tenantservicepb.CreateTenantRequest{}, grpc.Header(header))
+
+		return status.Code(err)
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		connections = nil
+		limiter = &ratelimit.Limiter{Store: ratelimit.NewMemoryStore(), DefaultLimit: ratelimit.Limit{RequestsPerSecond: 0.001, Burst: 1}}
+	})
+
+	AfterEach(func() {
+		for _, connection := range connections {
+			connection.Close()
+		}
+
+		server.Stop()
+		mockCtrl.Finish()
+	})
+
+	Context("when a rate limiter is provided", func() {
+		It("should answer RESOURCE_EXHAUSTED once the bucket of the caller is empty", func() {
+			serve(grpcendpoint.Options{RateLimiter: limiter})
+			client := connect(insecure.NewCredentials())
+			header := metadata.MD{}
+
+			Expect(call(client, context.Background(), &header)).To(Equal(codes.InvalidArgument))
+			Expect(header.Get("ratelimit-limit")).To(Equal([]string{"1"}))
+			Expect(header.Get("ratelimit-remaining")).To(Equal([]string{"0"}))
+
+			Expect(call(client, context.Background(), &header)).To(Equal(codes.ResourceExhausted))
+			Expect(header.Get("retry-after")).NotTo(BeEmpty())
+		})
+
+		It("should share the buckets with the other transports using the same rate limiter", func() {
+			serve(grpcendpoint.Options{RateLimiter: limiter})
+			client := connect(insecure.NewCredentials())
+
+			limiter.Take(context.Background(), "bufconn")
+
+			Expect(call(client, context.Background(), &metadata.MD{})).To(Equal(codes.ResourceExhausted))
+		})
+
+		It("should not throttle the requests when no rate limiter is provided", func() {
+			serve(grpcendpoint.Options{})
+			client := connect(insecure.NewCredentials())
+
+			Expect(call(client, context.Background(), &metadata.MD{})).To(Equal(codes.InvalidArgument))
+			Expect(call(client, context.Background(), &metadata.MD{})).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("when the caller presents a verified client certificate", func() {
+		var (
+			authority         tls.Certificate
+			clientCredentials func(commonName string) credentials.TransportCredentials
+		)
+
+		BeforeEach(func() {
+			authority = issueCertificate("Test CA", nil, true)
+			serverCertificate := issueCertificate("tenant-service", &authority, false)
+			authorities := x509.NewCertPool()
+			authorities.AddCert(authority.Leaf)
+
+			limiter.TenantLimits = map[string]ratelimit.Limit{
+				"tenant1": {RequestsPerSecond: 0.001, Burst: 2},
+				"tenant2": {RequestsPerSecond: 0.001, Burst: 100},
+			}
+
+			serve(grpcendpoint.Options{
+				TLSConfig: &tls.Config{
+					Certificates: []tls.Certificate{serverCertificate},
+					ClientAuth:   tls.RequireAndVerifyClientCert,
+					ClientCAs:    authorities,
+				},
+				PrincipalTenants: map[string]security.TenantBinding{"billing": {TenantID: "tenant1"}},
+				RateLimiter:      limiter,
+			})
+
+			clientCredentials = func(commonName string) credentials.TransportCredentials {
+				return credentials.NewTLS(&tls.Config{
+					RootCAs:      authorities,
+					ServerName:   "tenant-service",
+					Certificates: []tls.Certificate{issueCertificate(commonName, &authority, false)},
+				})
+			}
+		})
+
+		It("should apply the rate limit of the tenant bound to the certificate, not the one sent in the metadata", func() {
+			client := connect(clientCredentials("billing"))
+			ctx := metadata.AppendToOutgoingContext(context.Background(), "x-tenant-id", "tenant2", "x-application-id", "application1")
+
+			Expect(call(client, ctx, &metadata.MD{})).To(Equal(codes.InvalidArgument))
+			Expect(call(client, ctx, &metadata.MD{})).To(Equal(codes.InvalidArgument))
+			Expect(call(client, ctx, &metadata.MD{})).To(Equal(codes.ResourceExhausted))
+		})
+
+		It("should not let a caller bound to no tenant pick a fresh bucket using the metadata", func() {
+			client := connect(clientCredentials("portal"))
+
+			Expect(call(client, metadata.AppendToOutgoingContext(context.Background(), "x-application-id", "application1"), &metadata.MD{})).To(Equal(codes.InvalidArgument))
+			Expect(call(client, metadata.AppendToOutgoingContext(context.Background(), "x-application-id", "application2"), &metadata.MD{})).To(Equal(codes.ResourceExhausted))
+		})
+	})
+})
+
+func TestServer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "gRPC server behaviour")
+}
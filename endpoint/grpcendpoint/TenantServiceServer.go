@@ -0,0 +1,450 @@
+package grpcendpoint
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/contract"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/endpoint/grpcendpoint/tenantservicepb"
+	"github.com/micro-business/TenantService/endpoint/security"
+	"github.com/micro-business/TenantService/idempotency"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// maskedValue is returned instead of the value of a sensitive field when the caller lacks the required permission.
+const maskedValue = "********"
+
+// tenantServiceServer implements the gRPC tenant service on top of the business tenant service. The requests are
+// validated before reaching the business tenant service, invalid requests are reported as INVALID_ARGUMENT.
+type tenantServiceServer struct {
+	tenantservicepb.UnimplementedTenantServiceServer
+
+	tenantService contract.TenantService
+}
+
+// service returns the tenant service bound to the context of the request, so its logs and spans are correlated with the request.
+func (server tenantServiceServer) service(ctx context.Context) contract.TenantService {
+	return server.tenantService.WithContext(ctx)
+}
+
+// idempotentService returns the tenant service bound to the context of the request and to the idempotency key sent in
+// the idempotency-key metadata, if any, so a retry returns the unique identifier created first.
+func (server tenantServiceServer) idempotentService(ctx context.Context) (contract.TenantService, error) {
+	incomingMetadata, _ := metadata.FromIncomingContext(ctx)
+	key := firstMetadataValue(incomingMetadata, idempotencyKeyMetadataKey)
+
+	if len(key) == 0 {
+		return server.service(ctx), nil
+	}
+
+	if !idempotency.IsValidKey(key) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s must be made of at most %d printable ASCII characters.", idempotencyKeyMetadataKey, idempotency.MaxKeyLength)
+	}
+
+	return server.service(idempotency.NewContext(ctx, key)), nil
+}
+
+func (server tenantServiceServer) CreateTenant(ctx context.Context, request *tenantservicepb.CreateTenantRequest) (*tenantservicepb.CreateTenantResponse, error) {
+	if err := checkProvided("secret_key", request.SecretKey); err != nil {
+		return nil, err
+	}
+
+	tenantService, err := server.idempotentService(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID, err := tenantService.CreateTenant(domain.Tenant{SecretKey: request.SecretKey})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenantservicepb.CreateTenantResponse{TenantId: tenantID.String()}, nil
+}
+
+func (server tenantServiceServer) UpdateTenant(ctx context.Context, request *tenantservicepb.UpdateTenantRequest) (*tenantservicepb.UpdateTenantResponse, error) {
+	tenantID, err := parseUUID("tenant_id", request.TenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkProvided("secret_key", request.SecretKey); err != nil {
+		return nil, err
+	}
+
+	if err = server.service(ctx).UpdateTenant(tenantID, domain.Tenant{SecretKey: request.SecretKey}); err != nil {
+		return nil, err
+	}
+
+	return &tenantservicepb.UpdateTenantResponse{}, nil
+}
+
+func (server tenantServiceServer) ReadTenant(ctx context.Context, request *tenantservicepb.ReadTenantRequest) (*tenantservicepb.ReadTenantResponse, error) {
+	tenantID, err := parseUUID("tenant_id", request.TenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err := server.service(ctx).ReadTenant(tenantID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenantservicepb.ReadTenantResponse{Tenant: newTenant(ctx, tenantID, tenant)}, nil
+}
+
+func (server tenantServiceServer) ReadAllTenants(ctx context.Context, request *tenantservicepb.ReadAllTenantsRequest) (*tenantservicepb.ReadAllTenantsResponse, error) {
+	tenants, err := server.service(ctx).ReadAllTenants()
+
+	if err != nil {
+		return nil, err
+	}
+
+	response := &tenantservicepb.ReadAllTenantsResponse{Tenants: make([]*tenantservicepb.Tenant, 0, len(tenants))}
+
+	for tenantID, tenant := range tenants {
+		response.Tenants = append(response.Tenants, newTenant(ctx, tenantID, tenant))
+	}
+
+	sort.Slice(response.Tenants, func(i, j int) bool { return response.Tenants[i].TenantId < response.Tenants[j].TenantId })
+
+	return response, nil
+}
+
+func (server tenantServiceServer) DeleteTenant(ctx context.Context, request *tenantservicepb.DeleteTenantRequest) (*tenantservicepb.DeleteTenantResponse, error) {
+	tenantID, err := parseUUID("tenant_id", request.TenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = server.service(ctx).DeleteTenant(tenantID); err != nil {
+		return nil, err
+	}
+
+	return &tenantservicepb.DeleteTenantResponse{}, nil
+}
+
+func (server tenantServiceServer) CreateApplication(ctx context.Context, request *tenantservicepb.CreateApplicationRequest) (*tenantservicepb.CreateApplicationResponse, error) {
+	tenantID, err := parseUUID("tenant_id", request.TenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkProvided("name", request.Name); err != nil {
+		return nil, err
+	}
+
+	tenantService, err := server.idempotentService(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID, err := tenantService.CreateApplication(tenantID, domain.Application{Name: request.Name})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenantservicepb.CreateApplicationResponse{ApplicationId: applicationID.String()}, nil
+}
+
+func (server tenantServiceServer) UpdateApplication(ctx context.Context, request *tenantservicepb.UpdateApplicationRequest) (*tenantservicepb.UpdateApplicationResponse, error) {
+	tenantID, applicationID, err := parseApplicationKeys(request.TenantId, request.ApplicationId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkProvided("name", request.Name); err != nil {
+		return nil, err
+	}
+
+	if err = server.service(ctx).UpdateApplication(tenantID, applicationID, domain.Application{Name: request.Name}); err != nil {
+		return nil, err
+	}
+
+	return &tenantservicepb.UpdateApplicationResponse{}, nil
+}
+
+func (server tenantServiceServer) ReadApplication(ctx context.Context, request *tenantservicepb.ReadApplicationRequest) (*tenantservicepb.ReadApplicationResponse, error) {
+	tenantID, applicationID, err := parseApplicationKeys(request.TenantId, request.ApplicationId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	application, err := server.service(ctx).ReadApplication(tenantID, applicationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenantservicepb.ReadApplicationResponse{Application: newApplication(tenantID, applicationID, application)}, nil
+}
+
+func (server tenantServiceServer) ReadAllApplications(ctx context.Context, request *tenantservicepb.ReadAllApplicationsRequest) (*tenantservicepb.ReadAllApplicationsResponse, error) {
+	tenantID, err := parseUUID("tenant_id", request.TenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	applications, err := server.service(ctx).ReadAllApplications(tenantID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response := &tenantservicepb.ReadAllApplicationsResponse{Applications: make([]*tenantservicepb.Application, 0, len(applications))}
+
+	for applicationID, application := range applications {
+		response.Applications = append(response.Applications, newApplication(tenantID, applicationID, application))
+	}
+
+	sort.Slice(response.Applications, func(i, j int) bool {
+		return response.Applications[i].ApplicationId < response.Applications[j].ApplicationId
+	})
+
+	return response, nil
+}
+
+func (server tenantServiceServer) DeleteApplication(ctx context.Context, request *tenantservicepb.DeleteApplicationRequest) (*tenantservicepb.DeleteApplicationResponse, error) {
+	tenantID, applicationID, err := parseApplicationKeys(request.TenantId, request.ApplicationId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = server.service(ctx).DeleteApplication(tenantID, applicationID); err != nil {
+		return nil, err
+	}
+
+	return &tenantservicepb.DeleteApplicationResponse{}, nil
+}
+
+func (server tenantServiceServer) CreateApplications(ctx context.Context, request *tenantservicepb.CreateApplicationsRequest) (*tenantservicepb.BulkApplicationsResponse, error) {
+	tenantID, err := parseUUID("tenant_id", request.TenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkBulkSize(len(request.Names)); err != nil {
+		return nil, err
+	}
+
+	applications := make([]domain.Application, 0, len(request.Names))
+
+	for _, name := range request.Names {
+		applications = append(applications, domain.Application{Name: name})
+	}
+
+	applicationResults, err := server.service(ctx).CreateApplications(tenantID, applications, request.AllOrNothing)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response := &tenantservicepb.BulkApplicationsResponse{Results: make([]*tenantservicepb.BulkApplicationResult, 0, len(applicationResults))}
+
+	for _, applicationResult := range applicationResults {
+		result := &tenantservicepb.BulkApplicationResult{}
+
+		if applicationResult.Err != nil {
+			result.Error = applicationResult.Err.Error()
+		} else if applicationResult.ApplicationID == system.EmptyUUID {
+			result.Error = contract.ApplicationNotAppliedMessage
+		} else {
+			result.ApplicationId = applicationResult.ApplicationID.String()
+			result.Succeeded = true
+		}
+
+		response.Results = append(response.Results, result)
+	}
+
+	return response, nil
+}
+
+func (server tenantServiceServer) UpdateApplications(ctx context.Context, request *tenantservicepb.UpdateApplicationsRequest) (*tenantservicepb.BulkApplicationsResponse, error) {
+	tenantID, err := parseUUID("tenant_id", request.TenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkBulkSize(len(request.Applications)); err != nil {
+		return nil, err
+	}
+
+	applicationIDs := make([]system.UUID, 0, len(request.Applications))
+	applications := make(map[system.UUID]domain.Application)
+
+	for _, application := range request.Applications {
+		applicationID, err := parseBulkApplicationID(application.ApplicationId, applications)
+
+		if err != nil {
+			return nil, err
+		}
+
+		applicationIDs = append(applicationIDs, applicationID)
+		applications[applicationID] = domain.Application{Name: application.Name}
+	}
+
+	if len(applications) == 0 {
+		return &tenantservicepb.BulkApplicationsResponse{}, nil
+	}
+
+	applicationErrors, err := server.service(ctx).UpdateApplications(tenantID, applications, request.AllOrNothing)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newBulkApplicationsResponse(applicationIDs, applicationErrors, request.AllOrNothing), nil
+}
+
+func (server tenantServiceServer) DeleteApplications(ctx context.Context, request *tenantservicepb.DeleteApplicationsRequest) (*tenantservicepb.BulkApplicationsResponse, error) {
+	tenantID, err := parseUUID("tenant_id", request.TenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkBulkSize(len(request.ApplicationIds)); err != nil {
+		return nil, err
+	}
+
+	applicationIDs := make([]system.UUID, 0, len(request.ApplicationIds))
+	provided := make(map[system.UUID]domain.Application)
+
+	for _, applicationIDInString := range request.ApplicationIds {
+		applicationID, err := parseBulkApplicationID(applicationIDInString, provided)
+
+		if err != nil {
+			return nil, err
+		}
+
+		applicationIDs = append(applicationIDs, applicationID)
+		provided[applicationID] = domain.Application{}
+	}
+
+	applicationErrors, err := server.service(ctx).DeleteApplications(tenantID, applicationIDs, request.AllOrNothing)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newBulkApplicationsResponse(applicationIDs, applicationErrors, request.AllOrNothing), nil
+}
+
+// newBulkApplicationsResponse creates the response of a bulk update or delete request, in the order of the provided
+// application unique identifiers. When all or nothing is requested and any application failed, the other applications
+// are reported as not applied.
+func newBulkApplicationsResponse(applicationIDs []system.UUID, applicationErrors map[system.UUID]error, allOrNothing bool) *tenantservicepb.BulkApplicationsResponse {
+	response := &tenantservicepb.BulkApplicationsResponse{Results: make([]*tenantservicepb.BulkApplicationResult, 0, len(applicationIDs))}
+
+	for _, applicationID := range applicationIDs {
+		result := &tenantservicepb.BulkApplicationResult{ApplicationId: applicationID.String()}
+
+		if applicationErr, failed := applicationErrors[applicationID]; failed {
+			result.Error = applicationErr.Error()
+		} else if allOrNothing && len(applicationErrors) != 0 {
+			result.Error = contract.ApplicationNotAppliedMessage
+		} else {
+			result.Succeeded = true
+		}
+
+		response.Results = append(response.Results, result)
+	}
+
+	return response
+}
+
+// newTenant creates the protocol buffer message of the provided tenant. The secret key is masked unless the caller is
+// granted the permission to read it.
+func newTenant(ctx context.Context, tenantID system.UUID, tenant domain.Tenant) *tenantservicepb.Tenant {
+	message := &tenantservicepb.Tenant{TenantId: tenantID.String(), SecretKey: maskedValue}
+
+	if principal, authenticated := security.PrincipalFromContext(ctx); authenticated && principal.HasPermission(security.ReadTenantSecretKeyPermission) {
+		message.SecretKey = tenant.SecretKey
+	}
+
+	return message
+}
+
+func newApplication(tenantID system.UUID, applicationID system.UUID, application domain.Application) *tenantservicepb.Application {
+	return &tenantservicepb.Application{ApplicationId: applicationID.String(), TenantId: tenantID.String(), Name: application.Name}
+}
+
+// parseUUID parses the unique identifier provided in the field of the provided name.
+// Returns either the unique identifier or INVALID_ARGUMENT error if it is not a valid non-empty UUID.
+func parseUUID(fieldName string, value string) (system.UUID, error) {
+	id, err := system.ParseUUID(value)
+
+	if err != nil || id == system.EmptyUUID {
+		return system.EmptyUUID, status.Errorf(codes.InvalidArgument, "%s must be a valid UUID. Value: %s", fieldName, value)
+	}
+
+	return id, nil
+}
+
+func parseApplicationKeys(tenantIDInString string, applicationIDInString string) (system.UUID, system.UUID, error) {
+	tenantID, err := parseUUID("tenant_id", tenantIDInString)
+
+	if err != nil {
+		return system.EmptyUUID, system.EmptyUUID, err
+	}
+
+	applicationID, err := parseUUID("application_id", applicationIDInString)
+
+	if err != nil {
+		return system.EmptyUUID, system.EmptyUUID, err
+	}
+
+	return tenantID, applicationID, nil
+}
+
+// parseBulkApplicationID parses the unique identifier of an application of a bulk request, rejecting the applications
+// provided more than once.
+func parseBulkApplicationID(applicationIDInString string, provided map[system.UUID]domain.Application) (system.UUID, error) {
+	applicationID, err := parseUUID("application_id", applicationIDInString)
+
+	if err != nil {
+		return system.EmptyUUID, err
+	}
+
+	if _, duplicate := provided[applicationID]; duplicate {
+		return system.EmptyUUID, status.Errorf(codes.InvalidArgument, "Application provided more than once. Application ID: %s", applicationIDInString)
+	}
+
+	return applicationID, nil
+}
+
+func checkProvided(fieldName string, value string) error {
+	if len(strings.TrimSpace(value)) == 0 {
+		return status.Errorf(codes.InvalidArgument, "%s must be provided.", fieldName)
+	}
+
+	return nil
+}
+
+func checkBulkSize(size int) error {
+	if size > contract.MaxBulkApplications {
+		return status.Errorf(codes.InvalidArgument, "At most %d applications can be changed by a single request. Provided: %d", contract.MaxBulkApplications, size)
+	}
+
+	return nil
+}
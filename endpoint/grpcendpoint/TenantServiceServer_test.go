@@ -0,0 +1,319 @@
+package grpcendpoint_test
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	datacontract "github.com/micro-business/TenantService/data/contract"
+	"github.com/micro-business/TenantService/endpoint/grpcendpoint"
+	"github.com/micro-business/TenantService/endpoint/grpcendpoint/tenantservicepb"
+	"github.com/micro-business/TenantService/idempotency"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var _ = Describe("gRPC tenant service behaviour", func() {
+	var (
+		mockCtrl          *gomock.Controller
+		mockTenantService *MockTenantService
+		server            *grpc.Server
+		connection        *grpc.ClientConn
+		client            tenantservicepb.TenantServiceClient
+		tenantID          system.UUID
+		applicationID     system.UUID
+	)
+
+	codeOf := func(err error) codes.Code {
+		return status.Code(err)
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+
+		listener := bufconn.Listen(1024 * 1024)
+		server = grpcendpoint.NewServer(mockTenantService, grpcendpoint.Options{})
+
+		go server.Serve(listener)
+
+		var err error
+		connection, err = grpc.NewClient(
+			"passthrough:///bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) { return listener.DialContext(ctx) }),
+			grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).To(BeNil())
+
+		client = tenantservicepb.NewTenantServiceClient(connection)
+		tenantID, _ = system.RandomUUID()
+		applicationID, _ = system.RandomUUID()
+	})
+
+	AfterEach(func() {
+		connection.Close()
+		server.Stop()
+		mockCtrl.Finish()
+	})
+
+	It("should register the tenant service and server reflection", func() {
+		serviceInfo := server.GetServiceInfo()
+
+		Expect(serviceInfo).To(HaveKey("tenantservice.TenantService"))
+		Expect(serviceInfo).To(HaveKey("grpc.reflection.v1.ServerReflection"))
+	})
+
+	Describe("Tenants", func() {
+		It("should create the tenant and return its unique identifier", func() {
+			mockTenantService.EXPECT().CreateTenant(domain.Tenant{SecretKey: "Secret"}).Return(tenantID, nil)
+
+			response, err := client.CreateTenant(context.Background(), &tenantservicepb.CreateTenantRequest{SecretKey: "Secret"})
+
+			Expect(err).To(BeNil())
+			Expect(response.TenantId).To(Equal(tenantID.String()))
+		})
+
+		It("should return INVALID_ARGUMENT if the secret key is not provided", func() {
+			_, err := client.CreateTenant(context.Background(), &tenantservicepb.CreateTenantRequest{SecretKey: " "})
+
+			Expect(codeOf(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("should return ALREADY_EXISTS if the idempotency key was used with a different tenant", func() {
+			mockTenantService.EXPECT().CreateTenant(domain.Tenant{SecretKey: "Secret"}).Return(system.EmptyUUID, idempotency.ConflictError{})
+			ctx := metadata.AppendToOutgoingContext(context.Background(), "idempotency-key", "key")
+
+			_, err := client.CreateTenant(ctx, &tenantservicepb.CreateTenantRequest{SecretKey: "Secret"})
+
+			Expect(codeOf(err)).To(Equal(codes.AlreadyExists))
+		})
+
+		It("should return INVALID_ARGUMENT if the idempotency key is not valid", func() {
+			ctx := metadata.AppendToOutgoingContext(context.Background(), "idempotency-key", strings.Repeat("a", idempotency.MaxKeyLength+1))
+
+			_, err := client.CreateTenant(ctx, &tenantservicepb.CreateTenantRequest{SecretKey: "Secret"})
+
+			Expect(codeOf(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("should return the tenant with its secret key masked for callers without the permission", func() {
+			mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{SecretKey: "Secret"}, nil)
+
+			response, err := client.ReadTenant(context.Background(), &tenantservicepb.ReadTenantRequest{TenantId: tenantID.String()})
+
+			Expect(err).To(BeNil())
+			Expect(response.Tenant.TenantId).To(Equal(tenantID.String()))
+			Expect(response.Tenant.SecretKey).To(Equal("********"))
+		})
+
+		It("should return NOT_FOUND if the tenant does not exist", func() {
+			mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{}, datacontract.NewTenantNotFoundError(tenantID))
+
+			_, err := client.ReadTenant(context.Background(), &tenantservicepb.ReadTenantRequest{TenantId: tenantID.String()})
+
+			Expect(codeOf(err)).To(Equal(codes.NotFound))
+			Expect(status.Convert(err).Message()).To(ContainSubstring(tenantID.String()))
+		})
+
+		It("should return INVALID_ARGUMENT if the tenant unique identifier is not a UUID", func() {
+			_, err := client.DeleteTenant(context.Background(), &tenantservicepb.DeleteTenantRequest{TenantId: "invalid"})
+
+			Expect(codeOf(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("should return INTERNAL without the error details if the tenant service fails unexpectedly", func() {
+			mockTenantService.EXPECT().ReadAllTenants().Return(nil, errors.New("connection refused"))
+
+			_, err := client.ReadAllTenants(context.Background(), &tenantservicepb.ReadAllTenantsRequest{})
+
+			Expect(codeOf(err)).To(Equal(codes.Internal))
+			Expect(status.Convert(err).Message()).NotTo(ContainSubstring("connection refused"))
+		})
+
+		It("should return all tenants sorted by their unique identifier", func() {
+			otherTenantID, _ := system.RandomUUID()
+			mockTenantService.EXPECT().ReadAllTenants().Return(map[system.UUID]domain.Tenant{tenantID: {SecretKey: "Secret"}, otherTenantID: {SecretKey: "Secret"}}, nil)
+
+			response, err := client.ReadAllTenants(context.Background(), &tenantservicepb.ReadAllTenantsRequest{})
+
+			Expect(err).To(BeNil())
+			Expect(response.Tenants).To(HaveLen(2))
+			Expect(response.Tenants[0].TenantId < response.Tenants[1].TenantId).To(BeTrue())
+		})
+	})
+
+	Describe("Applications", func() {
+		It("should update the application", func() {
+			mockTenantService.EXPECT().UpdateApplication(tenantID, applicationID, domain.Application{Name: "Renamed"}).Return(nil)
+
+			_, err := client.UpdateApplication(context.Background(), &tenantservicepb.UpdateApplicationRequest{
+				TenantId:      tenantID.String(),
+				ApplicationId: applicationID.String(),
+				Name:          "Renamed",
+			})
+
+			Expect(err).To(BeNil())
+		})
+
+		It("should return NOT_FOUND if the application does not exist", func() {
+			mockTenantService.EXPECT().ReadApplication(tenantID, applicationID).Return(domain.Application{}, datacontract.NewApplicationNotFoundError(tenantID, applicationID))
+
+			_, err := client.ReadApplication(context.Background(), &tenantservicepb.ReadApplicationRequest{TenantId: tenantID.String(), ApplicationId: applicationID.String()})
+
+			Expect(codeOf(err)).To(Equal(codes.NotFound))
+		})
+
+		It("should report the result of every created application in order", func() {
+			mockTenantService.EXPECT().CreateApplications(tenantID, []domain.Application{{Name: "First"}, {Name: ""}}, false).Return([]domain.ApplicationResult{
+				{ApplicationID: applicationID},
+				{Err: errors.New("Name must be provided.")},
+			}, nil)
+
+			response, err := client.CreateApplications(context.Background(), &tenantservicepb.CreateApplicationsRequest{TenantId: tenantID.String(), Names: []string{"First", ""}})
+
+			Expect(err).To(BeNil())
+			Expect(response.Results).To(HaveLen(2))
+			Expect(response.Results[0].Succeeded).To(BeTrue())
+			Expect(response.Results[0].ApplicationId).To(Equal(applicationID.String()))
+			Expect(response.Results[1].Succeeded).To(BeFalse())
+			Expect(response.Results[1].Error).To(Equal("Name must be provided."))
+		})
+
+		It("should report the other applications as not applied if an all or nothing bulk delete fails", func() {
+			otherApplicationID, _ := system.RandomUUID()
+			mockTenantService.EXPECT().DeleteApplications(tenantID, []system.UUID{applicationID, otherApplicationID}, true).Return(map[system.UUID]error{
+				otherApplicationID: datacontract.NewApplicationNotFoundError(tenantID, otherApplicationID),
+			}, nil)
+
+			response, err := client.DeleteApplications(context.Background(), &tenantservicepb.DeleteApplicationsRequest{
+				TenantId:       tenantID.String(),
+				ApplicationIds: []string{applicationID.String(), otherApplicationID.String()},
+				AllOrNothing:   true,
+			})
+
+			Expect(err).To(BeNil())
+			Expect(response.Results[0].Succeeded).To(BeFalse())
+			Expect(response.Results[0].Error).To(Equal("Not applied as another application of the request failed."))
+			Expect(response.Results[1].Error).To(ContainSubstring(otherApplicationID.String()))
+		})
+
+		It("should return INVALID_ARGUMENT if an application is provided more than once", func() {
+			_, err := client.UpdateApplications(context.Background(), &tenantservicepb.UpdateApplicationsRequest{
+				TenantId: tenantID.String(),
+				Applications: []*tenantservicepb.ApplicationUpdate{
+					{ApplicationId: applicationID.String(), Name: "First"},
+					{ApplicationId: applicationID.String(), Name: "Second"},
+				},
+			})
+
+			Expect(codeOf(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+})
+
+var _ = Describe("gRPC correlation identifier behaviour", func() {
+	var (
+		mockCtrl          *gomock.Controller
+		mockTenantService *MockTenantService
+		server            *grpc.Server
+		connection        *grpc.ClientConn
+		client            tenantservicepb.TenantServiceClient
+		output            *bytes.Buffer
+		tenantID          system.UUID
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+		output = &bytes.Buffer{}
+
+		listener := bufconn.Listen(1024 * 1024)
+		server = grpcendpoint.NewServer(mockTenantService, grpcendpoint.Options{Logger: log.NewLogfmtLogger(log.NewSyncWriter(output))})
+
+		go server.Serve(listener)
+
+		var err error
+		connection, err = grpc.NewClient(
+			"passthrough:///bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) { return listener.DialContext(ctx) }),
+			grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).To(BeNil())
+
+		client = tenantservicepb.NewTenantServiceClient(connection)
+		tenantID, _ = system.RandomUUID()
+	})
+
+	AfterEach(func() {
+		connection.Close()
+		server.Stop()
+		mockCtrl.Finish()
+	})
+
+	It("should return the correlation identifier sent by the caller and write it in the log lines of the request", func() {
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{SecretKey: "Secret"}, nil)
+
+		var header metadata.MD
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "x-request-id", "caller-request-1")
+
+		_, err := client.ReadTenant(ctx, &tenantservicepb.ReadTenantRequest{TenantId: tenantID.String()}, grpc.Header(&header))
+
+		Expect(err).To(BeNil())
+		Expect(header.Get("x-request-id")).To(Equal([]string{"caller-request-1"}))
+		Expect(output.String()).To(ContainSubstring("request_id=caller-request-1"))
+		Expect(output.String()).To(ContainSubstring("code=OK"))
+	})
+
+	It("should generate a correlation identifier if the caller does not send one", func() {
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{}, errors.New("Failed"))
+
+		var header metadata.MD
+
+		_, err := client.ReadTenant(context.Background(), &tenantservicepb.ReadTenantRequest{TenantId: tenantID.String()}, grpc.Header(&header))
+
+		Expect(status.Code(err)).To(Equal(codes.Internal))
+		Expect(header.Get("x-request-id")).To(HaveLen(1))
+		Expect(output.String()).To(ContainSubstring("request_id=" + header.Get("x-request-id")[0]))
+	})
+
+	It("should continue the trace sent by the caller in the traceparent metadata", func() {
+		recorder := tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+		mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{}, errors.New("Failed"))
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+		client.ReadTenant(ctx, &tenantservicepb.ReadTenantRequest{TenantId: tenantID.String()})
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name()).To(Equal("tenantservice.TenantService/ReadTenant"))
+		Expect(spans[0].SpanContext().TraceID().String()).To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+		Expect(spans[0].Parent().SpanID().String()).To(Equal("00f067aa0ba902b7"))
+		Expect(spans[0].Status().Code).To(Equal(otelcodes.Error))
+		Expect(output.String()).To(ContainSubstring("trace_id=4bf92f3577b34da6a3ce929d0e0e4736"))
+	})
+})
+
+func TestTenantServiceServer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "gRPC tenant service behaviour")
+}
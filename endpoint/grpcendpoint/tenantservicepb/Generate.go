@@ -0,0 +1,5 @@
+// Package tenantservicepb contains the protocol buffer messages of the tenant service and the gRPC client and server
+// interfaces generated from TenantService.proto. Go services call the tenant service using NewTenantServiceClient.
+package tenantservicepb
+
+//go:generate protoc -I ../../.. --go_out=../../.. --go_opt=paths=source_relative --go-grpc_out=../../.. --go-grpc_opt=paths=source_relative endpoint/grpcendpoint/tenantservicepb/TenantService.proto
@@ -0,0 +1,1575 @@
+// Protocol buffer definition of the tenant service, served over gRPC alongside the HTTP API.
+// Regenerate the Go code after changing this file, see Generate.go.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: endpoint/grpcendpoint/tenantservicepb/TenantService.proto
+
+package tenantservicepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Tenant is a tenant of the platform.
+type Tenant struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// secret_key is masked unless the caller is granted the tenant:secret-key:read permission.
+	SecretKey     string `protobuf:"bytes,2,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant) ProtoMessage() {}
+
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tenant) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Tenant) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+// Application is an application of a tenant.
+type Application struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Application) Reset() {
+	*x = Application{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Application) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Application) ProtoMessage() {}
+
+func (x *Application) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Application.ProtoReflect.Descriptor instead.
+func (*Application) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Application) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *Application) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Application) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecretKey     string                 `protobuf:"bytes,1,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantRequest) Reset() {
+	*x = CreateTenantRequest{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantRequest) ProtoMessage() {}
+
+func (x *CreateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantRequest.ProtoReflect.Descriptor instead.
+func (*CreateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateTenantRequest) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+type CreateTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantResponse) Reset() {
+	*x = CreateTenantResponse{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantResponse) ProtoMessage() {}
+
+func (x *CreateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantResponse.ProtoReflect.Descriptor instead.
+func (*CreateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTenantResponse) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type UpdateTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	SecretKey     string                 `protobuf:"bytes,2,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantRequest) Reset() {
+	*x = UpdateTenantRequest{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantRequest) ProtoMessage() {}
+
+func (x *UpdateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UpdateTenantRequest) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+type UpdateTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantResponse) Reset() {
+	*x = UpdateTenantResponse{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantResponse) ProtoMessage() {}
+
+func (x *UpdateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{5}
+}
+
+type ReadTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadTenantRequest) Reset() {
+	*x = ReadTenantRequest{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadTenantRequest) ProtoMessage() {}
+
+func (x *ReadTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadTenantRequest.ProtoReflect.Descriptor instead.
+func (*ReadTenantRequest) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReadTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type ReadTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadTenantResponse) Reset() {
+	*x = ReadTenantResponse{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadTenantResponse) ProtoMessage() {}
+
+func (x *ReadTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadTenantResponse.ProtoReflect.Descriptor instead.
+func (*ReadTenantResponse) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReadTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type ReadAllTenantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadAllTenantsRequest) Reset() {
+	*x = ReadAllTenantsRequest{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadAllTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAllTenantsRequest) ProtoMessage() {}
+
+func (x *ReadAllTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAllTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ReadAllTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{8}
+}
+
+type ReadAllTenantsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenants are sorted by tenant_id.
+	Tenants       []*Tenant `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadAllTenantsResponse) Reset() {
+	*x = ReadAllTenantsResponse{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadAllTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAllTenantsResponse) ProtoMessage() {}
+
+func (x *ReadAllTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAllTenantsResponse.ProtoReflect.Descriptor instead.
+func (*ReadAllTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReadAllTenantsResponse) GetTenants() []*Tenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type DeleteTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantRequest) Reset() {
+	*x = DeleteTenantRequest{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantRequest) ProtoMessage() {}
+
+func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type DeleteTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantResponse) Reset() {
+	*x = DeleteTenantResponse{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantResponse) ProtoMessage() {}
+
+func (x *DeleteTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTenantResponse) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{11}
+}
+
+type CreateApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApplicationRequest) Reset() {
+	*x = CreateApplicationRequest{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationRequest) ProtoMessage() {}
+
+func (x *CreateApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationRequest.ProtoReflect.Descriptor instead.
+func (*CreateApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateApplicationRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApplicationResponse) Reset() {
+	*x = CreateApplicationResponse{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationResponse) ProtoMessage() {}
+
+func (x *CreateApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationResponse.ProtoReflect.Descriptor instead.
+func (*CreateApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateApplicationResponse) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type UpdateApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateApplicationRequest) Reset() {
+	*x = UpdateApplicationRequest{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApplicationRequest) ProtoMessage() {}
+
+func (x *UpdateApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApplicationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateApplicationRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UpdateApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *UpdateApplicationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateApplicationResponse) Reset() {
+	*x = UpdateApplicationResponse{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApplicationResponse) ProtoMessage() {}
+
+func (x *UpdateApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApplicationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{15}
+}
+
+type ReadApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadApplicationRequest) Reset() {
+	*x = ReadApplicationRequest{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadApplicationRequest) ProtoMessage() {}
+
+func (x *ReadApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadApplicationRequest.ProtoReflect.Descriptor instead.
+func (*ReadApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReadApplicationRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ReadApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type ReadApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Application   *Application           `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadApplicationResponse) Reset() {
+	*x = ReadApplicationResponse{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadApplicationResponse) ProtoMessage() {}
+
+func (x *ReadApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadApplicationResponse.ProtoReflect.Descriptor instead.
+func (*ReadApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReadApplicationResponse) GetApplication() *Application {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type ReadAllApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadAllApplicationsRequest) Reset() {
+	*x = ReadAllApplicationsRequest{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadAllApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAllApplicationsRequest) ProtoMessage() {}
+
+func (x *ReadAllApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAllApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ReadAllApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReadAllApplicationsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type ReadAllApplicationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// applications are sorted by application_id.
+	Applications  []*Application `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadAllApplicationsResponse) Reset() {
+	*x = ReadAllApplicationsResponse{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadAllApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAllApplicationsResponse) ProtoMessage() {}
+
+func (x *ReadAllApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAllApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ReadAllApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReadAllApplicationsResponse) GetApplications() []*Application {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+type DeleteApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteApplicationRequest) Reset() {
+	*x = DeleteApplicationRequest{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApplicationRequest) ProtoMessage() {}
+
+func (x *DeleteApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApplicationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteApplicationRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *DeleteApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type DeleteApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteApplicationResponse) Reset() {
+	*x = DeleteApplicationResponse{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApplicationResponse) ProtoMessage() {}
+
+func (x *DeleteApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApplicationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{21}
+}
+
+type CreateApplicationsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Names    []string               `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	// all_or_nothing, if set, creates no application when any of the applications cannot be created.
+	AllOrNothing  bool `protobuf:"varint,3,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApplicationsRequest) Reset() {
+	*x = CreateApplicationsRequest{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationsRequest) ProtoMessage() {}
+
+func (x *CreateApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*CreateApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateApplicationsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateApplicationsRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *CreateApplicationsRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+// ApplicationUpdate is the updated information of an existing tenant application.
+type ApplicationUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationUpdate) Reset() {
+	*x = ApplicationUpdate{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationUpdate) ProtoMessage() {}
+
+func (x *ApplicationUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationUpdate.ProtoReflect.Descriptor instead.
+func (*ApplicationUpdate) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ApplicationUpdate) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *ApplicationUpdate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateApplicationsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	TenantId     string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Applications []*ApplicationUpdate   `protobuf:"bytes,2,rep,name=applications,proto3" json:"applications,omitempty"`
+	// all_or_nothing, if set, updates no application when any of the applications cannot be updated.
+	AllOrNothing  bool `protobuf:"varint,3,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateApplicationsRequest) Reset() {
+	*x = UpdateApplicationsRequest{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateApplicationsRequest) ProtoMessage() {}
+
+func (x *UpdateApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateApplicationsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UpdateApplicationsRequest) GetApplications() []*ApplicationUpdate {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+func (x *UpdateApplicationsRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+type DeleteApplicationsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TenantId       string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ApplicationIds []string               `protobuf:"bytes,2,rep,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	// all_or_nothing, if set, deletes no application when any of the applications cannot be deleted.
+	AllOrNothing  bool `protobuf:"varint,3,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteApplicationsRequest) Reset() {
+	*x = DeleteApplicationsRequest{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteApplicationsRequest) ProtoMessage() {}
+
+func (x *DeleteApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteApplicationsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *DeleteApplicationsRequest) GetApplicationIds() []string {
+	if x != nil {
+		return x.ApplicationIds
+	}
+	return nil
+}
+
+func (x *DeleteApplicationsRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+// BulkApplicationResult is the result of a single application of a bulk request.
+type BulkApplicationResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// application_id is empty if the application could not be created.
+	ApplicationId string `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Succeeded     bool   `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	// error explains why the application failed or was not applied, empty if it succeeded.
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkApplicationResult) Reset() {
+	*x = BulkApplicationResult{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkApplicationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkApplicationResult) ProtoMessage() {}
+
+func (x *BulkApplicationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkApplicationResult.ProtoReflect.Descriptor instead.
+func (*BulkApplicationResult) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BulkApplicationResult) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *BulkApplicationResult) GetSucceeded() bool {
+	if x != nil {
+		return x.Succeeded
+	}
+	return false
+}
+
+func (x *BulkApplicationResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BulkApplicationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results are in the order of the applications of the request.
+	Results       []*BulkApplicationResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkApplicationsResponse) Reset() {
+	*x = BulkApplicationsResponse{}
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkApplicationsResponse) ProtoMessage() {}
+
+func (x *BulkApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*BulkApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BulkApplicationsResponse) GetResults() []*BulkApplicationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_endpoint_grpcendpoint_tenantservicepb_TenantService_proto protoreflect.FileDescriptor
+
+const file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDesc = "" +
+	"\n" +
+	"9endpoint/grpcendpoint/tenantservicepb/TenantService.proto\x12\rtenantservice\"D\n" +
+	"\x06Tenant\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x02 \x01(\tR\tsecretKey\"e\n" +
+	"\vApplication\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"4\n" +
+	"\x13CreateTenantRequest\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x01 \x01(\tR\tsecretKey\"3\n" +
+	"\x14CreateTenantResponse\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"Q\n" +
+	"\x13UpdateTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x02 \x01(\tR\tsecretKey\"\x16\n" +
+	"\x14UpdateTenantResponse\"0\n" +
+	"\x11ReadTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"C\n" +
+	"\x12ReadTenantResponse\x12-\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x15.tenantservice.TenantR\x06tenant\"\x17\n" +
+	"\x15ReadAllTenantsRequest\"I\n" +
+	"\x16ReadAllTenantsResponse\x12/\n" +
+	"\atenants\x18\x01 \x03(\v2\x15.tenantservice.TenantR\atenants\"2\n" +
+	"\x13DeleteTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"\x16\n" +
+	"\x14DeleteTenantResponse\"K\n" +
+	"\x18CreateApplicationRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"B\n" +
+	"\x19CreateApplicationResponse\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"r\n" +
+	"\x18UpdateApplicationRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\x1b\n" +
+	"\x19UpdateApplicationResponse\"\\\n" +
+	"\x16ReadApplicationRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\"W\n" +
+	"\x17ReadApplicationResponse\x12<\n" +
+	"\vapplication\x18\x01 \x01(\v2\x1a.tenantservice.ApplicationR\vapplication\"9\n" +
+	"\x1aReadAllApplicationsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"]\n" +
+	"\x1bReadAllApplicationsResponse\x12>\n" +
+	"\fapplications\x18\x01 \x03(\v2\x1a.tenantservice.ApplicationR\fapplications\"^\n" +
+	"\x18DeleteApplicationRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\"\x1b\n" +
+	"\x19DeleteApplicationResponse\"t\n" +
+	"\x19CreateApplicationsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x14\n" +
+	"\x05names\x18\x02 \x03(\tR\x05names\x12$\n" +
+	"\x0eall_or_nothing\x18\x03 \x01(\bR\fallOrNothing\"N\n" +
+	"\x11ApplicationUpdate\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xa4\x01\n" +
+	"\x19UpdateApplicationsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12D\n" +
+	"\fapplications\x18\x02 \x03(\v2 .tenantservice.ApplicationUpdateR\fapplications\x12$\n" +
+	"\x0eall_or_nothing\x18\x03 \x01(\bR\fallOrNothing\"\x87\x01\n" +
+	"\x19DeleteApplicationsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12'\n" +
+	"\x0fapplication_ids\x18\x02 \x03(\tR\x0eapplicationIds\x12$\n" +
+	"\x0eall_or_nothing\x18\x03 \x01(\bR\fallOrNothing\"r\n" +
+	"\x15BulkApplicationResult\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\bR\tsucceeded\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"Z\n" +
+	"\x18BulkApplicationsResponse\x12>\n" +
+	"\aresults\x18\x01 \x03(\v2$.tenantservice.BulkApplicationResultR\aresults2\x8f\n" +
+	"\n" +
+	"\rTenantService\x12W\n" +
+	"\fCreateTenant\x12\".tenantservice.CreateTenantRequest\x1a#.tenantservice.CreateTenantResponse\x12W\n" +
+	"\fUpdateTenant\x12\".tenantservice.UpdateTenantRequest\x1a#.tenantservice.UpdateTenantResponse\x12Q\n" +
+	"\n" +
+	"ReadTenant\x12 .tenantservice.ReadTenantRequest\x1a!.tenantservice.ReadTenantResponse\x12]\n" +
+	"\x0eReadAllTenants\x12$.tenantservice.ReadAllTenantsRequest\x1a%.tenantservice.ReadAllTenantsResponse\x12W\n" +
+	"\fDeleteTenant\x12\".tenantservice.DeleteTenantRequest\x1a#.tenantservice.DeleteTenantResponse\x12f\n" +
+	"\x11CreateApplication\x12'.tenantservice.CreateApplicationRequest\x1a(.tenantservice.CreateApplicationResponse\x12f\n" +
+	"\x11UpdateApplication\x12'.tenantservice.UpdateApplicationRequest\x1a(.tenantservice.UpdateApplicationResponse\x12`\n" +
+	"\x0fReadApplication\x12%.tenantservice.ReadApplicationRequest\x1a&.tenantservice.ReadApplicationResponse\x12l\n" +
+	"\x13ReadAllApplications\x12).tenantservice.ReadAllApplicationsRequest\x1a*.tenantservice.ReadAllApplicationsResponse\x12f\n" +
+	"\x11DeleteApplication\x12'.tenantservice.DeleteApplicationRequest\x1a(.tenantservice.DeleteApplicationResponse\x12g\n" +
+	"\x12CreateApplications\x12(.tenantservice.CreateApplicationsRequest\x1a'.tenantservice.BulkApplicationsResponse\x12g\n" +
+	"\x12UpdateApplications\x12(.tenantservice.UpdateApplicationsRequest\x1a'.tenantservice.BulkApplicationsResponse\x12g\n" +
+	"\x12DeleteApplications\x12(.tenantservice.DeleteApplicationsRequest\x1a'.tenantservice.BulkApplicationsResponseBOZMgithub.com/micro-business/TenantService/endpoint/grpcendpoint/tenantservicepbb\x06proto3"
+
+var (
+	file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescOnce sync.Once
+	file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescData []byte
+)
+
+func file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescGZIP() []byte {
+	file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescOnce.Do(func() {
+		file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDesc), len(file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDesc)))
+	})
+	return file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDescData
+}
+
+var file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_goTypes = []any{
+	(*Tenant)(nil),                      // 0: tenantservice.Tenant
+	(*Application)(nil),                 // 1: tenantservice.Application
+	(*CreateTenantRequest)(nil),         // 2: tenantservice.CreateTenantRequest
+	(*CreateTenantResponse)(nil),        // 3: tenantservice.CreateTenantResponse
+	(*UpdateTenantRequest)(nil),         // 4: tenantservice.UpdateTenantRequest
+	(*UpdateTenantResponse)(nil),        // 5: tenantservice.UpdateTenantResponse
+	(*ReadTenantRequest)(nil),           // 6: tenantservice.ReadTenantRequest
+	(*ReadTenantResponse)(nil),          // 7: tenantservice.ReadTenantResponse
+	(*ReadAllTenantsRequest)(nil),       // 8: tenantservice.ReadAllTenantsRequest
+	(*ReadAllTenantsResponse)(nil),      // 9: tenantservice.ReadAllTenantsResponse
+	(*DeleteTenantRequest)(nil),         // 10: tenantservice.DeleteTenantRequest
+	(*DeleteTenantResponse)(nil),        // 11: tenantservice.DeleteTenantResponse
+	(*CreateApplicationRequest)(nil),    // 12: tenantservice.CreateApplicationRequest
+	(*CreateApplicationResponse)(nil),   // 13: tenantservice.CreateApplicationResponse
+	(*UpdateApplicationRequest)(nil),    // 14: tenantservice.UpdateApplicationRequest
+	(*UpdateApplicationResponse)(nil),   // 15: tenantservice.UpdateApplicationResponse
+	(*ReadApplicationRequest)(nil),      // 16: tenantservice.ReadApplicationRequest
+	(*ReadApplicationResponse)(nil),     // 17: tenantservice.ReadApplicationResponse
+	(*ReadAllApplicationsRequest)(nil),  // 18: tenantservice.ReadAllApplicationsRequest
+	(*ReadAllApplicationsResponse)(nil), // 19: tenantservice.ReadAllApplicationsResponse
+	(*DeleteApplicationRequest)(nil),    // 20: tenantservice.DeleteApplicationRequest
+	(*DeleteApplicationResponse)(nil),   // 21: tenantservice.DeleteApplicationResponse
+	(*CreateApplicationsRequest)(nil),   // 22: tenantservice.CreateApplicationsRequest
+	(*ApplicationUpdate)(nil),           // 23: tenantservice.ApplicationUpdate
+	(*UpdateApplicationsRequest)(nil),   // 24: tenantservice.UpdateApplicationsRequest
+	(*DeleteApplicationsRequest)(nil),   // 25: tenantservice.DeleteApplicationsRequest
+	(*BulkApplicationResult)(nil),       // 26: tenantservice.BulkApplicationResult
+	(*BulkApplicationsResponse)(nil),    // 27: tenantservice.BulkApplicationsResponse
+}
+var file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_depIdxs = []int32{
+	0,  // 0: tenantservice.ReadTenantResponse.tenant:type_name -> tenantservice.Tenant
+	0,  // 1: tenantservice.ReadAllTenantsResponse.tenants:type_name -> tenantservice.Tenant
+	1,  // 2: tenantservice.ReadApplicationResponse.application:type_name -> tenantservice.Application
+	1,  // 3: tenantservice.ReadAllApplicationsResponse.applications:type_name -> tenantservice.Application
+	23, // 4: tenantservice.UpdateApplicationsRequest.applications:type_name -> tenantservice.ApplicationUpdate
+	26, // 5: tenantservice.BulkApplicationsResponse.results:type_name -> tenantservice.BulkApplicationResult
+	2,  // 6: tenantservice.TenantService.CreateTenant:input_type -> tenantservice.CreateTenantRequest
+	4,  // 7: tenantservice.TenantService.UpdateTenant:input_type -> tenantservice.UpdateTenantRequest
+	6,  // 8: tenantservice.TenantService.ReadTenant:input_type -> tenantservice.ReadTenantRequest
+	8,  // 9: tenantservice.TenantService.ReadAllTenants:input_type -> tenantservice.ReadAllTenantsRequest
+	10, // 10: tenantservice.TenantService.DeleteTenant:input_type -> tenantservice.DeleteTenantRequest
+	12, // 11: tenantservice.TenantService.CreateApplication:input_type -> tenantservice.CreateApplicationRequest
+	14, // 12: tenantservice.TenantService.UpdateApplication:input_type -> tenantservice.UpdateApplicationRequest
+	16, // 13: tenantservice.TenantService.ReadApplication:input_type -> tenantservice.ReadApplicationRequest
+	18, // 14: tenantservice.TenantService.ReadAllApplications:input_type -> tenantservice.ReadAllApplicationsRequest
+	20, // 15: tenantservice.TenantService.DeleteApplication:input_type -> tenantservice.DeleteApplicationRequest
+	22, // 16: tenantservice.TenantService.CreateApplications:input_type -> tenantservice.CreateApplicationsRequest
+	24, // 17: tenantservice.TenantService.UpdateApplications:input_type -> tenantservice.UpdateApplicationsRequest
+	25, // 18: tenantservice.TenantService.DeleteApplications:input_type -> tenantservice.DeleteApplicationsRequest
+	3,  // 19: tenantservice.TenantService.CreateTenant:output_type -> tenantservice.CreateTenantResponse
+	5,  // 20: tenantservice.TenantService.UpdateTenant:output_type -> tenantservice.UpdateTenantResponse
+	7,  // 21: tenantservice.TenantService.ReadTenant:output_type -> tenantservice.ReadTenantResponse
+	9,  // 22: tenantservice.TenantService.ReadAllTenants:output_type -> tenantservice.ReadAllTenantsResponse
+	11, // 23: tenantservice.TenantService.DeleteTenant:output_type -> tenantservice.DeleteTenantResponse
+	13, // 24: tenantservice.TenantService.CreateApplication:output_type -> tenantservice.CreateApplicationResponse
+	15, // 25: tenantservice.TenantService.UpdateApplication:output_type -> tenantservice.UpdateApplicationResponse
+	17, // 26: tenantservice.TenantService.ReadApplication:output_type -> tenantservice.ReadApplicationResponse
+	19, // 27: tenantservice.TenantService.ReadAllApplications:output_type -> tenantservice.ReadAllApplicationsResponse
+	21, // 28: tenantservice.TenantService.DeleteApplication:output_type -> tenantservice.DeleteApplicationResponse
+	27, // 29: tenantservice.TenantService.CreateApplications:output_type -> tenantservice.BulkApplicationsResponse
+	27, // 30: tenantservice.TenantService.UpdateApplications:output_type -> tenantservice.BulkApplicationsResponse
+	27, // 31: tenantservice.TenantService.DeleteApplications:output_type -> tenantservice.BulkApplicationsResponse
+	19, // [19:32] is the sub-list for method output_type
+	6,  // [6:19] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_init() }
+func file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_init() {
+	if File_endpoint_grpcendpoint_tenantservicepb_TenantService_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDesc), len(file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   28,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_goTypes,
+		DependencyIndexes: file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_depIdxs,
+		MessageInfos:      file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_msgTypes,
+	}.Build()
+	File_endpoint_grpcendpoint_tenantservicepb_TenantService_proto = out.File
+	file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_goTypes = nil
+	file_endpoint_grpcendpoint_tenantservicepb_TenantService_proto_depIdxs = nil
+}
@@ -0,0 +1,154 @@
+// Package health serves the liveness and readiness probes of the service.
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/micro-business/TenantService/logging"
+	"golang.org/x/net/context"
+)
+
+const (
+	// StatusUp is reported when the process, or the dependency, can serve requests.
+	StatusUp = "up"
+
+	// StatusDown is reported when the process, or the dependency, cannot serve requests.
+	StatusDown = "down"
+)
+
+// Check defines a dependency of the service checked by the readiness probe.
+type Check struct {
+	// Name identifies the dependency in the report.
+	Name string
+
+	// Check returns error if the dependency cannot serve requests. The check must return once the provided context is done,
+	// the context carries the deadline of the check.
+	Check func(ctx context.Context) error
+}
+
+// Report is the JSON response of the probes.
+type Report struct {
+	Status     string        `json:"status"`
+	DurationMs float64       `json:"durationMs"`
+	Checks     []CheckResult `json:"checks"`
+}
+
+// CheckResult is the outcome of a single dependency check. The error reported is generic, the probes are served without
+// authentication so the error returned by the check is only logged.
+type CheckResult struct {
+	Name       string  `json:"name"`
+	Status     string  `json:"status"`
+	DurationMs float64 `json:"durationMs"`
+	Error      string  `json:"error,omitempty"`
+}
+
+// NewLivenessHandler creates the handler of the liveness probe. It reports the process is up as long as it can serve
+// HTTP requests, without checking any dependency.
+// Returns the new handler.
+func NewLivenessHandler() http.Handler {
+	return NewReadinessHandler(nil, 0, nil)
+}
+
+// NewReadinessHandler creates the handler of the readiness probe. The checks run concurrently on every request. The
+// service is reported up with 200 OK if all checks succeed, otherwise down with 503 Service Unavailable.
+// checks: Optional. The dependencies to check.
+// timeout: Mandatory if checks are provided. How long a check can run before its dependency is reported down.
+// logger: Optional. When provided, the errors of the failed checks are logged.
+// Returns the new handler.
+func NewReadinessHandler(checks []Check, timeout time.Duration, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {
+		report := Run(httpRequest.Context(), checks, timeout, logger)
+		statusCode := http.StatusOK
+
+		if report.Status != StatusUp {
+			statusCode = http.StatusServiceUnavailable
+		}
+
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		writer.Header().Set("Cache-Control", "no-store")
+		writer.WriteHeader(statusCode)
+
+		json.NewEncoder(writer).Encode(report)
+	})
+}
+
+// Run runs the provided checks concurrently and reports their outcome, in the order of the provided checks.
+// ctx: Mandatory. The context the checks run in, each check gets a context derived from it with the check deadline.
+// checks: Optional. The dependencies to check.
+// timeout: Mandatory if checks are provided. How long a check can run before its dependency is reported down.
+// logger: Optional. When provided, the errors of the failed checks are logged.
+// Returns the report, up only if all checks succeed.
+func Run(ctx context.Context, checks []Check, timeout time.Duration, logger log.Logger) Report {
+	startTime := time.Now()
+	report := Report{Status: StatusUp, Checks: make([]CheckResult, len(checks))}
+	done := make(chan struct{}, len(checks))
+
+	for index, check := range checks {
+		go func(index int, check Check) {
+			report.Checks[index] = runCheck(ctx, check, timeout, logging.OrNop(logger))
+			done <- struct{}{}
+		}(index, check)
+	}
+
+	for range checks {
+		<-done
+	}
+
+	for _, result := range report.Checks {
+		if result.Status != StatusUp {
+			report.Status = StatusDown
+		}
+	}
+
+	report.DurationMs = millisecondsSince(startTime)
+
+	return report
+}
+
+// runCheck runs the provided check, reporting its dependency down if it fails, panics or does not complete in time. The
+// check is given a context that is done once the timeout elapses, so it stops instead of running in the background.
+func runCheck(ctx context.Context, check Check, timeout time.Duration, logger log.Logger) CheckResult {
+	startTime := time.Now()
+	checkErr := make(chan error, 1)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	go func() {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				checkErr <- fmt.Errorf("%v", recovered)
+			}
+		}()
+
+		checkErr <- check.Check(ctx)
+	}()
+
+	result := CheckResult{Name: check.Name, Status: StatusUp}
+
+	select {
+	case err := <-checkErr:
+		if err != nil {
+			result.Status = StatusDown
+			result.Error = "Check failed."
+			level.Error(logger).Log("msg", "Health check failed.", "check", check.Name, "err", err)
+		}
+
+	case <-ctx.Done():
+		result.Status = StatusDown
+		result.Error = fmt.Sprintf("Check did not complete within %s.", timeout)
+		level.Error(logger).Log("msg", "Health check did not complete in time.", "check", check.Name, "timeout", timeout)
+	}
+
+	result.DurationMs = millisecondsSince(startTime)
+
+	return result
+}
+
+func millisecondsSince(startTime time.Time) float64 {
+	return float64(time.Since(startTime).Nanoseconds()) / float64(time.Millisecond)
+}
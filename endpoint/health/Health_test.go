@@ -0,0 +1,110 @@
+package health_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/micro-business/TenantService/endpoint/health"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("Health probes behaviour", func() {
+	serve := func(handler http.Handler) (*httptest.ResponseRecorder, health.Report) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+		report := health.Report{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &report)).To(Succeed())
+
+		return recorder, report
+	}
+
+	succeeding := func(ctx context.Context) error { return nil }
+
+	It("should report the process up without checking any dependency for liveness", func() {
+		recorder, report := serve(health.NewLivenessHandler())
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("Content-Type")).To(HavePrefix("application/json"))
+		Expect(report.Status).To(Equal(health.StatusUp))
+		Expect(report.Checks).To(BeEmpty())
+	})
+
+	It("should report ready if all checks succeed", func() {
+		recorder, report := serve(health.NewReadinessHandler([]health.Check{
+			{Name: "cassandra", Check: succeeding},
+			{Name: "consul", Check: succeeding},
+		}, time.Second, nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(report.Status).To(Equal(health.StatusUp))
+		Expect(report.Checks).To(HaveLen(2))
+		Expect(report.Checks[0].Name).To(Equal("cassandra"))
+		Expect(report.Checks[0].Status).To(Equal(health.StatusUp))
+		Expect(report.Checks[1].Name).To(Equal("consul"))
+	})
+
+	It("should report not ready along with a generic error for the failed check and log the error of the check", func() {
+		logged := &bytes.Buffer{}
+
+		recorder, report := serve(health.NewReadinessHandler([]health.Check{
+			{Name: "cassandra", Check: func(ctx context.Context) error { return errors.New("no hosts available: 10.0.0.12:9042") }},
+			{Name: "consul", Check: succeeding},
+		}, time.Second, log.NewLogfmtLogger(logged)))
+
+		Expect(recorder.Code).To(Equal(http.StatusServiceUnavailable))
+		Expect(report.Status).To(Equal(health.StatusDown))
+		Expect(report.Checks[0].Status).To(Equal(health.StatusDown))
+		Expect(report.Checks[0].Error).To(Equal("Check failed."))
+		Expect(recorder.Body.String()).NotTo(ContainSubstring("10.0.0.12"))
+		Expect(logged.String()).To(ContainSubstring("no hosts available: 10.0.0.12:9042"))
+		Expect(report.Checks[1].Status).To(Equal(health.StatusUp))
+	})
+
+	It("should report the dependency down if its check does not complete in time", func() {
+		report := health.Run(context.Background(), []health.Check{
+			{Name: "cassandra", Check: func(ctx context.Context) error { time.Sleep(time.Second); return nil }},
+		}, 10*time.Millisecond, nil)
+
+		Expect(report.Status).To(Equal(health.StatusDown))
+		Expect(report.Checks[0].Error).To(ContainSubstring("did not complete"))
+		Expect(report.Checks[0].DurationMs).To(BeNumerically("<", 1000))
+	})
+
+	It("should stop the check once it does not complete in time", func() {
+		stopped := make(chan struct{})
+
+		health.Run(context.Background(), []health.Check{
+			{Name: "cassandra", Check: func(ctx context.Context) error {
+				<-ctx.Done()
+				close(stopped)
+
+				return ctx.Err()
+			}},
+		}, 10*time.Millisecond, nil)
+
+		Eventually(stopped).Should(BeClosed())
+	})
+
+	It("should report the dependency down if its check panics", func() {
+		report := health.Run(context.Background(), []health.Check{
+			{Name: "cassandra", Check: func(ctx context.Context) error { panic("ClusterConfig must be provided.") }},
+		}, time.Second, nil)
+
+		Expect(report.Status).To(Equal(health.StatusDown))
+		Expect(report.Checks[0].Error).To(Equal("Check failed."))
+	})
+})
+
+func TestHealth(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Health probes behaviour")
+}
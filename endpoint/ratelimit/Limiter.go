@@ -0,0 +1,212 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+	"github.com/micro-business/TenantService/endpoint/security"
+	"github.com/micro-business/TenantService/logging"
+	"golang.org/x/net/context"
+)
+
+// Limiter throttles the requests of the callers using a token bucket per caller. The limits can be changed while the
+// requests are served, see SetLimits.
+// TrustedProxies holds the networks of the reverse proxies the service is reached through. The anonymous callers reaching
+// the service through them are identified by the X-Forwarded-For header. If empty, the service must be reached directly,
+// otherwise all the anonymous callers behind a proxy share the bucket of the proxy.
+type Limiter struct {
+	Store          Store
+	DefaultLimit   Limit
+	TenantLimits   map[string]Limit
+	TrustedProxies []*net.IPNet
+
+	mutex sync.RWMutex
+}
+
+// SetLimits changes the limits applied to the requests served from then on. The buckets of the callers whose limit
+// changed are started over.
+// defaultLimit: Mandatory. The limit applied to every caller that does not have its own limit.
+// tenantLimits: Mandatory. The limits overridden per tenant, keyed by tenant unique identifier.
+func (limiter *Limiter) SetLimits(defaultLimit Limit, tenantLimits map[string]Limit) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	limiter.DefaultLimit = defaultLimit
+	limiter.TenantLimits = tenantLimits
+}
+
+// Middleware returns an HTTP handler that throttles the requests before they are passed to the provided handler.
+// The caller is identified by the application or tenant bound to the authenticated principal, by the principal itself
+// if it is not bound to a tenant, and by the client IP address for anonymous callers. The client IP address is read
+// from the X-Forwarded-For header only when the request is received from a trusted proxy, nothing else the caller sends
+// in the request is used to identify it. Throttled requests are answered with 429 Too Many Requests.
+// next: Mandatory. The handler that serves the allowed requests.
+func (limiter *Limiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {
+		key, limit, result, err := limiter.take(httpRequest.Context(), limiter.clientAddress(httpRequest))
+
+		if err != nil {
+			logger, _ := logging.FromContext(httpRequest.Context())
+			level.Warn(logger).Log("msg", "Failed to apply rate limit, letting the request through.", "key", key, "err", err)
+			next.ServeHTTP(writer, httpRequest)
+
+			return
+		}
+
+		if !isLimited(limit) {
+			next.ServeHTTP(writer, httpRequest)
+
+			return
+		}
+
+		writer.Header().Set("RateLimit-Limit", strconv.Itoa(limit.Burst))
+		writer.Header().Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		writer.Header().Set("RateLimit-Reset", strconv.Itoa(result.ResetSeconds()))
+
+		if result.Allowed {
+			next.ServeHTTP(writer, httpRequest)
+
+			return
+		}
+
+		writer.Header().Set("Retry-After", strconv.Itoa(result.RetryAfterSeconds()))
+		writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+		writer.WriteHeader(http.StatusTooManyRequests)
+
+		json.NewEncoder(writer).Encode(map[string]interface{}{
+			"errors": []map[string]string{{"message": "Too many requests. Retry after the time given in Retry-After header."}},
+		})
+	})
+}
+
+// Take takes a token from the bucket of the caller of a request, identified the same way as by Middleware, so the
+// requests served over other transports, e.g. gRPC, share the buckets of the HTTP API.
+// ctx: Mandatory. The context of the request, carrying the authenticated principal, if any.
+// remoteAddress: Mandatory. The network address of the caller, identifying the anonymous callers.
+// Returns the limit applied to the caller along with the result of taking the token, or error if the bucket cannot be
+// read. The limit is empty and the request allowed if the caller is not limited.
+func (limiter *Limiter) Take(ctx context.Context, remoteAddress string) (Limit, Result, error) {
+	_, limit, result, err := limiter.take(ctx, remoteAddress)
+
+	return limit, result, err
+}
+
+// clientAddress returns the address of the client that sent the provided request. If the request is received from a
+// trusted proxy, the X-Forwarded-For header is read from right to left, skipping the trusted proxies, and the first
+// address not trusted is returned. The last trusted address is returned if the header holds no other valid address.
+func (limiter *Limiter) clientAddress(httpRequest *http.Request) string {
+	clientAddress := httpRequest.RemoteAddr
+
+	if !limiter.isTrustedProxy(clientAddress) {
+		return clientAddress
+	}
+
+	forwardedAddresses := strings.Split(strings.Join(httpRequest.Header["X-Forwarded-For"], ","), ",")
+
+	for index := len(forwardedAddresses) - 1; index >= 0; index-- {
+		forwardedAddress := strings.TrimSpace(forwardedAddresses[index])
+
+		if net.ParseIP(forwardedAddress) == nil {
+			return clientAddress
+		}
+
+		clientAddress = forwardedAddress
+
+		if !limiter.isTrustedProxy(clientAddress) {
+			return clientAddress
+		}
+	}
+
+	return clientAddress
+}
+
+// isTrustedProxy checks whether the provided address, with or without port, belongs to a trusted proxy.
+func (limiter *Limiter) isTrustedProxy(address string) bool {
+	if len(limiter.TrustedProxies) == 0 {
+		return false
+	}
+
+	ipAddress := net.ParseIP(hostOf(address))
+
+	if ipAddress == nil {
+		return false
+	}
+
+	for _, trustedProxy := range limiter.TrustedProxies {
+		if trustedProxy.Contains(ipAddress) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// take takes a token from the bucket of the caller of a request.
+// Returns the bucket key and the limit of the caller along with the result of taking the token, or error if the bucket
+// cannot be read.
+func (limiter *Limiter) take(ctx context.Context, remoteAddress string) (string, Limit, Result, error) {
+	key, limit := limiter.resolveKeyAndLimit(ctx, remoteAddress)
+
+	if !isLimited(limit) {
+		return key, Limit{}, Result{Allowed: true}, nil
+	}
+
+	result, err := limiter.Store.Take(key, limit, time.Now())
+
+	return key, limit, result, err
+}
+
+// resolveKeyAndLimit resolves the bucket key of the caller and the limit that applies to it.
+func (limiter *Limiter) resolveKeyAndLimit(ctx context.Context, remoteAddress string) (string, Limit) {
+	limiter.mutex.RLock()
+	defer limiter.mutex.RUnlock()
+
+	principal, authenticated := security.PrincipalFromContext(ctx)
+
+	if authenticated && len(principal.TenantID) != 0 {
+		limit := limiter.limitOfTenant(principal.TenantID)
+
+		if len(principal.ApplicationID) != 0 {
+			return "application:" + principal.TenantID + "/" + principal.ApplicationID, limit
+		}
+
+		return "tenant:" + principal.TenantID, limit
+	}
+
+	if authenticated && len(principal.Subject) != 0 {
+		return "principal:" + principal.Subject, limiter.DefaultLimit
+	}
+
+	return "ip:" + hostOf(remoteAddress), limiter.DefaultLimit
+}
+
+// hostOf returns the host of the provided network address, or the address itself if it has no port.
+func hostOf(address string) string {
+	host, _, err := net.SplitHostPort(address)
+
+	if err != nil {
+		return address
+	}
+
+	return host
+}
+
+// limitOfTenant returns the limit overridden for the provided tenant, or the default limit if the tenant has no override.
+func (limiter *Limiter) limitOfTenant(tenantID string) Limit {
+	if limit, ok := limiter.TenantLimits[tenantID]; ok {
+		return limit
+	}
+
+	return limiter.DefaultLimit
+}
+
+// isLimited checks whether the provided limit throttles the requests, an empty limit disables the rate limiting.
+func isLimited(limit Limit) bool {
+	return limit.RequestsPerSecond > 0 && limit.Burst > 0
+}
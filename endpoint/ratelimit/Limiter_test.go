@@ -0,0 +1,97 @@
+package ratelimit_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/micro-business/TenantService/endpoint/ratelimit"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Limiter behaviour", func() {
+	var (
+		limiter *ratelimit.Limiter
+		handler http.Handler
+	)
+
+	serveFrom := func(remoteAddress string, forwardedFor string) int {
+		httpRequest := httptest.NewRequest(http.MethodPost, "/Api", nil)
+		httpRequest.RemoteAddr = remoteAddress
+
+		if len(forwardedFor) != 0 {
+			httpRequest.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+
+		recorder := httptest.NewRecorder()
+
+		handler.ServeHTTP(recorder, httpRequest)
+
+		return recorder.Code
+	}
+
+	serve := func() int {
+		return serveFrom("10.0.0.1:1234", "")
+	}
+
+	BeforeEach(func() {
+		limiter = &ratelimit.Limiter{
+			Store:        ratelimit.NewMemoryStore(),
+			DefaultLimit: ratelimit.Limit{RequestsPerSecond: 1, Burst: 1},
+		}
+
+		handler = limiter.Middleware(http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {}))
+	})
+
+	It("should throttle the anonymous caller once its bucket is empty", func() {
+		Expect(serve()).To(Equal(http.StatusOK))
+		Expect(serve()).To(Equal(http.StatusTooManyRequests))
+	})
+
+	It("should apply the limits changed while the requests are served", func() {
+		Expect(serve()).To(Equal(http.StatusOK))
+
+		limiter.SetLimits(ratelimit.Limit{RequestsPerSecond: 1, Burst: 3}, map[string]ratelimit.Limit{})
+
+		Expect(serve()).To(Equal(http.StatusOK))
+		Expect(serve()).To(Equal(http.StatusOK))
+	})
+
+	It("should stop throttling once the limits are disabled", func() {
+		Expect(serve()).To(Equal(http.StatusOK))
+
+		limiter.SetLimits(ratelimit.Limit{}, map[string]ratelimit.Limit{})
+
+		Expect(serve()).To(Equal(http.StatusOK))
+		Expect(serve()).To(Equal(http.StatusOK))
+	})
+
+	It("should ignore the X-Forwarded-For header of the requests not received from a trusted proxy", func() {
+		Expect(serveFrom("10.0.0.1:1234", "192.168.0.1")).To(Equal(http.StatusOK))
+		Expect(serveFrom("10.0.0.1:1234", "192.168.0.2")).To(Equal(http.StatusTooManyRequests))
+	})
+
+	It("should identify the anonymous callers behind a trusted proxy by their forwarded address", func() {
+		_, trustedProxy, _ := net.ParseCIDR("10.0.0.0/24")
+		limiter.TrustedProxies = []*net.IPNet{trustedProxy}
+
+		Expect(serveFrom("10.0.0.1:1234", "192.168.0.1")).To(Equal(http.StatusOK))
+		Expect(serveFrom("10.0.0.1:1234", "192.168.0.2, 10.0.0.2")).To(Equal(http.StatusOK))
+		Expect(serveFrom("10.0.0.1:1234", "192.168.0.3, 192.168.0.1")).To(Equal(http.StatusTooManyRequests))
+	})
+
+	It("should fall back to the trusted proxy if the forwarded address is not valid", func() {
+		_, trustedProxy, _ := net.ParseCIDR("10.0.0.0/24")
+		limiter.TrustedProxies = []*net.IPNet{trustedProxy}
+
+		Expect(serveFrom("10.0.0.1:1234", "unknown")).To(Equal(http.StatusOK))
+		Expect(serveFrom("10.0.0.1:1234", "")).To(Equal(http.StatusTooManyRequests))
+	})
+})
+
+func TestLimiter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Limiter behaviour")
+}
@@ -0,0 +1,70 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/micro-business/TenantService/endpoint/ratelimit"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MemoryStore Take method behaviour", func() {
+	var (
+		store *ratelimit.MemoryStore
+		limit ratelimit.Limit
+		now   time.Time
+	)
+
+	BeforeEach(func() {
+		store = ratelimit.NewMemoryStore()
+		limit = ratelimit.Limit{RequestsPerSecond: 1, Burst: 2}
+		now = time.Now()
+	})
+
+	It("should allow requests up to the burst size", func() {
+		result, err := store.Take("key", limit, now)
+		Expect(err).To(BeNil())
+		Expect(result.Allowed).To(BeTrue())
+		Expect(result.Remaining).To(Equal(1))
+
+		result, err = store.Take("key", limit, now)
+		Expect(err).To(BeNil())
+		Expect(result.Allowed).To(BeTrue())
+		Expect(result.Remaining).To(Equal(0))
+	})
+
+	It("should throttle requests once the bucket is empty and return the time to wait", func() {
+		store.Take("key", limit, now)
+		store.Take("key", limit, now)
+
+		result, err := store.Take("key", limit, now)
+		Expect(err).To(BeNil())
+		Expect(result.Allowed).To(BeFalse())
+		Expect(result.RetryAfter).To(Equal(time.Second))
+		Expect(result.Reset).To(Equal(2 * time.Second))
+	})
+
+	It("should refill the bucket over time", func() {
+		store.Take("key", limit, now)
+		store.Take("key", limit, now)
+
+		result, err := store.Take("key", limit, now.Add(time.Second))
+		Expect(err).To(BeNil())
+		Expect(result.Allowed).To(BeTrue())
+	})
+
+	It("should keep separate buckets for different keys", func() {
+		store.Take("key", limit, now)
+		store.Take("key", limit, now)
+
+		result, err := store.Take("another key", limit, now)
+		Expect(err).To(BeNil())
+		Expect(result.Allowed).To(BeTrue())
+	})
+})
+
+func TestMemoryStoreTake(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "MemoryStore Take method behaviour")
+}
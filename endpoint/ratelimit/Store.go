@@ -0,0 +1,146 @@
+// Package ratelimit implements token bucket rate limiting of the requests sent to the service.
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often the memory store removes the buckets that are full and therefore carry no state.
+const sweepInterval = time.Minute
+
+// Limit defines the token bucket of a caller
+type Limit struct {
+	// RequestsPerSecond is the rate the bucket is refilled at.
+	RequestsPerSecond float64
+
+	// Burst is the size of the bucket.
+	Burst int
+}
+
+// Result defines the outcome of taking a token from a bucket
+type Result struct {
+	// Allowed is true if a token was available and the request can go ahead.
+	Allowed bool
+
+	// Remaining is the number of tokens left in the bucket.
+	Remaining int
+
+	// RetryAfter is how long the caller should wait before the next token is available. Zero when the request is allowed.
+	RetryAfter time.Duration
+
+	// Reset is how long it takes for the bucket to be full again.
+	Reset time.Duration
+}
+
+// RetryAfterSeconds returns the number of whole seconds the caller should wait before retrying a throttled request.
+func (result Result) RetryAfterSeconds() int {
+	return ceilSeconds(result.RetryAfter)
+}
+
+// ResetSeconds returns the number of whole seconds it takes for the bucket of the caller to be full again.
+func (result Result) ResetSeconds() int {
+	return ceilSeconds(result.Reset)
+}
+
+// Store contract, it keeps the token buckets of all callers. Implementations can keep the buckets in memory or in a
+// distributed store shared between the service instances.
+type Store interface {
+	// Take takes a token from the bucket of the provided key.
+	// key: Mandatory. The unique identifier of the bucket.
+	// limit: Mandatory. The limit to apply to the bucket.
+	// now: Mandatory. The current time.
+	// Returns either the result of taking the token or error if something goes wrong.
+	Take(key string, limit Limit, now time.Time) (Result, error)
+}
+
+// MemoryStore implements Store keeping the token buckets in memory of the current service instance.
+type MemoryStore struct {
+	mutex     sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens     float64
+	lastRefill time.Time
+	limit      Limit
+}
+
+// NewMemoryStore creates a new empty memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*bucket)}
+}
+
+// Take takes a token from the bucket of the provided key.
+// key: Mandatory. The unique identifier of the bucket.
+// limit: Mandatory. The limit to apply to the bucket.
+// now: Mandatory. The current time.
+// Returns either the result of taking the token or error if something goes wrong.
+func (store *MemoryStore) Take(key string, limit Limit, now time.Time) (Result, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.sweep(now)
+
+	currentBucket, ok := store.buckets[key]
+
+	if !ok || currentBucket.limit != limit {
+		currentBucket = &bucket{tokens: float64(limit.Burst), lastRefill: now, limit: limit}
+		store.buckets[key] = currentBucket
+	}
+
+	currentBucket.refill(now)
+
+	result := Result{}
+
+	if currentBucket.tokens >= 1 {
+		currentBucket.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = secondsToDuration((1 - currentBucket.tokens) / limit.RequestsPerSecond)
+	}
+
+	result.Remaining = int(math.Floor(currentBucket.tokens))
+	result.Reset = secondsToDuration((float64(limit.Burst) - currentBucket.tokens) / limit.RequestsPerSecond)
+
+	return result, nil
+}
+
+// sweep removes the buckets that are full again, a full bucket behaves the same as a missing one.
+func (store *MemoryStore) sweep(now time.Time) {
+	if now.Sub(store.lastSweep) < sweepInterval {
+		return
+	}
+
+	store.lastSweep = now
+
+	for key, currentBucket := range store.buckets {
+		currentBucket.refill(now)
+
+		if currentBucket.tokens >= float64(currentBucket.limit.Burst) {
+			delete(store.buckets, key)
+		}
+	}
+}
+
+// refill adds the tokens earned since the last refill to the bucket, up to the bucket size.
+func (currentBucket *bucket) refill(now time.Time) {
+	elapsed := now.Sub(currentBucket.lastRefill).Seconds()
+
+	if elapsed <= 0 {
+		return
+	}
+
+	currentBucket.tokens = math.Min(float64(currentBucket.limit.Burst), currentBucket.tokens+elapsed*currentBucket.limit.RequestsPerSecond)
+	currentBucket.lastRefill = now
+}
+
+func ceilSeconds(duration time.Duration) int {
+	return int(math.Ceil(duration.Seconds()))
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
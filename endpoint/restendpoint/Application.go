@@ -0,0 +1,173 @@
+package restendpoint
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/contract"
+	"github.com/micro-business/TenantService/business/domain"
+	"golang.org/x/net/context"
+)
+
+const applicationIDParameter = "applicationID"
+
+// applicationResource is the JSON representation of a tenant application.
+type applicationResource struct {
+	ID       string `json:"id"`
+	TenantID string `json:"tenantId"`
+	Name     string `json:"name"`
+}
+
+// applicationInput is the JSON request body of the requests creating or updating a tenant application.
+type applicationInput struct {
+	Name string `json:"name"`
+}
+
+type applicationRequest struct {
+	tenantID      system.UUID
+	applicationID system.UUID
+	application   domain.Application
+}
+
+func makeReadAllApplicationsEndpoint(tenantService contract.TenantService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		tenantID := request.(applicationRequest).tenantID
+		applications, err := tenantService.ReadAllApplications(tenantID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		resources := make([]applicationResource, 0, len(applications))
+
+		for applicationID, application := range applications {
+			resources = append(resources, newApplicationResource(tenantID, applicationID, application))
+		}
+
+		sort.Slice(resources, func(i, j int) bool { return resources[i].ID < resources[j].ID })
+
+		return response{statusCode: http.StatusOK, body: resources}, nil
+	}
+}
+
+func makeCreateApplicationEndpoint(tenantService contract.TenantService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		applicationRequest := request.(applicationRequest)
+		applicationID, err := tenantService.CreateApplication(applicationRequest.tenantID, applicationRequest.application)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return response{
+			statusCode: http.StatusCreated,
+			location:   applicationPath(applicationRequest.tenantID, applicationID),
+			body:       newApplicationResource(applicationRequest.tenantID, applicationID, applicationRequest.application),
+		}, nil
+	}
+}
+
+func makeReadApplicationEndpoint(tenantService contract.TenantService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		applicationRequest := request.(applicationRequest)
+		application, err := tenantService.ReadApplication(applicationRequest.tenantID, applicationRequest.applicationID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return response{
+			statusCode: http.StatusOK,
+			body:       newApplicationResource(applicationRequest.tenantID, applicationRequest.applicationID, application),
+		}, nil
+	}
+}
+
+func makeUpdateApplicationEndpoint(tenantService contract.TenantService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		applicationRequest := request.(applicationRequest)
+
+		if err := tenantService.UpdateApplication(applicationRequest.tenantID, applicationRequest.applicationID, applicationRequest.application); err != nil {
+			return nil, err
+		}
+
+		return response{
+			statusCode: http.StatusOK,
+			body:       newApplicationResource(applicationRequest.tenantID, applicationRequest.applicationID, applicationRequest.application),
+		}, nil
+	}
+}
+
+func makeDeleteApplicationEndpoint(tenantService contract.TenantService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		applicationRequest := request.(applicationRequest)
+
+		if err := tenantService.DeleteApplication(applicationRequest.tenantID, applicationRequest.applicationID); err != nil {
+			return nil, err
+		}
+
+		return response{statusCode: http.StatusNoContent}, nil
+	}
+}
+
+// decodeApplicationIDRequest decodes the requests addressing tenant applications by the unique identifiers provided in
+// the path. The application unique identifier is only decoded if the route provides it.
+func decodeApplicationIDRequest(ctx context.Context, httpRequest *http.Request) (interface{}, error) {
+	request := applicationRequest{}
+	tenantID, err := parsePathUUID(ctx, tenantIDParameter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	request.tenantID = tenantID
+
+	if len(pathParameter(ctx, applicationIDParameter)) != 0 {
+		applicationID, err := parsePathUUID(ctx, applicationIDParameter)
+
+		if err != nil {
+			return nil, err
+		}
+
+		request.applicationID = applicationID
+	}
+
+	return request, nil
+}
+
+// createApplicationInputDecoder creates the decoder of the requests carrying a tenant application in their body.
+func createApplicationInputDecoder(maxRequestBodySize int64) func(ctx context.Context, httpRequest *http.Request) (interface{}, error) {
+	return func(ctx context.Context, httpRequest *http.Request) (interface{}, error) {
+		decoded, err := decodeApplicationIDRequest(ctx, httpRequest)
+
+		if err != nil {
+			return nil, err
+		}
+
+		request := decoded.(applicationRequest)
+		input := applicationInput{}
+
+		if err = decodeJSONBody(httpRequest, maxRequestBodySize, &input); err != nil {
+			return nil, err
+		}
+
+		if strings.TrimSpace(input.Name) == "" {
+			return nil, newUnprocessableEntityError("name must be provided.")
+		}
+
+		request.application = domain.Application{Name: input.Name}
+
+		return request, nil
+	}
+}
+
+func newApplicationResource(tenantID system.UUID, applicationID system.UUID, application domain.Application) applicationResource {
+	return applicationResource{ID: applicationID.String(), TenantID: tenantID.String(), Name: application.Name}
+}
+
+func applicationPath(tenantID system.UUID, applicationID system.UUID) string {
+	return tenantPath(tenantID) + "/applications/" + applicationID.String()
+}
@@ -0,0 +1,130 @@
+package restendpoint_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/domain"
+	datacontract "github.com/micro-business/TenantService/data/contract"
+	"github.com/micro-business/TenantService/endpoint/restendpoint"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Application routes behaviour", func() {
+	var (
+		mockCtrl          *gomock.Controller
+		mockTenantService *MockTenantService
+		handler           http.Handler
+		tenantID          system.UUID
+		applicationID     system.UUID
+		applicationsPath  string
+		applicationPath   string
+	)
+
+	serve := func(method string, path string, body string) *httptest.ResponseRecorder {
+		httpRequest := httptest.NewRequest(method, path, strings.NewReader(body))
+
+		if len(body) != 0 {
+			httpRequest.Header.Set("Content-Type", "application/json; charset=utf-8")
+		}
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httpRequest)
+
+		return recorder
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+		handler = restendpoint.NewHandler(mockTenantService, restendpoint.Options{})
+
+		tenantID, _ = system.RandomUUID()
+		applicationID, _ = system.RandomUUID()
+		applicationsPath = "/tenants/" + tenantID.String() + "/applications"
+		applicationPath = applicationsPath + "/" + applicationID.String()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	It("should return the application read by the tenant service", func() {
+		mockTenantService.EXPECT().ReadApplication(tenantID, applicationID).Return(domain.Application{Name: "Application"}, nil)
+
+		recorder := serve(http.MethodGet, applicationPath, "")
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(MatchJSON(`{"id":"` + applicationID.String() + `","tenantId":"` + tenantID.String() + `","name":"Application"}`))
+	})
+
+	It("should return 404 problem if the application does not exist", func() {
+		mockTenantService.EXPECT().ReadApplication(tenantID, applicationID).Return(domain.Application{}, datacontract.NewApplicationNotFoundError(tenantID, applicationID))
+
+		recorder := serve(http.MethodGet, applicationPath, "")
+
+		Expect(recorder.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("should return 400 problem if the application unique identifier is not a UUID", func() {
+		recorder := serve(http.MethodGet, applicationsPath+"/invalid", "")
+
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should return all applications of the tenant", func() {
+		mockTenantService.EXPECT().ReadAllApplications(tenantID).Return(map[system.UUID]domain.Application{applicationID: {Name: "Application"}}, nil)
+
+		recorder := serve(http.MethodGet, applicationsPath, "")
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		applications := []map[string]interface{}{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &applications)).To(Succeed())
+		Expect(applications).To(HaveLen(1))
+		Expect(applications[0]["id"]).To(Equal(applicationID.String()))
+	})
+
+	It("should create the application and return its location", func() {
+		mockTenantService.EXPECT().CreateApplication(tenantID, domain.Application{Name: "Application"}).Return(applicationID, nil)
+
+		recorder := serve(http.MethodPost, applicationsPath, `{"name":"Application"}`)
+
+		Expect(recorder.Code).To(Equal(http.StatusCreated))
+		Expect(recorder.Header().Get("Location")).To(Equal(applicationPath))
+	})
+
+	It("should return 422 problem if the application name is not provided", func() {
+		recorder := serve(http.MethodPost, applicationsPath, `{}`)
+
+		Expect(recorder.Code).To(Equal(http.StatusUnprocessableEntity))
+	})
+
+	It("should update the application", func() {
+		mockTenantService.EXPECT().UpdateApplication(tenantID, applicationID, domain.Application{Name: "Renamed"}).Return(nil)
+
+		recorder := serve(http.MethodPut, applicationPath, `{"name":"Renamed"}`)
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+	})
+
+	It("should delete the application and return no content", func() {
+		mockTenantService.EXPECT().DeleteApplication(tenantID, applicationID).Return(nil)
+
+		recorder := serve(http.MethodDelete, applicationPath, "")
+
+		Expect(recorder.Code).To(Equal(http.StatusNoContent))
+	})
+})
+
+func TestApplicationRoutes(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Application routes behaviour")
+}
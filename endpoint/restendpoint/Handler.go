@@ -0,0 +1,213 @@
+// Package restendpoint serves the tenants and their applications as REST resources, alongside the GraphQL API.
+package restendpoint
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/micro-business/Micro-Business-Core/common/diagnostics"
+	"github.com/micro-business/TenantService/business/contract"
+	"github.com/micro-business/TenantService/idempotency"
+	"golang.org/x/net/context"
+)
+
+// Options defines how the REST requests are served.
+type Options struct {
+	// MaxRequestBodySize is the maximum size of the request body in bytes. Zero means no limit.
+	MaxRequestBodySize int64
+}
+
+// route defines a REST route along with the operation it is documented as in the OpenAPI document.
+type route struct {
+	method       string
+	pattern      string
+	operation    operation
+	makeEndpoint func(tenantService contract.TenantService) endpoint.Endpoint
+	decode       httptransport.DecodeRequestFunc
+}
+
+// operation describes a route in the OpenAPI document.
+type operation struct {
+	id             string
+	summary        string
+	tag            string
+	requestSchema  string
+	statusCode     int
+	responseSchema string
+	responseIsList bool
+	errorStatuses  []int
+
+	// idempotent is true if the route accepts an Idempotency-Key header, so a retry returns the resource created first.
+	idempotent bool
+}
+
+// NewHandler creates the handler serving the REST routes and the OpenAPI document describing them.
+// tenantService: Mandatory. Reference to the tenant service the requests are served by.
+// options: Mandatory. Defines how the requests are served.
+// Returns the new handler.
+func NewHandler(tenantService contract.TenantService, options Options) http.Handler {
+	diagnostics.IsNotNil(tenantService, "tenantService", "tenantService must be provided.")
+
+	router := &router{}
+
+	for _, route := range newRoutes(options) {
+		decode := route.decode
+		before := []httptransport.RequestFunc{httptransport.PopulateRequestContext}
+
+		if route.operation.idempotent {
+			decode = withIdempotencyKey(decode)
+			before = append(before, idempotency.PopulateRequestContext)
+		}
+
+		router.handle(route.method, route.pattern, httptransport.NewServer(
+			withRequestContext(route.makeEndpoint, tenantService),
+			decode,
+			encodeResponse,
+			httptransport.ServerBefore(before...),
+			httptransport.ServerErrorEncoder(encodeProblem)))
+	}
+
+	router.handle(http.MethodGet, OpenAPIDocumentPath, http.HandlerFunc(serveOpenAPIDocument))
+
+	return router
+}
+
+// withRequestContext creates the endpoint using the provided function, passing it the tenant service bound to the
+// context of the request, so its logs and spans are correlated with the request.
+func withRequestContext(makeEndpoint func(tenantService contract.TenantService) endpoint.Endpoint, tenantService contract.TenantService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return makeEndpoint(tenantService.WithContext(ctx))(ctx, request)
+	}
+}
+
+// withIdempotencyKey wraps the provided decoder, rejecting the requests sent with an idempotency key that is not valid.
+func withIdempotencyKey(decode httptransport.DecodeRequestFunc) httptransport.DecodeRequestFunc {
+	return func(ctx context.Context, httpRequest *http.Request) (interface{}, error) {
+		if key := httpRequest.Header.Get(idempotency.Header); len(key) != 0 && !idempotency.IsValidKey(key) {
+			return nil, newBadRequestError(fmt.Sprintf("%s must be made of at most %d printable ASCII characters.", idempotency.Header, idempotency.MaxKeyLength))
+		}
+
+		return decode(ctx, httpRequest)
+	}
+}
+
+func newRoutes(options Options) []route {
+	decodeTenantInput := createTenantInputDecoder(options.MaxRequestBodySize)
+	decodeApplicationInput := createApplicationInputDecoder(options.MaxRequestBodySize)
+
+	return []route{
+		{
+			method:  http.MethodGet,
+			pattern: "/tenants",
+			operation: operation{
+				id: "listTenants", summary: "Lists all tenants", tag: "Tenants",
+				statusCode: http.StatusOK, responseSchema: "Tenant", responseIsList: true,
+			},
+			makeEndpoint: makeReadAllTenantsEndpoint,
+			decode:       decodeNoRequest,
+		},
+		{
+			method:  http.MethodPost,
+			pattern: "/tenants",
+			operation: operation{
+				id: "createTenant", summary: "Creates a tenant", tag: "Tenants", requestSchema: "TenantInput",
+				statusCode: http.StatusCreated, responseSchema: "Tenant",
+				errorStatuses: []int{http.StatusBadRequest, http.StatusConflict, http.StatusRequestEntityTooLarge, http.StatusUnsupportedMediaType, http.StatusUnprocessableEntity},
+				idempotent:    true,
+			},
+			makeEndpoint: makeCreateTenantEndpoint,
+			decode:       decodeTenantInput,
+		},
+		{
+			method:  http.MethodGet,
+			pattern: "/tenants/{tenantID}",
+			operation: operation{
+				id: "getTenant", summary: "Returns a tenant", tag: "Tenants",
+				statusCode: http.StatusOK, responseSchema: "Tenant",
+				errorStatuses: []int{http.StatusBadRequest, http.StatusNotFound},
+			},
+			makeEndpoint: makeReadTenantEndpoint,
+			decode:       decodeTenantIDRequest,
+		},
+		{
+			method:  http.MethodPut,
+			pattern: "/tenants/{tenantID}",
+			operation: operation{
+				id: "updateTenant", summary: "Updates a tenant", tag: "Tenants", requestSchema: "TenantInput",
+				statusCode: http.StatusOK, responseSchema: "Tenant",
+				errorStatuses: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusRequestEntityTooLarge, http.StatusUnsupportedMediaType, http.StatusUnprocessableEntity},
+			},
+			makeEndpoint: makeUpdateTenantEndpoint,
+			decode:       decodeTenantInput,
+		},
+		{
+			method:  http.MethodDelete,
+			pattern: "/tenants/{tenantID}",
+			operation: operation{
+				id: "deleteTenant", summary: "Deletes a tenant along with its applications", tag: "Tenants",
+				statusCode:    http.StatusNoContent,
+				errorStatuses: []int{http.StatusBadRequest, http.StatusNotFound},
+			},
+			makeEndpoint: makeDeleteTenantEndpoint,
+			decode:       decodeTenantIDRequest,
+		},
+		{
+			method:  http.MethodGet,
+			pattern: "/tenants/{tenantID}/applications",
+			operation: operation{
+				id: "listApplications", summary: "Lists all applications of a tenant", tag: "Applications",
+				statusCode: http.StatusOK, responseSchema: "Application", responseIsList: true,
+				errorStatuses: []int{http.StatusBadRequest, http.StatusNotFound},
+			},
+			makeEndpoint: makeReadAllApplicationsEndpoint,
+			decode:       decodeApplicationIDRequest,
+		},
+		{
+			method:  http.MethodPost,
+			pattern: "/tenants/{tenantID}/applications",
+			operation: operation{
+				id: "createApplication", summary: "Creates an application of a tenant", tag: "Applications", requestSchema: "ApplicationInput",
+				statusCode: http.StatusCreated, responseSchema: "Application",
+				errorStatuses: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusRequestEntityTooLarge, http.StatusUnsupportedMediaType, http.StatusUnprocessableEntity},
+				idempotent:    true,
+			},
+			makeEndpoint: makeCreateApplicationEndpoint,
+			decode:       decodeApplicationInput,
+		},
+		{
+			method:  http.MethodGet,
+			pattern: "/tenants/{tenantID}/applications/{applicationID}",
+			operation: operation{
+				id: "getApplication", summary: "Returns an application of a tenant", tag: "Applications",
+				statusCode: http.StatusOK, responseSchema: "Application",
+				errorStatuses: []int{http.StatusBadRequest, http.StatusNotFound},
+			},
+			makeEndpoint: makeReadApplicationEndpoint,
+			decode:       decodeApplicationIDRequest,
+		},
+		{
+			method:  http.MethodPut,
+			pattern: "/tenants/{tenantID}/applications/{applicationID}",
+			operation: operation{
+				id: "updateApplication", summary: "Updates an application of a tenant", tag: "Applications", requestSchema: "ApplicationInput",
+				statusCode: http.StatusOK, responseSchema: "Application",
+				errorStatuses: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusRequestEntityTooLarge, http.StatusUnsupportedMediaType, http.StatusUnprocessableEntity},
+			},
+			makeEndpoint: makeUpdateApplicationEndpoint,
+			decode:       decodeApplicationInput,
+		},
+		{
+			method:  http.MethodDelete,
+			pattern: "/tenants/{tenantID}/applications/{applicationID}",
+			operation: operation{
+				id: "deleteApplication", summary: "Deletes an application of a tenant", tag: "Applications",
+				statusCode:    http.StatusNoContent,
+				errorStatuses: []int{http.StatusBadRequest, http.StatusNotFound},
+			},
+			makeEndpoint: makeDeleteApplicationEndpoint,
+			decode:       decodeApplicationIDRequest,
+		},
+	}
+}
@@ -0,0 +1,92 @@
+package restendpoint
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"github.com/micro-business/Micro-Business-Core/system"
+	"golang.org/x/net/context"
+)
+
+// response defines the response of a REST route
+type response struct {
+	statusCode int
+	location   string
+	body       interface{}
+}
+
+// encodeResponse encodes the response of a REST route. The body, if any, is encoded as JSON.
+func encodeResponse(ctx context.Context, writer http.ResponseWriter, routeResponse interface{}) error {
+	response := routeResponse.(response)
+
+	if len(response.location) != 0 {
+		writer.Header().Set("Location", response.location)
+	}
+
+	if response.body == nil {
+		writer.WriteHeader(response.statusCode)
+
+		return nil
+	}
+
+	writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+	writer.WriteHeader(response.statusCode)
+
+	return json.NewEncoder(writer).Encode(response.body)
+}
+
+// decodeJSONBody decodes the JSON body of the provided request into the provided target, rejecting unknown members and
+// bodies larger than the provided maximum size. Zero maximum size means no limit.
+func decodeJSONBody(httpRequest *http.Request, maxRequestBodySize int64, target interface{}) error {
+	mediaType, _, err := mime.ParseMediaType(httpRequest.Header.Get("Content-Type"))
+
+	if err != nil || mediaType != "application/json" {
+		return problemError{statusCode: http.StatusUnsupportedMediaType, detail: "Content type must be application/json."}
+	}
+
+	var reader io.Reader = httpRequest.Body
+
+	if maxRequestBodySize > 0 {
+		reader = io.LimitReader(httpRequest.Body, maxRequestBodySize+1)
+	}
+
+	body, err := ioutil.ReadAll(reader)
+
+	if err != nil {
+		return newBadRequestError("Request body could not be read. Error: " + err.Error())
+	}
+
+	if maxRequestBodySize > 0 && int64(len(body)) > maxRequestBodySize {
+		return problemError{
+			statusCode: http.StatusRequestEntityTooLarge,
+			detail:     fmt.Sprintf("Request body exceeds the maximum allowed size of %d bytes.", maxRequestBodySize),
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+
+	if err = decoder.Decode(target); err != nil {
+		return newBadRequestError("Request body must be a JSON object. Error: " + err.Error())
+	}
+
+	return nil
+}
+
+// parsePathUUID parses the unique identifier provided as the provided path parameter.
+// Returns either the unique identifier or error if it is not a valid non-empty UUID.
+func parsePathUUID(ctx context.Context, name string) (system.UUID, error) {
+	value := pathParameter(ctx, name)
+	id, err := system.ParseUUID(value)
+
+	if err != nil || id == system.EmptyUUID {
+		return system.EmptyUUID, newBadRequestError(fmt.Sprintf("%s must be a valid UUID. Value: %s", name, value))
+	}
+
+	return id, nil
+}
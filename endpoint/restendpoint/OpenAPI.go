@@ -0,0 +1,173 @@
+package restendpoint
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/micro-business/TenantService/idempotency"
+)
+
+// OpenAPIDocumentPath is the path the OpenAPI document describing the REST routes is served on.
+const OpenAPIDocumentPath = "/openapi.json"
+
+// OpenAPIDocument generates the OpenAPI 3 document describing the REST routes. Members are sorted by name so the output
+// only changes when the routes change.
+// Returns the JSON encoded OpenAPI document.
+func OpenAPIDocument() []byte {
+	paths := map[string]map[string]interface{}{}
+
+	for _, route := range newRoutes(Options{}) {
+		if _, ok := paths[route.pattern]; !ok {
+			paths[route.pattern] = map[string]interface{}{}
+		}
+
+		paths[route.pattern][strings.ToLower(route.method)] = describeOperation(route)
+	}
+
+	document := map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":       "Tenant Service",
+			"description": "Manages the tenants and their applications.",
+			"version":     "1.0.0",
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": map[string]interface{}{
+				"Tenant": objectSchema(map[string]interface{}{
+					"id":        uuidSchema(),
+					"secretKey": map[string]interface{}{"type": "string", "description": "Masked unless the caller is granted the tenant:secret-key:read permission."},
+				}, "id", "secretKey"),
+				"TenantInput": objectSchema(map[string]interface{}{
+					"secretKey": map[string]interface{}{"type": "string", "minLength": 1},
+				}, "secretKey"),
+				"Application": objectSchema(map[string]interface{}{
+					"id":       uuidSchema(),
+					"tenantId": uuidSchema(),
+					"name":     map[string]interface{}{"type": "string"},
+				}, "id", "tenantId", "name"),
+				"ApplicationInput": objectSchema(map[string]interface{}{
+					"name": map[string]interface{}{"type": "string", "minLength": 1},
+				}, "name"),
+				"Problem": objectSchema(map[string]interface{}{
+					"type":     map[string]interface{}{"type": "string"},
+					"title":    map[string]interface{}{"type": "string"},
+					"status":   map[string]interface{}{"type": "integer"},
+					"detail":   map[string]interface{}{"type": "string"},
+					"instance": map[string]interface{}{"type": "string"},
+				}, "type", "title", "status"),
+			},
+		},
+	}
+
+	encoded, _ := json.MarshalIndent(document, "", "  ")
+
+	return append(encoded, '\n')
+}
+
+func describeOperation(route route) map[string]interface{} {
+	parameters := []interface{}{}
+
+	for _, segment := range splitPath(route.pattern) {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			parameters = append(parameters, map[string]interface{}{
+				"name":     segment[1 : len(segment)-1],
+				"in":       "path",
+				"required": true,
+				"schema":   uuidSchema(),
+			})
+		}
+	}
+
+	if route.operation.idempotent {
+		parameters = append(parameters, map[string]interface{}{
+			"name":        idempotency.Header,
+			"in":          "header",
+			"required":    false,
+			"description": "Makes the request safe to retry. A retry with the same key and body returns the resource created first.",
+			"schema":      map[string]interface{}{"type": "string", "maxLength": idempotency.MaxKeyLength},
+		})
+	}
+
+	successResponse := map[string]interface{}{"description": http.StatusText(route.operation.statusCode)}
+
+	if len(route.operation.responseSchema) != 0 {
+		schema := schemaReference(route.operation.responseSchema)
+
+		if route.operation.responseIsList {
+			schema = map[string]interface{}{"type": "array", "items": schema}
+		}
+
+		successResponse["content"] = map[string]interface{}{"application/json": map[string]interface{}{"schema": schema}}
+	}
+
+	if route.operation.statusCode == http.StatusCreated {
+		successResponse["headers"] = map[string]interface{}{
+			"Location": map[string]interface{}{
+				"description": "The path of the created resource",
+				"schema":      map[string]interface{}{"type": "string"},
+			},
+		}
+	}
+
+	responses := map[string]interface{}{
+		strconv.Itoa(route.operation.statusCode): successResponse,
+		"default":                                problemResponse("Unexpected error"),
+	}
+
+	for _, errorStatus := range route.operation.errorStatuses {
+		responses[strconv.Itoa(errorStatus)] = problemResponse(http.StatusText(errorStatus))
+	}
+
+	described := map[string]interface{}{
+		"operationId": route.operation.id,
+		"summary":     route.operation.summary,
+		"tags":        []string{route.operation.tag},
+		"responses":   responses,
+	}
+
+	if len(parameters) != 0 {
+		described["parameters"] = parameters
+	}
+
+	if len(route.operation.requestSchema) != 0 {
+		described["requestBody"] = map[string]interface{}{
+			"required": true,
+			"content":  map[string]interface{}{"application/json": map[string]interface{}{"schema": schemaReference(route.operation.requestSchema)}},
+		}
+	}
+
+	return described
+}
+
+func problemResponse(description string) map[string]interface{} {
+	return map[string]interface{}{
+		"description": description,
+		"content":     map[string]interface{}{problemMediaType: map[string]interface{}{"schema": schemaReference("Problem")}},
+	}
+}
+
+func objectSchema(properties map[string]interface{}, required ...string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
+
+func uuidSchema() map[string]interface{} {
+	return map[string]interface{}{"type": "string", "format": "uuid"}
+}
+
+func schemaReference(name string) map[string]interface{} {
+	return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+}
+
+// serveOpenAPIDocument serves the OpenAPI document describing the REST routes.
+func serveOpenAPIDocument(writer http.ResponseWriter, httpRequest *http.Request) {
+	writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+	writer.Write(OpenAPIDocument())
+}
@@ -0,0 +1,61 @@
+package restendpoint_test
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/TenantService/endpoint/restendpoint"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// openAPIGoldenFilePath is the path to the expected OpenAPI document. Run the tests with -update-openapi to accept a change.
+const openAPIGoldenFilePath = "testdata/openapi.json"
+
+var updateOpenAPIGoldenFile = flag.Bool("update-openapi", false, "Overwrites the OpenAPI golden file with the current document.")
+
+var _ = Describe("OpenAPI document behaviour", func() {
+	It("should match the OpenAPI golden file", func() {
+		document := restendpoint.OpenAPIDocument()
+
+		if *updateOpenAPIGoldenFile {
+			Expect(ioutil.WriteFile(openAPIGoldenFilePath, document, 0644)).To(Succeed())
+		}
+
+		expectedDocument, err := ioutil.ReadFile(openAPIGoldenFilePath)
+
+		Expect(err).To(BeNil())
+		Expect(string(document)).To(Equal(string(expectedDocument)), "The REST API changed. Make sure the change is backward compatible and run the tests with -update-openapi to accept it.")
+	})
+
+	It("should describe every route", func() {
+		document := struct {
+			Paths map[string]map[string]interface{} `json:"paths"`
+		}{}
+
+		Expect(json.Unmarshal(restendpoint.OpenAPIDocument(), &document)).To(Succeed())
+		Expect(document.Paths).To(HaveLen(4))
+		Expect(document.Paths["/tenants/{tenantID}/applications/{applicationID}"]).To(HaveLen(3))
+	})
+
+	It("should serve the OpenAPI document", func() {
+		mockCtrl := gomock.NewController(GinkgoT())
+		defer mockCtrl.Finish()
+
+		recorder := httptest.NewRecorder()
+		restendpoint.NewHandler(NewMockTenantService(mockCtrl), restendpoint.Options{}).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, restendpoint.OpenAPIDocumentPath, nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.Bytes()).To(Equal(restendpoint.OpenAPIDocument()))
+	})
+})
+
+func TestOpenAPIDocument(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OpenAPI document behaviour")
+}
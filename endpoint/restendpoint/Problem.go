@@ -0,0 +1,99 @@
+package restendpoint
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-kit/kit/log/level"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/micro-business/TenantService/logging"
+	"golang.org/x/net/context"
+)
+
+const problemMediaType = "application/problem+json"
+
+// problem is the problem details object, as defined by RFC 7807, sent to the client when a request fails.
+type problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+}
+
+// problemError is returned when the request sent by the client cannot be served. It carries the HTTP status code the
+// request is answered with.
+type problemError struct {
+	statusCode int
+	detail     string
+}
+
+func (err problemError) Error() string {
+	return err.detail
+}
+
+// StatusCode returns the HTTP status code of the error.
+func (err problemError) StatusCode() int {
+	return err.statusCode
+}
+
+// notFoundError is implemented by the errors reported when the requested tenant or application does not exist.
+type notFoundError interface {
+	NotFound() bool
+}
+
+// conflictError is implemented by the errors reported when an idempotency key is reused with a different request.
+type conflictError interface {
+	Conflict() bool
+}
+
+func newBadRequestError(detail string) problemError {
+	return problemError{statusCode: http.StatusBadRequest, detail: detail}
+}
+
+func newUnprocessableEntityError(detail string) problemError {
+	return problemError{statusCode: http.StatusUnprocessableEntity, detail: detail}
+}
+
+// encodeProblem encodes the provided error as a problem details object. The errors that are neither reported by the
+// REST layer nor not found or conflict errors are unexpected, their details are logged but not sent to the client.
+func encodeProblem(ctx context.Context, err error, writer http.ResponseWriter) {
+	instance, _ := ctx.Value(httptransport.ContextKeyRequestPath).(string)
+
+	if problemErr, ok := err.(problemError); ok {
+		writeProblem(writer, problemErr.statusCode, problemErr.detail, instance)
+
+		return
+	}
+
+	if notFoundErr, ok := err.(notFoundError); ok && notFoundErr.NotFound() {
+		writeProblem(writer, http.StatusNotFound, err.Error(), instance)
+
+		return
+	}
+
+	if conflictErr, ok := err.(conflictError); ok && conflictErr.Conflict() {
+		writeProblem(writer, http.StatusConflict, err.Error(), instance)
+
+		return
+	}
+
+	logger, _ := logging.FromContext(ctx)
+	level.Error(logger).Log("msg", "Failed to serve REST request.", "path", instance, "err", err)
+
+	writeProblem(writer, http.StatusInternalServerError, "The request could not be served.", instance)
+}
+
+// writeProblem writes a problem details object with the provided status code and detail.
+func writeProblem(writer http.ResponseWriter, statusCode int, detail string, instance string) {
+	writer.Header().Set("Content-Type", problemMediaType+"; charset=utf-8")
+	writer.WriteHeader(statusCode)
+
+	json.NewEncoder(writer).Encode(problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(statusCode),
+		Status:   statusCode,
+		Detail:   detail,
+		Instance: instance,
+	})
+}
@@ -0,0 +1,98 @@
+package restendpoint
+
+import (
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/context"
+)
+
+type contextKey int
+
+const pathParametersContextKey contextKey = iota
+
+// router dispatches the requests to the handler of the route matching the request method and path. Path segments of a
+// pattern written in braces, e.g. {tenantID}, match any segment and are made available to the handler using pathParameter.
+type router struct {
+	routes []routeHandler
+}
+
+type routeHandler struct {
+	method   string
+	segments []string
+	handler  http.Handler
+}
+
+// handle registers the provided handler for the provided method and path pattern.
+func (router *router) handle(method string, pattern string, handler http.Handler) {
+	router.routes = append(router.routes, routeHandler{method: method, segments: splitPath(pattern), handler: handler})
+}
+
+// ServeHTTP serves the request using the handler of the matching route. Requests whose path matches a route but not its
+// method are answered with 405 Method Not Allowed, other unmatched requests with 404 Not Found.
+func (router *router) ServeHTTP(writer http.ResponseWriter, httpRequest *http.Request) {
+	segments := splitPath(httpRequest.URL.Path)
+	allowedMethods := []string{}
+
+	for _, route := range router.routes {
+		pathParameters, matched := matchPath(route.segments, segments)
+
+		if !matched {
+			continue
+		}
+
+		if route.method != httpRequest.Method {
+			allowedMethods = append(allowedMethods, route.method)
+
+			continue
+		}
+
+		route.handler.ServeHTTP(writer, httpRequest.WithContext(context.WithValue(httpRequest.Context(), pathParametersContextKey, pathParameters)))
+
+		return
+	}
+
+	if len(allowedMethods) != 0 {
+		writer.Header().Set("Allow", strings.Join(allowedMethods, ", "))
+		writeProblem(writer, http.StatusMethodNotAllowed, "HTTP method "+httpRequest.Method+" is not supported by the resource.", httpRequest.URL.Path)
+
+		return
+	}
+
+	writeProblem(writer, http.StatusNotFound, "Resource not found.", httpRequest.URL.Path)
+}
+
+// pathParameter returns the value of the provided path parameter of the route serving the request.
+func pathParameter(ctx context.Context, name string) string {
+	pathParameters, _ := ctx.Value(pathParametersContextKey).(map[string]string)
+
+	return pathParameters[name]
+}
+
+func matchPath(patternSegments []string, segments []string) (map[string]string, bool) {
+	if len(patternSegments) != len(segments) {
+		return nil, false
+	}
+
+	pathParameters := make(map[string]string)
+
+	for index, patternSegment := range patternSegments {
+		if strings.HasPrefix(patternSegment, "{") && strings.HasSuffix(patternSegment, "}") {
+			pathParameters[patternSegment[1:len(patternSegment)-1]] = segments[index]
+		} else if patternSegment != segments[index] {
+			return nil, false
+		}
+	}
+
+	return pathParameters, true
+}
+
+func splitPath(path string) []string {
+	path = strings.Trim(path, "/")
+
+	if len(path) == 0 {
+		return []string{}
+	}
+
+	return strings.Split(path, "/")
+}
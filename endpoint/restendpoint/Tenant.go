@@ -0,0 +1,173 @@
+package restendpoint
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/contract"
+	"github.com/micro-business/TenantService/business/domain"
+	"github.com/micro-business/TenantService/endpoint/security"
+	"golang.org/x/net/context"
+)
+
+const (
+	tenantIDParameter = "tenantID"
+
+	// maskedValue is returned instead of the value of a sensitive member when the caller lacks the required permission.
+	maskedValue = "********"
+)
+
+// tenantResource is the JSON representation of a tenant.
+type tenantResource struct {
+	ID        string `json:"id"`
+	SecretKey string `json:"secretKey"`
+}
+
+// tenantInput is the JSON request body of the requests creating or updating a tenant.
+type tenantInput struct {
+	SecretKey string `json:"secretKey"`
+}
+
+type tenantRequest struct {
+	tenantID system.UUID
+	tenant   domain.Tenant
+}
+
+func makeReadAllTenantsEndpoint(tenantService contract.TenantService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		tenants, err := tenantService.ReadAllTenants()
+
+		if err != nil {
+			return nil, err
+		}
+
+		resources := make([]tenantResource, 0, len(tenants))
+
+		for tenantID, tenant := range tenants {
+			resources = append(resources, newTenantResource(ctx, tenantID, tenant))
+		}
+
+		sort.Slice(resources, func(i, j int) bool { return resources[i].ID < resources[j].ID })
+
+		return response{statusCode: http.StatusOK, body: resources}, nil
+	}
+}
+
+func makeCreateTenantEndpoint(tenantService contract.TenantService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		tenant := request.(tenantRequest).tenant
+		tenantID, err := tenantService.CreateTenant(tenant)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return response{
+			statusCode: http.StatusCreated,
+			location:   tenantPath(tenantID),
+			body:       newTenantResource(ctx, tenantID, tenant),
+		}, nil
+	}
+}
+
+func makeReadTenantEndpoint(tenantService contract.TenantService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		tenantID := request.(tenantRequest).tenantID
+		tenant, err := tenantService.ReadTenant(tenantID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return response{statusCode: http.StatusOK, body: newTenantResource(ctx, tenantID, tenant)}, nil
+	}
+}
+
+func makeUpdateTenantEndpoint(tenantService contract.TenantService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		tenantRequest := request.(tenantRequest)
+
+		if err := tenantService.UpdateTenant(tenantRequest.tenantID, tenantRequest.tenant); err != nil {
+			return nil, err
+		}
+
+		return response{statusCode: http.StatusOK, body: newTenantResource(ctx, tenantRequest.tenantID, tenantRequest.tenant)}, nil
+	}
+}
+
+func makeDeleteTenantEndpoint(tenantService contract.TenantService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if err := tenantService.DeleteTenant(request.(tenantRequest).tenantID); err != nil {
+			return nil, err
+		}
+
+		return response{statusCode: http.StatusNoContent}, nil
+	}
+}
+
+// decodeNoRequest decodes the requests that carry neither a path parameter nor a body.
+func decodeNoRequest(ctx context.Context, httpRequest *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
+// decodeTenantIDRequest decodes the requests addressing a single tenant by the unique identifier provided in the path.
+func decodeTenantIDRequest(ctx context.Context, httpRequest *http.Request) (interface{}, error) {
+	tenantID, err := parsePathUUID(ctx, tenantIDParameter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tenantRequest{tenantID: tenantID}, nil
+}
+
+// createTenantInputDecoder creates the decoder of the requests carrying a tenant in their body. The unique identifier of
+// the tenant is decoded from the path if the route provides it.
+func createTenantInputDecoder(maxRequestBodySize int64) func(ctx context.Context, httpRequest *http.Request) (interface{}, error) {
+	return func(ctx context.Context, httpRequest *http.Request) (interface{}, error) {
+		request := tenantRequest{}
+
+		if len(pathParameter(ctx, tenantIDParameter)) != 0 {
+			tenantID, err := parsePathUUID(ctx, tenantIDParameter)
+
+			if err != nil {
+				return nil, err
+			}
+
+			request.tenantID = tenantID
+		}
+
+		input := tenantInput{}
+
+		if err := decodeJSONBody(httpRequest, maxRequestBodySize, &input); err != nil {
+			return nil, err
+		}
+
+		if strings.TrimSpace(input.SecretKey) == "" {
+			return nil, newUnprocessableEntityError("secretKey must be provided.")
+		}
+
+		request.tenant = domain.Tenant{SecretKey: input.SecretKey}
+
+		return request, nil
+	}
+}
+
+// newTenantResource creates the JSON representation of the provided tenant. The secret key is masked unless the caller
+// is granted the permission to read it.
+func newTenantResource(ctx context.Context, tenantID system.UUID, tenant domain.Tenant) tenantResource {
+	resource := tenantResource{ID: tenantID.String(), SecretKey: maskedValue}
+
+	if principal, authenticated := security.PrincipalFromContext(ctx); authenticated && principal.HasPermission(security.ReadTenantSecretKeyPermission) {
+		resource.SecretKey = tenant.SecretKey
+	}
+
+	return resource
+}
+
+func tenantPath(tenantID system.UUID) string {
+	return "/tenants/" + tenantID.String()
+}
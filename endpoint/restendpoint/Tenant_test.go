@@ -0,0 +1,302 @@
+package restendpoint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/golang/mock/gomock"
+	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/contract"
+	"github.com/micro-business/TenantService/business/domain"
+	datacontract "github.com/micro-business/TenantService/data/contract"
+	"github.com/micro-business/TenantService/endpoint/restendpoint"
+	"github.com/micro-business/TenantService/endpoint/security"
+	"github.com/micro-business/TenantService/idempotency"
+	"github.com/micro-business/TenantService/logging"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("Tenant routes behaviour", func() {
+	var (
+		mockCtrl          *gomock.Controller
+		mockTenantService *MockTenantService
+		handler           http.Handler
+		tenantID          system.UUID
+		secretKey         string
+		authorized        bool
+	)
+
+	serve := func(method string, path string, body string) *httptest.ResponseRecorder {
+		httpRequest := httptest.NewRequest(method, path, strings.NewReader(body))
+
+		if len(body) != 0 {
+			httpRequest.Header.Set("Content-Type", "application/json")
+		}
+
+		if authorized {
+			httpRequest = httpRequest.WithContext(security.WithPrincipal(
+				httpRequest.Context(),
+				security.Principal{Name: "admin", Permissions: []string{security.ReadTenantSecretKeyPermission}}))
+		}
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httpRequest)
+
+		return recorder
+	}
+
+	decodeBody := func(recorder *httptest.ResponseRecorder) map[string]interface{} {
+		body := map[string]interface{}{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &body)).To(Succeed())
+
+		return body
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockTenantService = NewMockTenantService(mockCtrl)
+		mockTenantService.EXPECT().WithContext(gomock.Any()).Return(mockTenantService).AnyTimes()
+		handler = restendpoint.NewHandler(mockTenantService, restendpoint.Options{MaxRequestBodySize: 1024})
+
+		tenantID, _ = system.RandomUUID()
+		randomValue, _ := system.RandomUUID()
+		secretKey = randomValue.String()
+		authorized = true
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Describe("GET /tenants/{tenantID}", func() {
+		It("should return the tenant read by the tenant service", func() {
+			mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{SecretKey: secretKey}, nil)
+
+			recorder := serve(http.MethodGet, "/tenants/"+tenantID.String(), "")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(HavePrefix("application/json"))
+			Expect(decodeBody(recorder)).To(Equal(map[string]interface{}{"id": tenantID.String(), "secretKey": secretKey}))
+		})
+
+		It("should mask the secret key if the caller is not granted the permission to read it", func() {
+			authorized = false
+			mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{SecretKey: secretKey}, nil)
+
+			recorder := serve(http.MethodGet, "/tenants/"+tenantID.String(), "")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(decodeBody(recorder)["secretKey"]).To(Equal("********"))
+		})
+
+		It("should return 404 problem if the tenant does not exist", func() {
+			mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{}, datacontract.NewTenantNotFoundError(tenantID))
+
+			recorder := serve(http.MethodGet, "/tenants/"+tenantID.String(), "")
+
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			Expect(recorder.Header().Get("Content-Type")).To(HavePrefix("application/problem+json"))
+
+			problem := decodeBody(recorder)
+			Expect(problem["status"]).To(BeEquivalentTo(http.StatusNotFound))
+			Expect(problem["title"]).To(Equal("Not Found"))
+			Expect(problem["detail"]).To(ContainSubstring(tenantID.String()))
+			Expect(problem["instance"]).To(Equal("/tenants/" + tenantID.String()))
+		})
+
+		It("should return 400 problem if the tenant unique identifier is not a UUID", func() {
+			recorder := serve(http.MethodGet, "/tenants/invalid", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(decodeBody(recorder)["detail"]).To(ContainSubstring("tenantID must be a valid UUID"))
+		})
+
+		It("should serve the request using the tenant service bound to the context of the request", func() {
+			output := &bytes.Buffer{}
+			handler = logging.Middleware(log.NewLogfmtLogger(output), handler)
+
+			mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{}, errors.New("Failed"))
+
+			recorder := serve(http.MethodGet, "/tenants/"+tenantID.String(), "")
+
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			Expect(recorder.Header().Get(logging.CorrelationIDHeader)).NotTo(BeEmpty())
+			Expect(output.String()).To(ContainSubstring("Failed to serve REST request."))
+			Expect(output.String()).To(ContainSubstring("request_id=" + recorder.Header().Get(logging.CorrelationIDHeader)))
+		})
+
+		It("should return 500 problem without the error details if the tenant service fails unexpectedly", func() {
+			mockTenantService.EXPECT().ReadTenant(tenantID).Return(domain.Tenant{}, errors.New("connection refused"))
+
+			recorder := serve(http.MethodGet, "/tenants/"+tenantID.String(), "")
+
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			Expect(recorder.Body.String()).NotTo(ContainSubstring("connection refused"))
+		})
+	})
+
+	Describe("GET /tenants", func() {
+		It("should return all tenants sorted by their unique identifier", func() {
+			otherTenantID, _ := system.RandomUUID()
+			mockTenantService.EXPECT().ReadAllTenants().Return(map[system.UUID]domain.Tenant{
+				tenantID:      {SecretKey: secretKey},
+				otherTenantID: {SecretKey: secretKey},
+			}, nil)
+
+			recorder := serve(http.MethodGet, "/tenants", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			tenants := []map[string]interface{}{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &tenants)).To(Succeed())
+			Expect(tenants).To(HaveLen(2))
+			Expect(tenants[0]["id"].(string) < tenants[1]["id"].(string)).To(BeTrue())
+		})
+	})
+
+	Describe("POST /tenants", func() {
+		It("should create the tenant and return its location", func() {
+			mockTenantService.EXPECT().CreateTenant(domain.Tenant{SecretKey: secretKey}).Return(tenantID, nil)
+
+			recorder := serve(http.MethodPost, "/tenants", `{"secretKey":"`+secretKey+`"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			Expect(recorder.Header().Get("Location")).To(Equal("/tenants/" + tenantID.String()))
+			Expect(decodeBody(recorder)).To(Equal(map[string]interface{}{"id": tenantID.String(), "secretKey": secretKey}))
+		})
+
+		It("should create the tenant with the idempotency key sent in the Idempotency-Key header", func() {
+			mockTenantService = NewMockTenantService(mockCtrl)
+			handler = restendpoint.NewHandler(mockTenantService, restendpoint.Options{MaxRequestBodySize: 1024})
+
+			mockTenantService.EXPECT().WithContext(gomock.Any()).DoAndReturn(func(ctx context.Context) contract.TenantService {
+				key, _ := idempotency.FromContext(ctx)
+				Expect(key).To(Equal("key"))
+
+				return mockTenantService
+			})
+			mockTenantService.EXPECT().CreateTenant(domain.Tenant{SecretKey: secretKey}).Return(tenantID, nil)
+
+			httpRequest := httptest.NewRequest(http.MethodPost, "/tenants", strings.NewReader(`{"secretKey":"`+secretKey+`"}`))
+			httpRequest.Header.Set("Content-Type", "application/json")
+			httpRequest.Header.Set(idempotency.Header, "key")
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, httpRequest)
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+		})
+
+		It("should return 409 problem if the idempotency key was used with a different request", func() {
+			mockTenantService.EXPECT().CreateTenant(domain.Tenant{SecretKey: secretKey}).Return(system.EmptyUUID, idempotency.ConflictError{})
+
+			recorder := serve(http.MethodPost, "/tenants", `{"secretKey":"`+secretKey+`"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusConflict))
+		})
+
+		It("should return 400 problem if the idempotency key is not valid", func() {
+			httpRequest := httptest.NewRequest(http.MethodPost, "/tenants", strings.NewReader(`{"secretKey":"`+secretKey+`"}`))
+			httpRequest.Header.Set("Content-Type", "application/json")
+			httpRequest.Header.Set(idempotency.Header, strings.Repeat("a", idempotency.MaxKeyLength+1))
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, httpRequest)
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return 422 problem if the secret key is not provided", func() {
+			recorder := serve(http.MethodPost, "/tenants", `{"secretKey":"  "}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusUnprocessableEntity))
+		})
+
+		It("should return 400 problem if the body is not valid JSON", func() {
+			recorder := serve(http.MethodPost, "/tenants", `{"secretKey":`)
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return 400 problem if the body carries unknown members", func() {
+			recorder := serve(http.MethodPost, "/tenants", `{"secretKey":"key","unknown":true}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return 415 problem if the body is not JSON", func() {
+			httpRequest := httptest.NewRequest(http.MethodPost, "/tenants", strings.NewReader("secretKey=key"))
+			httpRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			recorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(recorder, httpRequest)
+
+			Expect(recorder.Code).To(Equal(http.StatusUnsupportedMediaType))
+		})
+
+		It("should return 413 problem if the body exceeds the maximum size", func() {
+			recorder := serve(http.MethodPost, "/tenants", `{"secretKey":"`+strings.Repeat("a", 1024)+`"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusRequestEntityTooLarge))
+		})
+	})
+
+	Describe("PUT /tenants/{tenantID}", func() {
+		It("should update the tenant", func() {
+			mockTenantService.EXPECT().UpdateTenant(tenantID, domain.Tenant{SecretKey: secretKey}).Return(nil)
+
+			recorder := serve(http.MethodPut, "/tenants/"+tenantID.String(), `{"secretKey":"`+secretKey+`"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(decodeBody(recorder)["id"]).To(Equal(tenantID.String()))
+		})
+
+		It("should return 404 problem if the tenant does not exist", func() {
+			mockTenantService.EXPECT().UpdateTenant(tenantID, domain.Tenant{SecretKey: secretKey}).Return(datacontract.NewTenantNotFoundError(tenantID))
+
+			recorder := serve(http.MethodPut, "/tenants/"+tenantID.String(), `{"secretKey":"`+secretKey+`"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("DELETE /tenants/{tenantID}", func() {
+		It("should delete the tenant and return no content", func() {
+			mockTenantService.EXPECT().DeleteTenant(tenantID).Return(nil)
+
+			recorder := serve(http.MethodDelete, "/tenants/"+tenantID.String(), "")
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			Expect(recorder.Body.Len()).To(Equal(0))
+		})
+	})
+
+	Describe("Routing", func() {
+		It("should return 405 problem along with the allowed methods if the method is not supported", func() {
+			recorder := serve(http.MethodPatch, "/tenants/"+tenantID.String(), "")
+
+			Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+			Expect(recorder.Header().Get("Allow")).To(Equal("GET, PUT, DELETE"))
+		})
+
+		It("should return 404 problem if no route matches the path", func() {
+			recorder := serve(http.MethodGet, "/tenants/"+tenantID.String()+"/unknown", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			Expect(recorder.Header().Get("Content-Type")).To(HavePrefix("application/problem+json"))
+		})
+	})
+})
+
+func TestTenantRoutes(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Tenant routes behaviour")
+}
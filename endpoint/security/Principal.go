@@ -0,0 +1,92 @@
+// Package security defines the authenticated caller of the service and the helpers to carry it through a request.
+package security
+
+import (
+	"crypto/x509"
+
+	"golang.org/x/net/context"
+)
+
+type contextKey int
+
+const principalContextKey contextKey = iota
+
+// ReadTenantSecretKeyPermission is the permission required to read the secret key of a tenant.
+const ReadTenantSecretKeyPermission = "tenant:secret-key:read"
+
+// Principal defines how an authenticated caller looks like
+type Principal struct {
+	// Name is the short name of the caller, e.g. the common name of the client certificate.
+	Name string
+
+	// Subject is the full distinguished name of the caller, e.g. the subject of the client certificate.
+	Subject string
+
+	// TenantID is the unique identifier of the tenant the caller acts on behalf of, bound to the caller by the
+	// configuration. Empty if the caller does not act on behalf of a tenant.
+	TenantID string
+
+	// ApplicationID is the unique identifier of the tenant application the caller acts on behalf of. Empty if the caller does not act on behalf of an application.
+	ApplicationID string
+
+	// Permissions is the list of permissions explicitly granted to the caller.
+	Permissions []string
+}
+
+// TenantBinding defines the tenant and application a principal is bound to
+type TenantBinding struct {
+	// TenantID is the unique identifier of the tenant.
+	TenantID string
+
+	// ApplicationID is the unique identifier of the tenant application. Empty if the principal is bound to the tenant itself.
+	ApplicationID string
+}
+
+// NewCertificatePrincipal creates the principal authenticated by the provided verified client certificate. The
+// permissions and the tenant of the principal are looked up by the common name of the certificate, so a caller can never
+// choose the tenant it acts on behalf of.
+// certificate: Mandatory. The verified client certificate.
+// permissions: Optional. The permissions explicitly granted to the principals, keyed by certificate common name.
+// tenantBindings: Optional. The tenant and application the principals are bound to, keyed by certificate common name.
+// Returns the authenticated principal.
+func NewCertificatePrincipal(certificate *x509.Certificate, permissions map[string][]string, tenantBindings map[string]TenantBinding) Principal {
+	tenantBinding := tenantBindings[certificate.Subject.CommonName]
+
+	return Principal{
+		Name:          certificate.Subject.CommonName,
+		Subject:       certificate.Subject.String(),
+		TenantID:      tenantBinding.TenantID,
+		ApplicationID: tenantBinding.ApplicationID,
+		Permissions:   permissions[certificate.Subject.CommonName],
+	}
+}
+
+// HasPermission checks whether the provided permission is explicitly granted to the principal.
+// permission: Mandatory. The permission to check.
+// Returns true if the permission is granted, otherwise false.
+func (principal Principal) HasPermission(permission string) bool {
+	for _, grantedPermission := range principal.Permissions {
+		if grantedPermission == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithPrincipal returns a copy of the provided context that carries the authenticated principal.
+// ctx: Mandatory. The parent context.
+// principal: Mandatory. The authenticated principal.
+// Returns the new context carrying the principal.
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey, principal)
+}
+
+// PrincipalFromContext returns the authenticated principal carried by the provided context.
+// ctx: Mandatory. The context to read the principal from.
+// Returns the principal and true if the caller is authenticated, otherwise an empty principal and false.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey).(Principal)
+
+	return principal, ok
+}
@@ -0,0 +1,22 @@
+#!/usr/bin/env bash
+
+# This script vendors the GraphiQL assets served with the explorer into the
+# directory given as first argument, which the
+# endpoint/graphql/graphiql-assets-directory setting must then point to. The
+# package versions are the ones the explorer page is written against.
+
+set -e
+
+DIRECTORY=${1:?Usage: $0 <assets directory>}
+
+function vendor {
+	curl --fail --silent --show-error --location --output "$DIRECTORY/$1" "https://unpkg.com/$2"
+}
+
+mkdir -p "$DIRECTORY"
+
+vendor graphiql.min.css graphiql@3.0.6/graphiql.min.css
+vendor react.production.min.js react@18.2.0/umd/react.production.min.js
+vendor react-dom.production.min.js react-dom@18.2.0/umd/react-dom.production.min.js
+vendor graphql-ws.min.js graphql-ws@5.14.0/umd/graphql-ws.min.js
+vendor graphiql.min.js graphiql@3.0.6/graphiql.min.js
@@ -0,0 +1,111 @@
+// Package idempotency lets the clients safely retry the requests creating tenants and applications. A request sent with
+// an idempotency key is recorded along with its fingerprint and its result, a retry sent with the same key and the same
+// payload returns the original result instead of creating a new tenant or application.
+package idempotency
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"net/http"
+
+	"golang.org/x/net/context"
+)
+
+// Header is the HTTP header, and the lower-cased gRPC metadata key, the idempotency key of a request is read from.
+const Header = "Idempotency-Key"
+
+// MaxKeyLength is the maximum length of an idempotency key sent by a client.
+const MaxKeyLength = 255
+
+type contextKey int
+
+const keyContextKey contextKey = iota
+
+// ConflictError is the error returned when an idempotency key is reused with a different request, or while the request
+// first sent with the key is still in progress.
+type ConflictError struct {
+	message string
+}
+
+// NewConflictError creates a new conflict error.
+// message: Mandatory. Why the idempotency key cannot be used.
+func NewConflictError(message string) ConflictError {
+	return ConflictError{message: message}
+}
+
+func (err ConflictError) Error() string {
+	return err.message
+}
+
+// Conflict always returns true. The callers detect the error by checking whether it implements Conflict() bool, the same
+// way they detect the not found errors.
+func (err ConflictError) Conflict() bool {
+	return true
+}
+
+// IsValidKey checks whether the provided idempotency key sent by a client can be used: not empty, not too long and made
+// of printable ASCII characters only.
+func IsValidKey(key string) bool {
+	if len(key) == 0 || len(key) > MaxKeyLength {
+		return false
+	}
+
+	for _, character := range key {
+		if character < ' ' || character > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewContext returns a copy of the provided context carrying the provided idempotency key.
+func NewContext(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, keyContextKey, key)
+}
+
+// FromContext returns the idempotency key carried by the provided context.
+// Returns the idempotency key and true, or an empty key and false if the context carries none.
+func FromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(keyContextKey).(string)
+
+	return key, ok && len(key) != 0
+}
+
+// PopulateRequestContext returns a copy of the provided context carrying the idempotency key sent in the Idempotency-Key
+// header of the provided request, if any. It can be used as a go-kit server before function.
+func PopulateRequestContext(ctx context.Context, httpRequest *http.Request) context.Context {
+	if key := httpRequest.Header.Get(Header); len(key) != 0 {
+		return NewContext(ctx, key)
+	}
+
+	return ctx
+}
+
+// Fingerprint computes the fingerprint of a request from the name of the operation and its payload. Only the hash is
+// kept, so the payload, e.g. the tenant secret key, is never stored along with the idempotency key.
+// parts: Mandatory. The name of the operation followed by the values of the payload.
+// Returns the hex encoded SHA-256 hash of the provided parts.
+func Fingerprint(parts ...string) string {
+	hash := sha256.New()
+	length := make([]byte, 8)
+
+	for _, part := range parts {
+		binary.BigEndian.PutUint64(length, uint64(len(part)))
+		hash.Write(length)
+		hash.Write([]byte(part))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// ScopedKey computes the key a request is recorded with from the idempotency key sent by the client and the scope of the
+// request, so the same idempotency key chosen by different callers, or sent for different operations or tenants, never
+// refers to the same request.
+// key: Mandatory. The idempotency key sent by the client.
+// scope: Mandatory. The authenticated caller, the operation and the tenant the key is scoped to, empty if not known.
+// Returns the hex encoded SHA-256 hash of the scope and the key.
+func ScopedKey(key string, scope ...string) string {
+	return Fingerprint(append(scope, key)...)
+}
@@ -0,0 +1,155 @@
+package idempotency_test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/micro-business/TenantService/idempotency"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("MemoryStore behaviour", func() {
+	var (
+		store *idempotency.MemoryStore
+		now   time.Time
+	)
+
+	BeforeEach(func() {
+		store = idempotency.NewMemoryStore(time.Minute, func() time.Time { return now })
+		now = time.Now()
+	})
+
+	It("should reserve the key for the first request", func() {
+		record, err := store.Reserve("key", "fingerprint")
+
+		Expect(err).To(BeNil())
+		Expect(record.Completed).To(BeFalse())
+	})
+
+	It("should return the result of the completed request if the same request is sent again", func() {
+		store.Reserve("key", "fingerprint")
+		Expect(store.Complete("key", "fingerprint", "result")).To(Succeed())
+
+		now = now.Add(time.Second)
+		record, err := store.Reserve("key", "fingerprint")
+
+		Expect(err).To(BeNil())
+		Expect(record.Completed).To(BeTrue())
+		Expect(record.Result).To(Equal("result"))
+	})
+
+	It("should not complete the reservation of a different request", func() {
+		store.Reserve("key", "fingerprint")
+		store.Complete("key", "other fingerprint", "result")
+
+		_, err := store.Reserve("key", "fingerprint")
+
+		Expect(err).To(BeAssignableToTypeOf(idempotency.ConflictError{}))
+	})
+
+	It("should return conflict error if the key is reused with a different request", func() {
+		store.Reserve("key", "fingerprint")
+		store.Complete("key", "fingerprint", "result")
+
+		_, err := store.Reserve("key", "other fingerprint")
+
+		Expect(err).To(BeAssignableToTypeOf(idempotency.ConflictError{}))
+		Expect(err.(idempotency.ConflictError).Conflict()).To(BeTrue())
+	})
+
+	It("should return conflict error while the request first sent with the key is in progress", func() {
+		store.Reserve("key", "fingerprint")
+
+		_, err := store.Reserve("key", "fingerprint")
+
+		Expect(err).To(BeAssignableToTypeOf(idempotency.ConflictError{}))
+	})
+
+	It("should let the request be retried once the reservation is released", func() {
+		store.Reserve("key", "fingerprint")
+		Expect(store.Release("key")).To(Succeed())
+
+		record, err := store.Reserve("key", "other fingerprint")
+
+		Expect(err).To(BeNil())
+		Expect(record.Completed).To(BeFalse())
+	})
+
+	It("should not release a completed request", func() {
+		store.Reserve("key", "fingerprint")
+		store.Complete("key", "fingerprint", "result")
+		store.Release("key")
+
+		record, err := store.Reserve("key", "fingerprint")
+
+		Expect(err).To(BeNil())
+		Expect(record.Completed).To(BeTrue())
+	})
+
+	It("should forget the completed request once the TTL elapses", func() {
+		store.Reserve("key", "fingerprint")
+		store.Complete("key", "fingerprint", "result")
+
+		now = now.Add(time.Minute)
+		record, err := store.Reserve("key", "other fingerprint")
+
+		Expect(err).To(BeNil())
+		Expect(record.Completed).To(BeFalse())
+	})
+
+	It("should keep the requests recorded after the TTL is changed for the new TTL", func() {
+		store.SetTTL(time.Hour)
+		store.Reserve("key", "fingerprint")
+		store.Complete("key", "fingerprint", "result")
+
+		now = now.Add(time.Minute)
+		record, err := store.Reserve("key", "fingerprint")
+
+		Expect(err).To(BeNil())
+		Expect(record.Completed).To(BeTrue())
+	})
+})
+
+var _ = Describe("Idempotency key behaviour", func() {
+	It("should accept the printable keys up to the maximum length", func() {
+		Expect(idempotency.IsValidKey("4bf92f35-77b3-4da6")).To(BeTrue())
+		Expect(idempotency.IsValidKey(strings.Repeat("a", 255))).To(BeTrue())
+	})
+
+	It("should reject the empty, too long or non printable keys", func() {
+		Expect(idempotency.IsValidKey("")).To(BeFalse())
+		Expect(idempotency.IsValidKey(strings.Repeat("a", 256))).To(BeFalse())
+		Expect(idempotency.IsValidKey("key\nforged")).To(BeFalse())
+	})
+
+	It("should read the key from the Idempotency-Key header", func() {
+		httpRequest := httptest.NewRequest("POST", "/tenants", nil)
+		httpRequest.Header.Set(idempotency.Header, "key")
+
+		key, ok := idempotency.FromContext(idempotency.PopulateRequestContext(context.Background(), httpRequest))
+
+		Expect(ok).To(BeTrue())
+		Expect(key).To(Equal("key"))
+	})
+
+	It("should not carry any key if the header is not sent", func() {
+		_, ok := idempotency.FromContext(idempotency.PopulateRequestContext(context.Background(), httptest.NewRequest("POST", "/tenants", nil)))
+
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should compute different fingerprints for different payloads", func() {
+		Expect(idempotency.Fingerprint("CreateTenant", "key")).To(Equal(idempotency.Fingerprint("CreateTenant", "key")))
+		Expect(idempotency.Fingerprint("CreateTenant", "key")).NotTo(Equal(idempotency.Fingerprint("CreateTenant", "other key")))
+		Expect(idempotency.Fingerprint("ab", "c")).NotTo(Equal(idempotency.Fingerprint("a", "bc")))
+	})
+})
+
+func TestIdempotency(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Idempotency behaviour")
+}
@@ -0,0 +1,154 @@
+package idempotency
+
+import (
+	"sync"
+	"time"
+)
+
+// Record defines the request recorded for an idempotency key
+type Record struct {
+	// Fingerprint is the fingerprint of the request first sent with the key, see Fingerprint.
+	Fingerprint string
+
+	// Result is the result of the request, empty until the request is completed.
+	Result string
+
+	// Completed is true once the request succeeded and its result is recorded.
+	Completed bool
+}
+
+// Store contract, it keeps the requests sent with an idempotency key. Implementations can keep the requests in memory or
+// in a distributed store shared between the service instances.
+type Store interface {
+	// Reserve records the request with the provided fingerprint for the provided key, unless a request is already recorded.
+	// key: Mandatory. The idempotency key sent by the client.
+	// fingerprint: Mandatory. The fingerprint of the request.
+	// Returns either the request already completed with the key, a record that is not completed if the key is reserved
+	// for the new request, or ConflictError if the key is used by a different request or by a request still in progress.
+	Reserve(key string, fingerprint string) (Record, error)
+
+	// Complete records the result of the request the provided key is reserved for.
+	// key: Mandatory. The idempotency key sent by the client.
+	// fingerprint: Mandatory. The fingerprint of the request the key is reserved for.
+	// result: Mandatory. The result of the request.
+	// Returns error if something goes wrong.
+	Complete(key string, fingerprint string, result string) error
+
+	// Release removes the reservation of the provided key, so the request can be retried after it failed.
+	// key: Mandatory. The idempotency key sent by the client.
+	// Returns error if something goes wrong.
+	Release(key string) error
+}
+
+// MemoryStore implements Store keeping the requests in memory of the current service instance. A retry sent to another
+// instance is not recognised, so the store only suits a single instance, e.g. the tests.
+type MemoryStore struct {
+	mutex     sync.Mutex
+	now       func() time.Time
+	ttl       time.Duration
+	records   map[string]*memoryRecord
+	lastSweep time.Time
+}
+
+type memoryRecord struct {
+	Record
+	expiresAt time.Time
+}
+
+// NewMemoryStore creates a new empty memory store.
+// ttl: Mandatory. How long a request is kept once it is reserved, and once it is completed.
+// now: Optional. Returns the current time, time.Now is used if not provided.
+func NewMemoryStore(ttl time.Duration, now func() time.Time) *MemoryStore {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &MemoryStore{now: now, ttl: ttl, records: make(map[string]*memoryRecord)}
+}
+
+// Reserve records the request with the provided fingerprint for the provided key, unless a request is already recorded.
+// key: Mandatory. The idempotency key sent by the client.
+// fingerprint: Mandatory. The fingerprint of the request.
+// Returns either the request already completed with the key, a record that is not completed if the key is reserved
+// for the new request, or ConflictError if the key is used by a different request or by a request still in progress.
+func (store *MemoryStore) Reserve(key string, fingerprint string) (Record, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	now := store.now()
+
+	store.sweep(now)
+
+	if record, ok := store.records[key]; ok && now.Before(record.expiresAt) {
+		if record.Fingerprint != fingerprint {
+			return Record{}, NewConflictError("Idempotency key was already used with a different request.")
+		}
+
+		if !record.Completed {
+			return Record{}, NewConflictError("A request with the same idempotency key is in progress.")
+		}
+
+		return record.Record, nil
+	}
+
+	store.records[key] = &memoryRecord{Record: Record{Fingerprint: fingerprint}, expiresAt: now.Add(store.ttl)}
+
+	return Record{Fingerprint: fingerprint}, nil
+}
+
+// Complete records the result of the request the provided key is reserved for.
+// key: Mandatory. The idempotency key sent by the client.
+// fingerprint: Mandatory. The fingerprint of the request the key is reserved for.
+// result: Mandatory. The result of the request.
+// Returns error if something goes wrong.
+func (store *MemoryStore) Complete(key string, fingerprint string, result string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if record, ok := store.records[key]; ok && record.Fingerprint == fingerprint {
+		record.Result = result
+		record.Completed = true
+		record.expiresAt = store.now().Add(store.ttl)
+	}
+
+	return nil
+}
+
+// Release removes the reservation of the provided key, so the request can be retried after it failed.
+// key: Mandatory. The idempotency key sent by the client.
+// Returns error if something goes wrong.
+func (store *MemoryStore) Release(key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if record, ok := store.records[key]; ok && !record.Completed {
+		delete(store.records, key)
+	}
+
+	return nil
+}
+
+// SetTTL changes how long the requests reserved or completed from then on are kept. The requests already recorded keep
+// their expiry.
+// ttl: Mandatory. How long a request is kept once it is reserved, and once it is completed.
+func (store *MemoryStore) SetTTL(ttl time.Duration) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.ttl = ttl
+}
+
+// sweep removes the expired requests, at most once per TTL.
+func (store *MemoryStore) sweep(now time.Time) {
+	if now.Sub(store.lastSweep) < store.ttl {
+		return
+	}
+
+	store.lastSweep = now
+
+	for key, record := range store.records {
+		if !now.Before(record.expiresAt) {
+			delete(store.records, key)
+		}
+	}
+}
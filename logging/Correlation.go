@@ -0,0 +1,161 @@
+package logging
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+)
+
+// CorrelationIDHeader is the header the correlation identifier of a request is read from and returned in.
+const CorrelationIDHeader = "X-Request-ID"
+
+// maxCorrelationIDLength is the maximum length of a correlation identifier sent by a client, longer identifiers are replaced.
+const maxCorrelationIDLength = 128
+
+type contextKey int
+
+const (
+	loggerContextKey contextKey = iota
+	correlationIDContextKey
+)
+
+// NewCorrelationID generates a random correlation identifier.
+// Returns the new correlation identifier.
+func NewCorrelationID() string {
+	identifier := make([]byte, 16)
+
+	if _, err := rand.Read(identifier); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 16)
+	}
+
+	return hex.EncodeToString(identifier)
+}
+
+// IsValidCorrelationID checks whether the provided correlation identifier sent by a client can be used as is: not empty,
+// not too long and made of printable ASCII characters only, so it cannot forge log lines.
+func IsValidCorrelationID(correlationID string) bool {
+	if len(correlationID) == 0 || len(correlationID) > maxCorrelationIDLength {
+		return false
+	}
+
+	for _, character := range correlationID {
+		if character <= ' ' || character > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewContext returns a copy of the provided context carrying the provided correlation identifier and the provided logger,
+// extended to write the correlation identifier in every log line, along with the trace identifier if the provided context
+// carries a span.
+// ctx: Mandatory. The parent context.
+// logger: Mandatory. The logger of the service.
+// correlationID: Mandatory. The correlation identifier of the request.
+// Returns the new context.
+func NewContext(ctx context.Context, logger log.Logger, correlationID string) context.Context {
+	ctx = context.WithValue(ctx, correlationIDContextKey, correlationID)
+
+	return context.WithValue(ctx, loggerContextKey, log.With(withTraceID(ctx, logger), "request_id", correlationID))
+}
+
+// FromContext returns the logger carried by the provided context.
+// Returns the logger and true, or a logger that discards everything and false if the context does not carry a logger.
+func FromContext(ctx context.Context) (log.Logger, bool) {
+	if logger, ok := ctx.Value(loggerContextKey).(log.Logger); ok {
+		return logger, true
+	}
+
+	return log.NewNopLogger(), false
+}
+
+// CorrelationIDFromContext returns the correlation identifier carried by the provided context, empty if it carries none.
+func CorrelationIDFromContext(ctx context.Context) string {
+	correlationID, _ := ctx.Value(correlationIDContextKey).(string)
+
+	return correlationID
+}
+
+// Middleware returns an HTTP handler that assigns a correlation identifier to every request passed to the provided
+// handler. The identifier is taken from the X-Request-ID header if the client sends a valid one, otherwise generated, and
+// is returned in the X-Request-ID response header. The request context carries the identifier and a logger writing it in
+// every log line, and a log line is written once the request is served.
+// logger: Mandatory. The logger of the service.
+// next: Mandatory. The handler that serves the requests.
+func Middleware(logger log.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {
+		correlationID := httpRequest.Header.Get(CorrelationIDHeader)
+
+		if !IsValidCorrelationID(correlationID) {
+			correlationID = NewCorrelationID()
+		}
+
+		writer.Header().Set(CorrelationIDHeader, correlationID)
+
+		ctx := NewContext(httpRequest.Context(), logger, correlationID)
+		requestLogger, _ := FromContext(ctx)
+		startTime := time.Now()
+		recorder := &statusRecorder{ResponseWriter: writer, statusCode: http.StatusOK}
+
+		next.ServeHTTP(recorder, httpRequest.WithContext(ctx))
+
+		level.Info(requestLogger).Log(
+			"msg", "Request served.",
+			"method", httpRequest.Method,
+			"path", httpRequest.URL.Path,
+			"status", recorder.statusCode,
+			"duration_ms", time.Since(startTime).Seconds()*1000)
+	})
+}
+
+// withTraceID returns the provided logger extended to write the identifier of the trace carried by the provided context
+// in every log line, or the provided logger if the context carries no trace.
+func withTraceID(ctx context.Context, logger log.Logger) log.Logger {
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		return log.With(logger, "trace_id", spanContext.TraceID().String())
+	}
+
+	return logger
+}
+
+// statusRecorder remembers the status code written to the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (recorder *statusRecorder) WriteHeader(statusCode int) {
+	recorder.statusCode = statusCode
+	recorder.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Flush sends the buffered data to the client if the underlying response writer supports it.
+func (recorder *statusRecorder) Flush() {
+	if flusher, ok := recorder.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack lets the caller take over the connection if the underlying response writer supports it, e.g. to upgrade to WebSocket.
+func (recorder *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := recorder.ResponseWriter.(http.Hijacker)
+
+	if !ok {
+		return nil, nil, errors.New("The response writer does not support hijacking.")
+	}
+
+	recorder.statusCode = http.StatusSwitchingProtocols
+
+	return hijacker.Hijack()
+}
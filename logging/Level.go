@@ -0,0 +1,73 @@
+package logging
+
+import (
+	"sync"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+)
+
+// Level is the lowest level of the log lines written by the loggers created with it. It can be changed while the
+// service is running, e.g. when the configuration is reloaded.
+type Level struct {
+	mutex  sync.RWMutex
+	name   string
+	option level.Option
+}
+
+// levelFilter discards the log lines below the current level.
+type levelFilter struct {
+	next         log.Logger
+	minimumLevel *Level
+}
+
+// NewLevel creates a level set to the provided level.
+// minimumLevel: Mandatory. The lowest level logged, either debug, info, warn or error.
+// Returns either the new level or error if the level is not supported.
+func NewLevel(minimumLevel string) (*Level, error) {
+	option, err := parseLevel(minimumLevel)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Level{name: minimumLevel, option: option}, nil
+}
+
+// Set changes the level, the log lines written from then on are filtered using the provided level.
+// minimumLevel: Mandatory. The lowest level logged, either debug, info, warn or error.
+// Returns error if the level is not supported, the level is left unchanged then.
+func (currentLevel *Level) Set(minimumLevel string) error {
+	option, err := parseLevel(minimumLevel)
+
+	if err != nil {
+		return err
+	}
+
+	currentLevel.mutex.Lock()
+	defer currentLevel.mutex.Unlock()
+
+	currentLevel.name = minimumLevel
+	currentLevel.option = option
+
+	return nil
+}
+
+// String returns the name of the level, either debug, info, warn or error.
+func (currentLevel *Level) String() string {
+	currentLevel.mutex.RLock()
+	defer currentLevel.mutex.RUnlock()
+
+	return currentLevel.name
+}
+
+func (currentLevel *Level) currentOption() level.Option {
+	currentLevel.mutex.RLock()
+	defer currentLevel.mutex.RUnlock()
+
+	return currentLevel.option
+}
+
+func (filter levelFilter) Log(keyvals ...interface{}) error {
+	return level.NewFilter(filter.next, filter.minimumLevel.currentOption()).Log(keyvals...)
+}
@@ -0,0 +1,99 @@
+// Package logging creates the structured, leveled logger of the service and correlates the log lines written while
+// serving a request using the request correlation identifier.
+package logging
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+)
+
+const (
+	// JSONFormat writes every log line as a JSON object.
+	JSONFormat = "json"
+
+	// LogfmtFormat writes every log line as space separated key=value pairs.
+	LogfmtFormat = "logfmt"
+)
+
+const (
+	// DebugLevel logs everything, including the details useful to troubleshoot the service.
+	DebugLevel = "debug"
+
+	// InfoLevel logs the served requests and the applied changes along with the warnings and errors.
+	InfoLevel = "info"
+
+	// WarnLevel logs the warnings and errors only.
+	WarnLevel = "warn"
+
+	// ErrorLevel logs the errors only.
+	ErrorLevel = "error"
+)
+
+// New creates a logger writing to the provided writer in the provided format. Every log line carries its timestamp and
+// the log lines below the provided level are discarded.
+// writer: Mandatory. The writer the log lines are written to.
+// format: Mandatory. The format of the log lines, either json or logfmt.
+// minimumLevel: Mandatory. The lowest level logged, either debug, info, warn or error.
+// Returns either the new logger or error if the format or the level is not supported.
+func New(writer io.Writer, format string, minimumLevel string) (log.Logger, error) {
+	currentLevel, err := NewLevel(minimumLevel)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithLevel(writer, format, currentLevel)
+}
+
+// NewWithLevel creates a logger writing to the provided writer in the provided format. Every log line carries its
+// timestamp and the log lines below the provided level are discarded, the level can be changed afterwards.
+// writer: Mandatory. The writer the log lines are written to.
+// format: Mandatory. The format of the log lines, either json or logfmt.
+// minimumLevel: Mandatory. The lowest level logged, see Level.
+// Returns either the new logger or error if the format is not supported.
+func NewWithLevel(writer io.Writer, format string, minimumLevel *Level) (log.Logger, error) {
+	var logger log.Logger
+
+	switch format {
+	case JSONFormat:
+		logger = log.NewJSONLogger(log.NewSyncWriter(writer))
+
+	case LogfmtFormat:
+		logger = log.NewLogfmtLogger(log.NewSyncWriter(writer))
+
+	default:
+		return nil, fmt.Errorf("Log format must be either %s or %s. Value: %s", JSONFormat, LogfmtFormat, format)
+	}
+
+	return log.With(levelFilter{next: logger, minimumLevel: minimumLevel}, "ts", log.DefaultTimestampUTC), nil
+}
+
+// OrNop returns the provided logger, or a logger that discards everything if the provided logger is nil.
+func OrNop(logger log.Logger) log.Logger {
+	if logger == nil {
+		return log.NewNopLogger()
+	}
+
+	return logger
+}
+
+func parseLevel(minimumLevel string) (level.Option, error) {
+	switch minimumLevel {
+	case DebugLevel:
+		return level.AllowDebug(), nil
+
+	case InfoLevel:
+		return level.AllowInfo(), nil
+
+	case WarnLevel:
+		return level.AllowWarn(), nil
+
+	case ErrorLevel:
+		return level.AllowError(), nil
+	}
+
+	return nil, fmt.Errorf("Log level must be either %s, %s, %s or %s. Value: %s", DebugLevel, InfoLevel, WarnLevel, ErrorLevel, minimumLevel)
+}
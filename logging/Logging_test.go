@@ -0,0 +1,176 @@
+package logging_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/log/level"
+	"github.com/micro-business/TenantService/logging"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Logging behaviour", func() {
+	var output *bytes.Buffer
+
+	decodeLines := func() []map[string]interface{} {
+		lines := []map[string]interface{}{}
+
+		for _, line := range strings.Split(strings.TrimSpace(output.String()), "\n") {
+			decodedLine := map[string]interface{}{}
+			Expect(json.Unmarshal([]byte(line), &decodedLine)).To(Succeed())
+			lines = append(lines, decodedLine)
+		}
+
+		return lines
+	}
+
+	BeforeEach(func() {
+		output = &bytes.Buffer{}
+	})
+
+	Describe("New", func() {
+		It("should write JSON log lines carrying the level and the timestamp", func() {
+			logger, err := logging.New(output, logging.JSONFormat, logging.InfoLevel)
+			Expect(err).To(BeNil())
+
+			level.Info(logger).Log("msg", "Started.")
+
+			lines := decodeLines()
+			Expect(lines).To(HaveLen(1))
+			Expect(lines[0]).To(HaveKeyWithValue("msg", "Started."))
+			Expect(lines[0]).To(HaveKeyWithValue("level", "info"))
+			Expect(lines[0]).To(HaveKey("ts"))
+		})
+
+		It("should write logfmt log lines", func() {
+			logger, err := logging.New(output, logging.LogfmtFormat, logging.InfoLevel)
+			Expect(err).To(BeNil())
+
+			level.Info(logger).Log("msg", "Started.")
+
+			Expect(output.String()).To(ContainSubstring("level=info"))
+			Expect(output.String()).To(ContainSubstring("msg=Started."))
+		})
+
+		It("should discard the log lines below the provided level", func() {
+			logger, err := logging.New(output, logging.LogfmtFormat, logging.WarnLevel)
+			Expect(err).To(BeNil())
+
+			level.Info(logger).Log("msg", "Started.")
+			level.Warn(logger).Log("msg", "Slow.")
+
+			Expect(output.String()).NotTo(ContainSubstring("Started."))
+			Expect(output.String()).To(ContainSubstring("Slow."))
+		})
+
+		It("should filter the log lines using the level in effect when they are written", func() {
+			minimumLevel, err := logging.NewLevel(logging.WarnLevel)
+			Expect(err).To(BeNil())
+
+			logger, err := logging.NewWithLevel(output, logging.LogfmtFormat, minimumLevel)
+			Expect(err).To(BeNil())
+
+			level.Info(logger).Log("msg", "Started.")
+			Expect(minimumLevel.Set(logging.InfoLevel)).To(Succeed())
+			level.Info(logger).Log("msg", "Reloaded.")
+
+			Expect(output.String()).NotTo(ContainSubstring("Started."))
+			Expect(output.String()).To(ContainSubstring("Reloaded."))
+			Expect(minimumLevel.String()).To(Equal(logging.InfoLevel))
+		})
+
+		It("should keep the level if the new level is not supported", func() {
+			minimumLevel, _ := logging.NewLevel(logging.WarnLevel)
+
+			Expect(minimumLevel.Set("verbose")).NotTo(Succeed())
+			Expect(minimumLevel.String()).To(Equal(logging.WarnLevel))
+		})
+
+		It("should return error if the format or the level is not supported", func() {
+			_, err := logging.New(output, "xml", logging.InfoLevel)
+			Expect(err).NotTo(BeNil())
+
+			_, err = logging.New(output, logging.JSONFormat, "verbose")
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("Middleware", func() {
+		var (
+			handler           http.Handler
+			servedCorrelation string
+		)
+
+		serve := func(correlationID string) *httptest.ResponseRecorder {
+			httpRequest := httptest.NewRequest(http.MethodGet, "/Api", nil)
+
+			if len(correlationID) != 0 {
+				httpRequest.Header.Set(logging.CorrelationIDHeader, correlationID)
+			}
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httpRequest)
+
+			return recorder
+		}
+
+		BeforeEach(func() {
+			logger, err := logging.New(output, logging.JSONFormat, logging.InfoLevel)
+			Expect(err).To(BeNil())
+
+			handler = logging.Middleware(logger, http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {
+				servedCorrelation = logging.CorrelationIDFromContext(httpRequest.Context())
+				requestLogger, ok := logging.FromContext(httpRequest.Context())
+				Expect(ok).To(BeTrue())
+
+				level.Info(requestLogger).Log("msg", "Serving.")
+				writer.WriteHeader(http.StatusAccepted)
+			}))
+		})
+
+		It("should use the correlation identifier sent by the client in the response header and in every log line", func() {
+			recorder := serve("client-request-1")
+
+			Expect(recorder.Header().Get(logging.CorrelationIDHeader)).To(Equal("client-request-1"))
+			Expect(servedCorrelation).To(Equal("client-request-1"))
+
+			lines := decodeLines()
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(HaveKeyWithValue("request_id", "client-request-1"))
+			Expect(lines[1]).To(HaveKeyWithValue("request_id", "client-request-1"))
+			Expect(lines[1]).To(HaveKeyWithValue("msg", "Request served."))
+			Expect(lines[1]).To(HaveKeyWithValue("status", float64(http.StatusAccepted)))
+		})
+
+		It("should generate a correlation identifier if the client does not send one", func() {
+			recorder := serve("")
+
+			Expect(recorder.Header().Get(logging.CorrelationIDHeader)).NotTo(BeEmpty())
+			Expect(recorder.Header().Get(logging.CorrelationIDHeader)).To(Equal(servedCorrelation))
+		})
+
+		It("should replace the correlation identifier sent by the client if it is not valid", func() {
+			recorder := serve("forged\tlevel=error")
+
+			Expect(recorder.Header().Get(logging.CorrelationIDHeader)).NotTo(Equal("forged\tlevel=error"))
+			Expect(logging.IsValidCorrelationID(recorder.Header().Get(logging.CorrelationIDHeader))).To(BeTrue())
+		})
+	})
+
+	It("should report that a context without logger carries none", func() {
+		logger, ok := logging.FromContext(httptest.NewRequest(http.MethodGet, "/", nil).Context())
+
+		Expect(ok).To(BeFalse())
+		Expect(logger).NotTo(BeNil())
+	})
+})
+
+func TestLogging(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Logging behaviour")
+}
@@ -4,41 +4,70 @@ import (
 	"flag"
 	"log"
 	"os"
-	"strconv"
-	"strings"
+	"time"
 
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
 	"github.com/gocql/gocql"
-	"github.com/micro-business/Micro-Business-Core/common/diagnostics"
 	"github.com/micro-business/Micro-Business-Core/system"
+	"github.com/micro-business/TenantService/business/event"
 	businessService "github.com/micro-business/TenantService/business/service"
 	"github.com/micro-business/TenantService/config"
 	dataService "github.com/micro-business/TenantService/data/service"
 	"github.com/micro-business/TenantService/endpoint"
+	"github.com/micro-business/TenantService/logging"
+	"github.com/micro-business/TenantService/metrics"
+	"github.com/micro-business/TenantService/tracing"
+	"golang.org/x/net/context"
 )
 
-var consulAddress string
-var consulScheme string
-var listeningPort int
-var cassandraHosts string
-var cassandraKeyspace string
-var cassandraProtoclVersion int
+// tracingShutdownTimeout is how long the spans not exported yet can take to be exported once the server is stopped.
+const tracingShutdownTimeout = 5 * time.Second
 
 func main() {
-	flag.StringVar(&consulAddress, "consul-address", "", "The consul address in form of host:port. The default value is empty string.")
-	flag.StringVar(&consulScheme, "consul-scheme", "", "The consul scheme. The default value is empty string.")
-	flag.IntVar(&listeningPort, "listening-port", 0, "The port the application is serving HTTP request on. The default is zero.")
-	flag.StringVar(&cassandraHosts, "cassandra-hosts", "", "The list of cassandra hosts to connect to. The default value is empty string.")
-	flag.StringVar(&cassandraKeyspace, "cassandra-keyspace", "", "The cassandra keyspace. The default value is empty string.")
-	flag.IntVar(&cassandraProtoclVersion, "cassandra-protocl-version", 0, "The cassandra protocl version. The default value is zero.")
-	flag.Parse()
+	if len(os.Args) > 1 && os.Args[1] == "schema" {
+		runSchemaCommand(os.Args[2:])
 
-	consulConfigurationReader := config.ConsulConfigurationReader{ConsulAddress: consulAddress, ConsulScheme: consulScheme}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "openapi" {
+		runOpenAPICommand(os.Args[2:])
+
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "config" {
+		runConfigCommand(os.Args[2:])
+
+		return
+	}
+
+	configurationReader, err := config.NewConfigurationReader(flag.CommandLine, os.Args[1:])
+
+	if err != nil {
+		log.Fatal(err.Error())
+
+		return
+	}
+
+	logger, logLevel, err := createLogger(configurationReader)
+
+	if err != nil {
+		log.Fatal(err.Error())
+
+		return
+	}
 
-	setConsulConfigurationValuesRequireToBeOverriden(&consulConfigurationReader)
+	reloader, err := config.NewReloader(configurationReader, logger)
 
-	endpoint := endpoint.Endpoint{ConfigurationReader: consulConfigurationReader}
+	if err != nil {
+		log.Fatal(err.Error())
 
-	cassandraHosts, err := consulConfigurationReader.GetCassandraHosts()
+		return
+	}
+
+	tracingProvider, err := createTracingProvider(configurationReader)
 
 	if err != nil {
 		log.Fatal(err.Error())
@@ -46,7 +75,11 @@ func main() {
 		return
 	}
 
-	cassandraKeyspace, err := consulConfigurationReader.GetCassandraKeyspace()
+	tracingProvider.Install()
+
+	endpoint := endpoint.Endpoint{ConfigurationReader: configurationReader, Logger: logger, Reloader: reloader}
+
+	tenantDataService, err := createTenantDataService(configurationReader, logger)
 
 	if err != nil {
 		log.Fatal(err.Error())
@@ -54,7 +87,7 @@ func main() {
 		return
 	}
 
-	cassandraProtocolVersion, err := consulConfigurationReader.GetCassandraProtocolVersion()
+	metricsEnabled, err := configurationReader.GetMetricsEnabled()
 
 	if err != nil {
 		log.Fatal(err.Error())
@@ -62,40 +95,167 @@ func main() {
 		return
 	}
 
-	uuidGeneratorService := system.UUIDGeneratorServiceImpl{}
+	if metricsEnabled {
+		endpoint.Metrics = metrics.New()
+		tenantDataService.ClusterConfig.QueryObserver = endpoint.Metrics.QueryObserver()
+		tenantDataService.ClusterConfig.BatchObserver = endpoint.Metrics.BatchObserver()
+	}
+
+	tenantDataService.Open()
 
-	cluster := gocql.NewCluster()
-	cluster.Hosts = cassandraHosts
-	cluster.ProtoVersion = cassandraProtocolVersion
-	cluster.Keyspace = cassandraKeyspace
-	cluster.Consistency = gocql.Quorum
+	idempotencyStore := dataService.NewIdempotencyStore(*tenantDataService, time.Duration(reloader.Settings().IdempotencyKeyTTL)*time.Second)
 
-	tenantDataService := dataService.TenantDataService{UUIDGeneratorService: &uuidGeneratorService, ClusterConfig: cluster}
-	tenantService := businessService.TenantService{TenantDataService: &tenantDataService}
+	reloader.OnReload(func(settings config.LiveSettings) {
+		logLevel.Set(settings.LogLevel)
+		idempotencyStore.SetTTL(time.Duration(settings.IdempotencyKeyTTL) * time.Second)
+	})
+
+	eventBus := event.NewBus()
+	tenantService := businessService.TenantService{
+		TenantDataService: tenantDataService,
+		EventPublisher:    eventBus,
+		Logger:            logger,
+		IdempotencyStore:  idempotencyStore,
+	}
 
 	endpoint.TenantService = tenantService
+	endpoint.EventBus = eventBus
+
+	stopWatching := make(chan struct{})
+	reloader.Watch(configurationReader, stopWatching)
 
 	endpoint.StartServer()
+
+	close(stopWatching)
+
+	// The in-flight requests are drained once the server returns, so the Cassandra session they share can be closed.
+	tenantDataService.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	defer cancel()
+
+	if err := tracingProvider.Shutdown(ctx); err != nil {
+		level.Error(logger).Log("msg", "Failed to export the pending spans.", "err", err)
+	}
 }
 
-func setConsulConfigurationValuesRequireToBeOverriden(consulConfigurationReader *config.ConsulConfigurationReader) {
-	diagnostics.IsNotNil(consulConfigurationReader, "consulConfigurationReader", "consulConfigurationReader is nil.")
+// createLogger creates the logger of the service writing to the standard error in the configured format and level.
+// Returns the logger along with its level, so the level can be changed when the configuration is reloaded.
+func createLogger(configurationReader config.ConfigurationReader) (kitlog.Logger, *logging.Level, error) {
+	logFormat, err := configurationReader.GetLogFormat()
 
-	if listeningPort != 0 {
-		consulConfigurationReader.ListeningPortToOverride = listeningPort
-	} else if port, err := strconv.Atoi(os.Getenv("PORT")); err == nil && port != 0 {
-		consulConfigurationReader.ListeningPortToOverride = port
+	if err != nil {
+		return nil, nil, err
 	}
 
-	if len(cassandraHosts) != 0 {
-		consulConfigurationReader.CassandraHostsToOverride = strings.Split(cassandraHosts, ",")
+	logLevelName, err := configurationReader.GetLogLevel()
+
+	if err != nil {
+		return nil, nil, err
 	}
 
-	if len(cassandraKeyspace) != 0 {
-		consulConfigurationReader.CassandraKeyspaceToOverride = cassandraKeyspace
+	logLevel, err := logging.NewLevel(logLevelName)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logger, err := logging.NewWithLevel(os.Stderr, logFormat, logLevel)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return logger, logLevel, nil
+}
+
+// createTenantDataService creates the tenant data service connecting to the Cassandra cluster as configured.
+func createTenantDataService(configurationReader config.ConfigurationReader, logger kitlog.Logger) (*dataService.TenantDataService, error) {
+	hosts, err := configurationReader.GetCassandraHosts()
+
+	if err != nil {
+		return nil, err
+	}
+
+	keyspace, err := configurationReader.GetCassandraKeyspace()
+
+	if err != nil {
+		return nil, err
 	}
 
-	if cassandraProtoclVersion != 0 {
-		consulConfigurationReader.CassandraProtocolVersionToOverride = cassandraProtoclVersion
+	protocolVersion, err := configurationReader.GetCassandraProtocolVersion()
+
+	if err != nil {
+		return nil, err
+	}
+
+	connection, err := configurationReader.GetCassandraConnection()
+
+	if err != nil {
+		return nil, err
 	}
+
+	readConsistency, err := dataService.ParseConsistency(connection.ReadConsistency)
+
+	if err != nil {
+		return nil, err
+	}
+
+	writeConsistency, err := dataService.ParseConsistency(connection.WriteConsistency)
+
+	if err != nil {
+		return nil, err
+	}
+
+	clusterOptions := dataService.ClusterOptions{
+		Hosts:                      hosts,
+		Keyspace:                   keyspace,
+		ProtocolVersion:            protocolVersion,
+		Username:                   connection.Username,
+		Password:                   connection.Password,
+		TLSEnabled:                 connection.TLSEnabled,
+		TLSCAFilePath:              connection.TLSCAFilePath,
+		TLSCertificateFilePath:     connection.TLSCertificateFilePath,
+		TLSKeyFilePath:             connection.TLSKeyFilePath,
+		TLSHostVerificationEnabled: connection.TLSHostVerificationEnabled,
+		ConnectTimeout:             time.Duration(connection.ConnectTimeout) * time.Millisecond,
+		QueryTimeout:               time.Duration(connection.QueryTimeout) * time.Millisecond,
+		RetryPolicy:                connection.RetryPolicy,
+		RetryAttempts:              connection.RetryAttempts,
+		TokenAwareRouting:          connection.TokenAwareRouting,
+		LocalDatacenter:            connection.LocalDatacenter,
+		ConnectionsPerHost:         connection.ConnectionsPerHost,
+	}
+
+	clusterConfig, err := dataService.NewClusterConfig(clusterOptions)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &dataService.TenantDataService{
+		UUIDGeneratorService: &system.UUIDGeneratorServiceImpl{},
+		ClusterConfig:        clusterConfig,
+		HostSelectionPolicy:  func() gocql.HostSelectionPolicy { return dataService.NewHostSelectionPolicy(clusterOptions) },
+		Logger:               logger,
+		ReadConsistency:      &readConsistency,
+		WriteConsistency:     &writeConsistency,
+	}, nil
+}
+
+// createTracingProvider creates the provider of the spans of the service, sampled and exported as configured.
+func createTracingProvider(configurationReader config.ConfigurationReader) (*tracing.Provider, error) {
+	tracingConfig, err := configurationReader.GetTracing()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tracing.New(tracing.Options{
+		Exporter:     tracingConfig.Exporter,
+		FilePath:     tracingConfig.FilePath,
+		OTLPEndpoint: tracingConfig.OTLPEndpoint,
+		OTLPInsecure: tracingConfig.OTLPInsecure,
+		SampleRatio:  tracingConfig.SampleRatio,
+	})
 }
@@ -0,0 +1,261 @@
+// Package metrics records how the service behaves in production and exposes the measurements to Prometheus.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "tenant_service"
+
+// knownMethods are the HTTP methods used as label values, other methods are reported as OTHER.
+var knownMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodPost:    true,
+	http.MethodPut:     true,
+	http.MethodPatch:   true,
+	http.MethodDelete:  true,
+	http.MethodOptions: true,
+}
+
+// Metrics holds the collectors of the service, registered in a dedicated registry along with the Go runtime and
+// process collectors.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests             *prometheus.CounterVec
+	httpRequestDuration      *prometheus.HistogramVec
+	graphqlOperations        *prometheus.CounterVec
+	graphqlOperationDuration *prometheus.HistogramVec
+	graphqlFieldDuration     *prometheus.HistogramVec
+	graphqlFieldErrors       *prometheus.CounterVec
+	cassandraQueries         *prometheus.CounterVec
+	cassandraQueryDuration   *prometheus.HistogramVec
+	counts                   *countCollector
+}
+
+// New creates the collectors of the service.
+// Returns the new metrics.
+func New() *Metrics {
+	metrics := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "Number of HTTP requests served, by handler, method and status code.",
+		}, []string{"handler", "method", "code"}),
+		httpRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Latency of the HTTP requests, by handler and method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"handler", "method"}),
+		graphqlOperations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "graphql_operations_total",
+			Help:      "Number of GraphQL operations executed, by operation type and whether the response carries errors.",
+		}, []string{"operation_type", "status"}),
+		graphqlOperationDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "graphql_operation_duration_seconds",
+			Help:      "Latency of the GraphQL operations, by operation type.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation_type"}),
+		graphqlFieldDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "graphql_field_duration_seconds",
+			Help:      "Latency of the GraphQL field resolvers, by parent type and field.",
+			Buckets:   []float64{0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1},
+		}, []string{"parent_type", "field"}),
+		graphqlFieldErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "graphql_field_errors_total",
+			Help:      "Number of GraphQL field resolvers that failed, by parent type and field.",
+		}, []string{"parent_type", "field"}),
+		cassandraQueries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cassandra_queries_total",
+			Help:      "Number of Cassandra query attempts, by statement and whether the attempt failed.",
+		}, []string{"statement", "status"}),
+		cassandraQueryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "cassandra_query_duration_seconds",
+			Help:      "Latency of the Cassandra query attempts, by statement.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"statement"}),
+		counts: &countCollector{
+			tenants:      prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "tenants"), "Number of tenants.", nil, nil),
+			applications: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "applications"), "Number of applications of all tenants.", nil, nil),
+		},
+	}
+
+	metrics.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		metrics.httpRequests,
+		metrics.httpRequestDuration,
+		metrics.graphqlOperations,
+		metrics.graphqlOperationDuration,
+		metrics.graphqlFieldDuration,
+		metrics.graphqlFieldErrors,
+		metrics.cassandraQueries,
+		metrics.cassandraQueryDuration,
+		metrics.counts,
+	)
+
+	return metrics
+}
+
+// Handler returns the HTTP handler exposing the metrics in the Prometheus exposition format.
+func (metrics *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(metrics.registry, promhttp.HandlerOpts{})
+}
+
+// Middleware returns an HTTP handler that counts the requests passed to the provided handler and measures their
+// latency. WebSocket upgrade requests are passed through without being measured as they last as long as the connection.
+// handlerName: Mandatory. The name of the handler used as label value.
+// next: Mandatory. The handler that serves the requests.
+func (metrics *Metrics) Middleware(handlerName string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {
+		if strings.EqualFold(httpRequest.Header.Get("Upgrade"), "websocket") {
+			next.ServeHTTP(writer, httpRequest)
+
+			return
+		}
+
+		startTime := time.Now()
+		recorder := &statusRecorder{ResponseWriter: writer, statusCode: http.StatusOK}
+
+		next.ServeHTTP(recorder, httpRequest)
+
+		method := httpRequest.Method
+
+		if !knownMethods[method] {
+			method = "OTHER"
+		}
+
+		metrics.httpRequests.WithLabelValues(handlerName, method, strconv.Itoa(recorder.statusCode)).Inc()
+		metrics.httpRequestDuration.WithLabelValues(handlerName, method).Observe(time.Since(startTime).Seconds())
+	})
+}
+
+// ObserveOperation records an executed GraphQL operation by its type. The operation name is chosen by the client, so it
+// is not used as label value: any client could otherwise create as many series as it sends names.
+// operationType: Mandatory. The type of the operation, e.g. query or mutation.
+// duration: Mandatory. How long the operation took.
+// failed: Mandatory. Whether the response carries errors.
+func (metrics *Metrics) ObserveOperation(operationType string, duration time.Duration, failed bool) {
+	metrics.graphqlOperations.WithLabelValues(operationType, status(failed)).Inc()
+	metrics.graphqlOperationDuration.WithLabelValues(operationType).Observe(duration.Seconds())
+}
+
+// ObserveField records a resolved GraphQL field.
+// parentType: Mandatory. The name of the type the field belongs to.
+// fieldName: Mandatory. The name of the field.
+// duration: Mandatory. How long the resolver took.
+// failed: Mandatory. Whether the resolver failed.
+func (metrics *Metrics) ObserveField(parentType string, fieldName string, duration time.Duration, failed bool) {
+	metrics.graphqlFieldDuration.WithLabelValues(parentType, fieldName).Observe(duration.Seconds())
+
+	if failed {
+		metrics.graphqlFieldErrors.WithLabelValues(parentType, fieldName).Inc()
+	}
+}
+
+// RefreshCounts counts the tenants and applications reported by the gauges using the provided function, right away and
+// then once per provided refresh interval, until the provided channel is closed. Counting scans the tables, so it is
+// never done when the metrics are scraped. The gauges are not reported until the first count succeeds, and keep the
+// previous counts if a later count fails.
+// count: Mandatory. Returns the number of tenants and applications, or error if they cannot be counted.
+// refreshInterval: Mandatory. The interval between two counts.
+// logger: Mandatory. The logger the failures to count are logged to.
+// stop: Mandatory. Closing it stops counting.
+func (metrics *Metrics) RefreshCounts(count func() (int, int, error), refreshInterval time.Duration, logger log.Logger, stop <-chan struct{}) {
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if tenantCount, applicationCount, err := count(); err != nil {
+			level.Error(logger).Log("msg", "Failed to count the tenants and applications.", "err", err)
+		} else {
+			metrics.counts.set(tenantCount, applicationCount)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+	}
+}
+
+func status(failed bool) string {
+	if failed {
+		return "error"
+	}
+
+	return "success"
+}
+
+// statusRecorder remembers the status code written to the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (recorder *statusRecorder) WriteHeader(statusCode int) {
+	recorder.statusCode = statusCode
+	recorder.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Flush sends the buffered data to the client if the underlying response writer supports it.
+func (recorder *statusRecorder) Flush() {
+	if flusher, ok := recorder.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// countCollector reports the number of tenants and applications last counted by RefreshCounts.
+type countCollector struct {
+	tenants      *prometheus.Desc
+	applications *prometheus.Desc
+
+	mutex            sync.Mutex
+	tenantCount      int
+	applicationCount int
+	counted          bool
+}
+
+func (collector *countCollector) set(tenantCount int, applicationCount int) {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+
+	collector.tenantCount, collector.applicationCount, collector.counted = tenantCount, applicationCount, true
+}
+
+func (collector *countCollector) Describe(descriptions chan<- *prometheus.Desc) {
+	descriptions <- collector.tenants
+	descriptions <- collector.applications
+}
+
+func (collector *countCollector) Collect(collected chan<- prometheus.Metric) {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+
+	if !collector.counted {
+		return
+	}
+
+	collected <- prometheus.MustNewConstMetric(collector.tenants, prometheus.GaugeValue, float64(collector.tenantCount))
+	collected <- prometheus.MustNewConstMetric(collector.applications, prometheus.GaugeValue, float64(collector.applicationCount))
+}
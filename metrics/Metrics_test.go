@@ -0,0 +1,163 @@
+package metrics_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/gocql/gocql"
+	"github.com/micro-business/TenantService/metrics"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("Metrics behaviour", func() {
+	var serviceMetrics *metrics.Metrics
+
+	scrape := func() string {
+		recorder := httptest.NewRecorder()
+		serviceMetrics.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		return recorder.Body.String()
+	}
+
+	BeforeEach(func() {
+		serviceMetrics = metrics.New()
+	})
+
+	It("should expose the Go runtime metrics", func() {
+		Expect(scrape()).To(ContainSubstring("go_goroutines"))
+	})
+
+	It("should count the HTTP requests by handler, method and status code", func() {
+		handler := serviceMetrics.Middleware("rest", http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {
+			writer.WriteHeader(http.StatusNotFound)
+		}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/tenants", nil))
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("PROPFIND", "/tenants", nil))
+
+		body := scrape()
+		Expect(body).To(ContainSubstring(`tenant_service_http_requests_total{code="404",handler="rest",method="GET"} 1`))
+		Expect(body).To(ContainSubstring(`tenant_service_http_requests_total{code="404",handler="rest",method="OTHER"} 1`))
+		Expect(body).To(ContainSubstring(`tenant_service_http_request_duration_seconds_count{handler="rest",method="GET"} 1`))
+	})
+
+	It("should not measure the WebSocket upgrade requests", func() {
+		served := false
+		handler := serviceMetrics.Middleware("graphql", http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {
+			served = true
+		}))
+
+		httpRequest := httptest.NewRequest(http.MethodGet, "/Api", nil)
+		httpRequest.Header.Set("Upgrade", "websocket")
+		handler.ServeHTTP(httptest.NewRecorder(), httpRequest)
+
+		Expect(served).To(BeTrue())
+		Expect(scrape()).NotTo(ContainSubstring("tenant_service_http_requests_total"))
+	})
+
+	It("should record the GraphQL operations by type and the fields", func() {
+		serviceMetrics.ObserveOperation("query", time.Millisecond, false)
+		serviceMetrics.ObserveOperation("query", time.Millisecond, false)
+		serviceMetrics.ObserveOperation("mutation", time.Millisecond, true)
+		serviceMetrics.ObserveField("Tenant", "secretKey", time.Millisecond, true)
+
+		body := scrape()
+		Expect(body).To(ContainSubstring(`tenant_service_graphql_operations_total{operation_type="query",status="success"} 2`))
+		Expect(body).To(ContainSubstring(`tenant_service_graphql_operations_total{operation_type="mutation",status="error"} 1`))
+		Expect(body).To(ContainSubstring(`tenant_service_graphql_operation_duration_seconds_count{operation_type="query"} 2`))
+		Expect(body).To(ContainSubstring(`tenant_service_graphql_field_duration_seconds_count{field="secretKey",parent_type="Tenant"} 1`))
+		Expect(body).To(ContainSubstring(`tenant_service_graphql_field_errors_total{field="secretKey",parent_type="Tenant"} 1`))
+	})
+
+	It("should record the Cassandra queries and batches by statement", func() {
+		startTime := time.Now()
+
+		serviceMetrics.QueryObserver().ObserveQuery(context.Background(), gocql.ObservedQuery{
+			Statement: "SELECT * FROM tenant",
+			Start:     startTime,
+			End:       startTime.Add(time.Millisecond),
+			Err:       errors.New("Failed"),
+		})
+
+		serviceMetrics.BatchObserver().ObserveBatch(context.Background(), gocql.ObservedBatch{
+			Statements: []string{"INSERT INTO tenant", "INSERT INTO application", "INSERT INTO tenant"},
+			Start:      startTime,
+			End:        startTime.Add(time.Millisecond),
+		})
+
+		body := scrape()
+		Expect(body).To(ContainSubstring(`tenant_service_cassandra_queries_total{statement="SELECT * FROM tenant",status="error"} 1`))
+		Expect(body).To(ContainSubstring(`tenant_service_cassandra_queries_total{statement="BATCH(INSERT INTO application; INSERT INTO tenant)",status="success"} 1`))
+		Expect(body).To(ContainSubstring(`tenant_service_cassandra_query_duration_seconds_count{statement="SELECT * FROM tenant"} 1`))
+	})
+
+	It("should report the counts of tenants and applications once counted in the background", func() {
+		stop := make(chan struct{})
+		defer close(stop)
+
+		counted := make(chan struct{}, 1)
+
+		go serviceMetrics.RefreshCounts(func() (int, int, error) {
+			select {
+			case counted <- struct{}{}:
+			default:
+			}
+
+			return 2, 5, nil
+		}, time.Hour, log.NewNopLogger(), stop)
+
+		Eventually(counted).Should(Receive())
+		Eventually(scrape).Should(ContainSubstring("tenant_service_tenants 2"))
+		Expect(scrape()).To(ContainSubstring("tenant_service_applications 5"))
+	})
+
+	It("should not count when the metrics are scraped", func() {
+		stop := make(chan struct{})
+		calls := make(chan struct{}, 10)
+
+		go serviceMetrics.RefreshCounts(func() (int, int, error) {
+			calls <- struct{}{}
+
+			return 2, 5, nil
+		}, time.Hour, log.NewNopLogger(), stop)
+
+		Eventually(calls).Should(Receive())
+		close(stop)
+
+		scrape()
+		scrape()
+
+		Consistently(calls, 100*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("should not report the counts if they cannot be counted", func() {
+		stop := make(chan struct{})
+		defer close(stop)
+
+		failed := make(chan struct{}, 1)
+
+		go serviceMetrics.RefreshCounts(func() (int, int, error) {
+			select {
+			case failed <- struct{}{}:
+			default:
+			}
+
+			return 0, 0, errors.New("Failed")
+		}, time.Hour, log.NewNopLogger(), stop)
+
+		Eventually(failed).Should(Receive())
+		Expect(scrape()).NotTo(ContainSubstring("tenant_service_tenants"))
+	})
+})
+
+func TestMetrics(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Metrics behaviour")
+}
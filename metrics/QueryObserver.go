@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/gocql/gocql"
+	"golang.org/x/net/context"
+)
+
+// cassandraObserver records the latency and the errors of the Cassandra queries and batches, by statement. Every
+// attempt is recorded, including the retries.
+type cassandraObserver struct {
+	metrics *Metrics
+}
+
+// QueryObserver returns the observer recording the Cassandra queries, to be set as the QueryObserver of the cluster configuration.
+func (metrics *Metrics) QueryObserver() gocql.QueryObserver {
+	return cassandraObserver{metrics: metrics}
+}
+
+// BatchObserver returns the observer recording the Cassandra batches, to be set as the BatchObserver of the cluster configuration.
+func (metrics *Metrics) BatchObserver() gocql.BatchObserver {
+	return cassandraObserver{metrics: metrics}
+}
+
+func (observer cassandraObserver) ObserveQuery(ctx context.Context, query gocql.ObservedQuery) {
+	observer.observe(query.Statement, query.End.Sub(query.Start).Seconds(), query.Err)
+}
+
+// ObserveBatch records a batch under the statements it is made of, each statement once, e.g. BATCH(INSERT INTO ...).
+func (observer cassandraObserver) ObserveBatch(ctx context.Context, batch gocql.ObservedBatch) {
+	distinctStatements := make(map[string]bool)
+	statements := []string{}
+
+	for _, statement := range batch.Statements {
+		if !distinctStatements[statement] {
+			distinctStatements[statement] = true
+			statements = append(statements, statement)
+		}
+	}
+
+	sort.Strings(statements)
+
+	observer.observe("BATCH("+strings.Join(statements, "; ")+")", batch.End.Sub(batch.Start).Seconds(), batch.Err)
+}
+
+func (observer cassandraObserver) observe(statement string, seconds float64, err error) {
+	observer.metrics.cassandraQueries.WithLabelValues(statement, status(err != nil)).Inc()
+	observer.metrics.cassandraQueryDuration.WithLabelValues(statement).Observe(seconds)
+}
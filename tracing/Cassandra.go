@@ -0,0 +1,88 @@
+package tracing
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+)
+
+// cassandraObserver records a span for every Cassandra query and batch attempt, as a child of the span carried by the
+// context it is bound to, and passes the attempt on to the next observers.
+type cassandraObserver struct {
+	ctx               context.Context
+	nextQueryObserver gocql.QueryObserver
+	nextBatchObserver gocql.BatchObserver
+}
+
+// QueryObserver returns the observer recording the Cassandra queries as children of the span carried by the provided
+// context, to be set as the QueryObserver of the cluster configuration the session is created with.
+// ctx: Mandatory. The context carrying the parent span.
+// next: Optional. The observer the queries are passed on to, e.g. the one recording the metrics.
+func QueryObserver(ctx context.Context, next gocql.QueryObserver) gocql.QueryObserver {
+	return cassandraObserver{ctx: ctx, nextQueryObserver: next}
+}
+
+// BatchObserver returns the observer recording the Cassandra batches as children of the span carried by the provided
+// context, to be set as the BatchObserver of the cluster configuration the session is created with.
+// ctx: Mandatory. The context carrying the parent span.
+// next: Optional. The observer the batches are passed on to, e.g. the one recording the metrics.
+func BatchObserver(ctx context.Context, next gocql.BatchObserver) gocql.BatchObserver {
+	return cassandraObserver{ctx: ctx, nextBatchObserver: next}
+}
+
+func (observer cassandraObserver) ObserveQuery(ctx context.Context, query gocql.ObservedQuery) {
+	observer.record("CQL "+operationOf(query.Statement), query.Statement, query.Keyspace, query.Host, query.Attempt, query.Start, query.End, query.Err)
+
+	if observer.nextQueryObserver != nil {
+		observer.nextQueryObserver.ObserveQuery(ctx, query)
+	}
+}
+
+func (observer cassandraObserver) ObserveBatch(ctx context.Context, batch gocql.ObservedBatch) {
+	observer.record("CQL BATCH", strings.Join(batch.Statements, "; "), batch.Keyspace, batch.Host, batch.Attempt, batch.Start, batch.End, batch.Err)
+
+	if observer.nextBatchObserver != nil {
+		observer.nextBatchObserver.ObserveBatch(ctx, batch)
+	}
+}
+
+// record records the span of a query attempt. The statements are recorded as sent, with placeholders instead of the
+// values, so the tenant secret keys are never recorded.
+func (observer cassandraObserver) record(spanName string, statement string, keyspace string, host *gocql.HostInfo, attempt int, start time.Time, end time.Time, err error) {
+	attributes := []attribute.KeyValue{
+		attribute.String("db.system", "cassandra"),
+		attribute.String("db.namespace", keyspace),
+		attribute.String("db.query.text", statement),
+		attribute.Int("db.cassandra.attempt", attempt),
+	}
+
+	if host != nil {
+		attributes = append(attributes, attribute.String("server.address", host.ConnectAddress().String()))
+	}
+
+	_, span := Start(
+		observer.ctx,
+		spanName,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(start),
+		trace.WithAttributes(attributes...))
+
+	if err != nil {
+		Fail(span, err)
+	}
+
+	span.End(trace.WithTimestamp(end))
+}
+
+// operationOf returns the operation of the provided statement, e.g. SELECT.
+func operationOf(statement string) string {
+	if fields := strings.Fields(statement); len(fields) != 0 {
+		return strings.ToUpper(fields[0])
+	}
+
+	return "UNKNOWN"
+}
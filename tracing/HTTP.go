@@ -0,0 +1,75 @@
+package tracing
+
+import (
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+)
+
+// Middleware returns an HTTP handler that records a span for every request passed to the provided handler. The span
+// continues the trace sent by the client in the W3C traceparent header, if any. WebSocket upgrade requests are passed
+// through without a span as they last as long as the connection.
+// route: Mandatory. The route the handler serves, used in the name of the spans, e.g. /Api.
+// next: Mandatory. The handler that serves the requests.
+func Middleware(route string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {
+		if strings.EqualFold(httpRequest.Header.Get("Upgrade"), "websocket") {
+			next.ServeHTTP(writer, httpRequest)
+
+			return
+		}
+
+		ctx := propagator.Extract(httpRequest.Context(), propagation.HeaderCarrier(httpRequest.Header))
+		ctx, span := Start(
+			ctx,
+			httpRequest.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", httpRequest.Method),
+				attribute.String("http.route", route),
+				attribute.String("url.path", httpRequest.URL.Path)))
+		defer span.End()
+
+		recorder := &statusRecorder{ResponseWriter: writer, statusCode: http.StatusOK}
+
+		next.ServeHTTP(recorder, httpRequest.WithContext(ctx))
+
+		span.SetAttributes(attribute.Int("http.response.status_code", recorder.statusCode))
+
+		if recorder.statusCode >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(recorder.statusCode))
+		}
+	})
+}
+
+// Extract returns a copy of the provided context carrying the W3C trace context read from the provided carrier, e.g.
+// the metadata of a gRPC request.
+// ctx: Mandatory. The parent context.
+// carrier: Mandatory. The carrier the trace context is read from.
+// Returns the context carrying the trace context.
+func Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	return propagator.Extract(ctx, carrier)
+}
+
+// statusRecorder remembers the status code written to the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (recorder *statusRecorder) WriteHeader(statusCode int) {
+	recorder.statusCode = statusCode
+	recorder.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Flush sends the buffered data to the client if the underlying response writer supports it.
+func (recorder *statusRecorder) Flush() {
+	if flusher, ok := recorder.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
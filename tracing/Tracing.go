@@ -0,0 +1,188 @@
+// Package tracing records the spans of the requests served by the service, from the endpoint down to the Cassandra
+// statements, and exports them using OpenTelemetry.
+package tracing
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+)
+
+const (
+	// NoExporter disables the export of the spans. The inbound trace context is still propagated.
+	NoExporter = "none"
+
+	// StdoutExporter writes the spans to the standard output, for local use.
+	StdoutExporter = "stdout"
+
+	// FileExporter writes the spans to a file, for local use.
+	FileExporter = "file"
+
+	// OTLPExporter sends the spans to an OpenTelemetry collector using OTLP over HTTP.
+	OTLPExporter = "otlp"
+)
+
+const instrumentationName = "github.com/micro-business/TenantService"
+
+const serviceName = "tenant-service"
+
+// propagator reads the W3C trace context of the inbound requests.
+var propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// Options defines how the spans are sampled and exported.
+type Options struct {
+	// Exporter is the exporter of the spans, either none, stdout, file or otlp.
+	Exporter string
+
+	// FilePath is the path of the file the spans are appended to. Mandatory for the file exporter.
+	FilePath string
+
+	// OTLPEndpoint is the host and port of the OpenTelemetry collector. Mandatory for the otlp exporter.
+	OTLPEndpoint string
+
+	// OTLPInsecure sends the spans to the OpenTelemetry collector over plain HTTP instead of HTTPS.
+	OTLPInsecure bool
+
+	// SampleRatio is the ratio of the traces started by the service that are sampled, between 0 and 1. The traces
+	// started by the callers are sampled as decided by the callers.
+	SampleRatio float64
+}
+
+// Provider creates the spans of the service and exports them.
+type Provider struct {
+	tracerProvider *sdktrace.TracerProvider
+	file           *os.File
+}
+
+// New creates the provider exporting the spans using the exporter defined by the provided options. Use Install to
+// make the service use the provider.
+// options: Mandatory. Defines how the spans are sampled and exported.
+// Returns either the new provider or error if the exporter cannot be created.
+func New(options Options) (*Provider, error) {
+	if options.SampleRatio < 0 || options.SampleRatio > 1 {
+		return nil, fmt.Errorf("Trace sample ratio must be between 0 and 1. Value: %g", options.SampleRatio)
+	}
+
+	provider := &Provider{}
+	tracerProviderOptions := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(options.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	}
+
+	switch options.Exporter {
+	case NoExporter:
+		// The spans are still created so the trace context of the inbound requests is propagated.
+
+	case StdoutExporter:
+		exporter, err := newWriterExporter(os.Stdout)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tracerProviderOptions = append(tracerProviderOptions, sdktrace.WithSyncer(exporter))
+
+	case FileExporter:
+		if len(options.FilePath) == 0 {
+			return nil, fmt.Errorf("Trace file path must be provided when the file exporter is used.")
+		}
+
+		file, err := os.OpenFile(options.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+
+		if err != nil {
+			return nil, err
+		}
+
+		exporter, err := newWriterExporter(file)
+
+		if err != nil {
+			file.Close()
+
+			return nil, err
+		}
+
+		provider.file = file
+		tracerProviderOptions = append(tracerProviderOptions, sdktrace.WithBatcher(exporter))
+
+	case OTLPExporter:
+		if len(options.OTLPEndpoint) == 0 {
+			return nil, fmt.Errorf("Trace OTLP endpoint must be provided when the otlp exporter is used.")
+		}
+
+		clientOptions := []otlptracehttp.Option{otlptracehttp.WithEndpoint(options.OTLPEndpoint)}
+
+		if options.OTLPInsecure {
+			clientOptions = append(clientOptions, otlptracehttp.WithInsecure())
+		}
+
+		exporter, err := otlptracehttp.New(context.Background(), clientOptions...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tracerProviderOptions = append(tracerProviderOptions, sdktrace.WithBatcher(exporter))
+
+	default:
+		return nil, fmt.Errorf("Trace exporter must be either %s, %s, %s or %s. Value: %s", NoExporter, StdoutExporter, FileExporter, OTLPExporter, options.Exporter)
+	}
+
+	provider.tracerProvider = sdktrace.NewTracerProvider(tracerProviderOptions...)
+
+	return provider, nil
+}
+
+// Install makes the provider the one every span of the service is created by.
+func (provider *Provider) Install() {
+	otel.SetTracerProvider(provider.tracerProvider)
+}
+
+// Shutdown exports the spans not exported yet and releases the exporter.
+// ctx: Mandatory. Bounds how long the pending spans can take to be exported.
+// Returns error if the pending spans cannot be exported.
+func (provider *Provider) Shutdown(ctx context.Context) error {
+	err := provider.tracerProvider.Shutdown(ctx)
+
+	if provider.file != nil {
+		if closeErr := provider.file.Close(); err == nil {
+			err = closeErr
+		}
+	}
+
+	return err
+}
+
+// Start starts a span as a child of the span carried by the provided context, if any.
+// ctx: Mandatory. The context carrying the parent span.
+// spanName: Mandatory. The name of the new span.
+// options: Optional. The options of the new span, e.g. its attributes.
+// Returns the context carrying the new span, and the new span.
+func Start(ctx context.Context, spanName string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, spanName, options...)
+}
+
+// Fail records the provided error on the provided span and marks the span as failed.
+// span: Mandatory. The failed span.
+// err: Mandatory. The error the span failed with.
+// Returns the provided error.
+func Fail(span trace.Span, err error) error {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+
+	return err
+}
+
+func newWriterExporter(writer io.Writer) (sdktrace.SpanExporter, error) {
+	return stdouttrace.New(stdouttrace.WithWriter(writer))
+}
@@ -0,0 +1,204 @@
+package tracing_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/micro-business/TenantService/tracing"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"golang.org/x/net/context"
+)
+
+type countingObserver struct {
+	queries int
+	batches int
+}
+
+func (observer *countingObserver) ObserveQuery(ctx context.Context, query gocql.ObservedQuery) {
+	observer.queries++
+}
+
+func (observer *countingObserver) ObserveBatch(ctx context.Context, batch gocql.ObservedBatch) {
+	observer.batches++
+}
+
+func attributeValue(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, keyValue := range span.Attributes() {
+		if keyValue.Key == key {
+			return keyValue.Value
+		}
+	}
+
+	return attribute.Value{}
+}
+
+var _ = Describe("Tracing behaviour", func() {
+	var recorder *tracetest.SpanRecorder
+
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	})
+
+	Context("New", func() {
+		It("should return error if the exporter is not supported", func() {
+			_, err := tracing.New(tracing.Options{Exporter: "zipkin", SampleRatio: 1})
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return error if the sample ratio is out of range", func() {
+			_, err := tracing.New(tracing.Options{Exporter: tracing.NoExporter, SampleRatio: 1.5})
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return error if the file path is not provided for the file exporter", func() {
+			_, err := tracing.New(tracing.Options{Exporter: tracing.FileExporter, SampleRatio: 1})
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should write the spans to the file once the provider is shut down", func() {
+			directory, err := ioutil.TempDir("", "tracing")
+			Expect(err).To(BeNil())
+			defer os.RemoveAll(directory)
+
+			filePath := filepath.Join(directory, "spans.json")
+			provider, err := tracing.New(tracing.Options{Exporter: tracing.FileExporter, FilePath: filePath, SampleRatio: 1})
+			Expect(err).To(BeNil())
+
+			provider.Install()
+
+			_, span := tracing.Start(context.Background(), "exported")
+			span.End()
+
+			Expect(provider.Shutdown(context.Background())).To(BeNil())
+
+			content, err := ioutil.ReadFile(filePath)
+			Expect(err).To(BeNil())
+			Expect(string(content)).To(ContainSubstring("\"Name\":\"exported\""))
+		})
+	})
+
+	Context("Fail", func() {
+		It("should mark the span as failed and return the provided error", func() {
+			expectedError := errors.New("Failed")
+			_, span := tracing.Start(context.Background(), "failed")
+
+			Expect(tracing.Fail(span, expectedError)).To(Equal(expectedError))
+			span.End()
+
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Status().Code).To(Equal(codes.Error))
+			Expect(spans[0].Events()).To(HaveLen(1))
+		})
+	})
+
+	Context("Middleware", func() {
+		It("should continue the trace sent by the client in the traceparent header", func() {
+			handler := tracing.Middleware("/Api", http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {
+				writer.WriteHeader(http.StatusServiceUnavailable)
+			}))
+
+			httpRequest := httptest.NewRequest(http.MethodPost, "/Api", nil)
+			httpRequest.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+			handler.ServeHTTP(httptest.NewRecorder(), httpRequest)
+
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Name()).To(Equal("POST /Api"))
+			Expect(spans[0].SpanContext().TraceID().String()).To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+			Expect(spans[0].Parent().SpanID().String()).To(Equal("00f067aa0ba902b7"))
+			Expect(attributeValue(spans[0], "http.response.status_code").AsInt64()).To(Equal(int64(http.StatusServiceUnavailable)))
+			Expect(spans[0].Status().Code).To(Equal(codes.Error))
+		})
+
+		It("should start a new trace if the client does not send one", func() {
+			var served bool
+
+			handler := tracing.Middleware("/tenants", http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {
+				served = true
+			}))
+
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/tenants", nil))
+
+			spans := recorder.Ended()
+			Expect(served).To(BeTrue())
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Parent().IsValid()).To(BeFalse())
+			Expect(spans[0].Status().Code).NotTo(Equal(codes.Error))
+		})
+
+		It("should not record a span for the WebSocket upgrade requests", func() {
+			handler := tracing.Middleware("/Api", http.HandlerFunc(func(writer http.ResponseWriter, httpRequest *http.Request) {}))
+
+			httpRequest := httptest.NewRequest(http.MethodGet, "/Api", nil)
+			httpRequest.Header.Set("Upgrade", "websocket")
+
+			handler.ServeHTTP(httptest.NewRecorder(), httpRequest)
+
+			Expect(recorder.Ended()).To(BeEmpty())
+		})
+	})
+
+	Context("Cassandra observers", func() {
+		It("should record the queries as children of the span of the context and pass them on", func() {
+			ctx, parentSpan := tracing.Start(context.Background(), "parent")
+			next := &countingObserver{}
+			start := time.Now()
+
+			tracing.QueryObserver(ctx, next).ObserveQuery(context.Background(), gocql.ObservedQuery{
+				Keyspace:  "tenant",
+				Statement: "SELECT secret_key FROM tenant WHERE tenant_id = ?",
+				Start:     start,
+				End:       start.Add(time.Millisecond),
+				Err:       errors.New("Failed")})
+
+			spans := recorder.Ended()
+			Expect(next.queries).To(Equal(1))
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Name()).To(Equal("CQL SELECT"))
+			Expect(spans[0].Parent().SpanID()).To(Equal(parentSpan.SpanContext().SpanID()))
+			Expect(spans[0].EndTime().Sub(spans[0].StartTime())).To(Equal(time.Millisecond))
+			Expect(attributeValue(spans[0], "db.query.text").AsString()).To(Equal("SELECT secret_key FROM tenant WHERE tenant_id = ?"))
+			Expect(spans[0].Status().Code).To(Equal(codes.Error))
+		})
+
+		It("should record the batches and pass them on", func() {
+			next := &countingObserver{}
+
+			tracing.BatchObserver(context.Background(), next).ObserveBatch(context.Background(), gocql.ObservedBatch{
+				Keyspace:   "tenant",
+				Statements: []string{"INSERT INTO application (tenant_id, application_id, name) VALUES(?, ?, ?)"},
+				Start:      time.Now(),
+				End:        time.Now()})
+
+			spans := recorder.Ended()
+			Expect(next.batches).To(Equal(1))
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Name()).To(Equal("CQL BATCH"))
+			Expect(spans[0].Status().Code).NotTo(Equal(codes.Error))
+		})
+	})
+})
+
+func TestTracing(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Tracing behaviour")
+}